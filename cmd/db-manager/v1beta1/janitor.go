@@ -0,0 +1,202 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+const (
+	janitorTaskDownsample = "downsample"
+	janitorTaskExpire     = "expire"
+	janitorTaskPurge      = "purge"
+
+	// downsampleBatchSize is the maximum number of logs deleted by one downsample statement.
+	downsampleBatchSize = 10000
+)
+
+// janitorConfig configures the background clean up of the observation logs.
+// A task is disabled if its duration is 0.
+type janitorConfig struct {
+	interval         time.Duration
+	downsampleAfter  time.Duration
+	downsampleBucket time.Duration
+	ttl              time.Duration
+	purgeOrphans     bool
+}
+
+func (c janitorConfig) enabled() bool {
+	return c.interval > 0 && (c.downsampleAfter > 0 || c.ttl > 0 || c.purgeOrphans)
+}
+
+type janitorMetrics struct {
+	deletedLogs  *prometheus.CounterVec
+	purgedTrials prometheus.Counter
+	errors       *prometheus.CounterVec
+	lastRunTime  prometheus.Gauge
+	runDuration  prometheus.Histogram
+}
+
+func newJanitorMetrics(registerer prometheus.Registerer) *janitorMetrics {
+	m := &janitorMetrics{
+		deletedLogs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "katib_db_manager_janitor_deleted_logs_total",
+			Help: "The total number of observation logs deleted by the janitor",
+		}, []string{"task"}),
+		purgedTrials: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "katib_db_manager_janitor_purged_trials_total",
+			Help: "The total number of deleted trials whose observation logs were purged",
+		}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "katib_db_manager_janitor_errors_total",
+			Help: "The total number of failed janitor tasks",
+		}, []string{"task"}),
+		lastRunTime: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "katib_db_manager_janitor_last_run_timestamp_seconds",
+			Help: "The time of the last janitor run",
+		}),
+		runDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name: "katib_db_manager_janitor_run_duration_seconds",
+			Help: "The duration of the janitor runs",
+		}),
+	}
+	registerer.MustRegister(m.deletedLogs, m.purgedTrials, m.errors, m.lastRunTime, m.runDuration)
+	return m
+}
+
+// observationLogJanitor downsamples, expires and purges the observation logs periodically.
+type observationLogJanitor struct {
+	config  janitorConfig
+	client  client.Client
+	metrics *janitorMetrics
+	now     func() time.Time
+}
+
+// newObservationLogJanitor creates the janitor. The client is used to find the deleted trials,
+// it is only required if purgeOrphans is set.
+func newObservationLogJanitor(config janitorConfig, kubeClient client.Client, registerer prometheus.Registerer) *observationLogJanitor {
+	return &observationLogJanitor{
+		config:  config,
+		client:  kubeClient,
+		metrics: newJanitorMetrics(registerer),
+		now:     time.Now,
+	}
+}
+
+// run runs the janitor every interval until the context is done.
+func (j *observationLogJanitor) run(ctx context.Context) {
+	ticker := time.NewTicker(j.config.interval)
+	defer ticker.Stop()
+	for {
+		if err := j.runOnce(ctx); err != nil {
+			klog.Errorf("Failed to clean up observation logs: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runOnce runs every enabled task once. A failed task doesn't prevent the others from running.
+func (j *observationLogJanitor) runOnce(ctx context.Context) error {
+	start := j.now()
+	defer func() {
+		j.metrics.lastRunTime.Set(float64(start.Unix()))
+		j.metrics.runDuration.Observe(j.now().Sub(start).Seconds())
+	}()
+
+	var errs []error
+	if j.config.downsampleAfter > 0 {
+		errs = append(errs, j.downsample(ctx, start.Add(-j.config.downsampleAfter)))
+	}
+	if j.config.ttl > 0 {
+		deleted, err := dbIf.ExpireObservationLog(start.Add(-j.config.ttl))
+		errs = append(errs, j.record(janitorTaskExpire, deleted, err))
+	}
+	if j.config.purgeOrphans {
+		errs = append(errs, j.purgeOrphans(ctx))
+	}
+	return errors.Join(errs...)
+}
+
+// downsample deletes the logs in batches until a batch is not full, so the table isn't locked for long.
+func (j *observationLogJanitor) downsample(ctx context.Context, before time.Time) error {
+	for ctx.Err() == nil {
+		deleted, err := dbIf.DownsampleObservationLog(before, j.config.downsampleBucket, downsampleBatchSize)
+		if err := j.record(janitorTaskDownsample, deleted, err); err != nil {
+			return err
+		}
+		if deleted < downsampleBatchSize {
+			return nil
+		}
+	}
+	return ctx.Err()
+}
+
+func (j *observationLogJanitor) record(task string, deleted int64, err error) error {
+	if err != nil {
+		j.metrics.errors.WithLabelValues(task).Inc()
+		return err
+	}
+	if deleted > 0 {
+		klog.Infof("Janitor task %s deleted %d observation logs", task, deleted)
+	}
+	j.metrics.deletedLogs.WithLabelValues(task).Add(float64(deleted))
+	return nil
+}
+
+// purgeOrphans deletes the logs of the trials which don't exist anymore.
+// The trials with logs are listed before the cluster trials, so a trial created in between is never purged.
+func (j *observationLogJanitor) purgeOrphans(ctx context.Context) error {
+	logTrials, err := dbIf.ListObservationLogTrials()
+	if err != nil {
+		j.metrics.errors.WithLabelValues(janitorTaskPurge).Inc()
+		return err
+	}
+	trialList := &trialsv1beta1.TrialList{}
+	if err = j.client.List(ctx, trialList); err != nil {
+		j.metrics.errors.WithLabelValues(janitorTaskPurge).Inc()
+		return err
+	}
+	trials := map[types.NamespacedName]bool{}
+	for _, trial := range trialList.Items {
+		trials[types.NamespacedName{Namespace: trial.Namespace, Name: trial.Name}] = true
+	}
+	for _, trial := range logTrials {
+		if trials[trial] {
+			continue
+		}
+		if err = dbIf.DeleteObservationLog(trial.Namespace, trial.Name); err != nil {
+			j.metrics.errors.WithLabelValues(janitorTaskPurge).Inc()
+			return err
+		}
+		klog.Infof("Janitor purged observation logs of deleted trial %s", trial)
+		j.metrics.purgedTrials.Inc()
+	}
+	return nil
+}
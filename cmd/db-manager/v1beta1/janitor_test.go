@@ -0,0 +1,122 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	mockdb "github.com/kubeflow/katib/pkg/mock/v1beta1/db"
+)
+
+func TestJanitorRunOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mockdb.NewMockKatibDBInterface(ctrl)
+	dbIf = mockDB
+
+	scheme := runtime.NewScheme()
+	if err := trialsv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add Trial to scheme: %v", err)
+	}
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&trialsv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "existing-trial",
+			Namespace: "test-namespace",
+		},
+	}).Build()
+
+	now := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	registry := prometheus.NewRegistry()
+	j := newObservationLogJanitor(janitorConfig{
+		interval:         time.Hour,
+		downsampleAfter:  24 * time.Hour,
+		downsampleBucket: time.Minute,
+		ttl:              720 * time.Hour,
+		purgeOrphans:     true,
+	}, kubeClient, registry)
+	j.now = func() time.Time { return now }
+
+	gomock.InOrder(
+		mockDB.EXPECT().DownsampleObservationLog(now.Add(-24*time.Hour), time.Minute, downsampleBatchSize).Return(int64(downsampleBatchSize), nil),
+		mockDB.EXPECT().DownsampleObservationLog(now.Add(-24*time.Hour), time.Minute, downsampleBatchSize).Return(int64(10), nil),
+	)
+	mockDB.EXPECT().ExpireObservationLog(now.Add(-720*time.Hour)).Return(int64(0), errors.New("expire failed"))
+	mockDB.EXPECT().ListObservationLogTrials().Return([]types.NamespacedName{
+		{Namespace: "test-namespace", Name: "existing-trial"},
+		{Namespace: "test-namespace", Name: "deleted-trial"},
+		{Namespace: "other-namespace", Name: "existing-trial"},
+	}, nil)
+	mockDB.EXPECT().DeleteObservationLog("test-namespace", "deleted-trial").Return(nil)
+	mockDB.EXPECT().DeleteObservationLog("other-namespace", "existing-trial").Return(nil)
+
+	if err := j.runOnce(context.Background()); err == nil {
+		t.Errorf("Expected the error of the failed task")
+	}
+	if got := testutil.ToFloat64(j.metrics.deletedLogs.WithLabelValues(janitorTaskDownsample)); got != downsampleBatchSize+10 {
+		t.Errorf("Expected %d downsampled logs, got %v", downsampleBatchSize+10, got)
+	}
+	if got := testutil.ToFloat64(j.metrics.errors.WithLabelValues(janitorTaskExpire)); got != 1 {
+		t.Errorf("Expected 1 expire error, got %v", got)
+	}
+	if got := testutil.ToFloat64(j.metrics.purgedTrials); got != 2 {
+		t.Errorf("Expected 2 purged trials, got %v", got)
+	}
+	if got := testutil.ToFloat64(j.metrics.lastRunTime); got != float64(now.Unix()) {
+		t.Errorf("Expected last run time %v, got %v", now.Unix(), got)
+	}
+}
+
+func TestJanitorConfigEnabled(t *testing.T) {
+	cases := map[string]struct {
+		config janitorConfig
+		want   bool
+	}{
+		"no task": {
+			config: janitorConfig{interval: time.Hour},
+		},
+		"zero interval": {
+			config: janitorConfig{ttl: time.Hour, purgeOrphans: true},
+		},
+		"downsample": {
+			config: janitorConfig{interval: time.Hour, downsampleAfter: time.Hour},
+			want:   true,
+		},
+		"purge orphans": {
+			config: janitorConfig{interval: time.Hour, purgeOrphans: true},
+			want:   true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.config.enabled(); got != tc.want {
+				t.Errorf("Expected enabled %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
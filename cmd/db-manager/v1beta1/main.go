@@ -18,55 +18,185 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configv1beta1 "github.com/kubeflow/katib/pkg/apis/config/v1beta1"
 	health_pb "github.com/kubeflow/katib/pkg/apis/manager/health"
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	db "github.com/kubeflow/katib/pkg/db/v1beta1"
 	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
-	"k8s.io/klog"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibclient"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
 	port                  = "0.0.0.0:6789"
 	defaultConnectTimeout = time.Second * 60
+	defaultStreamPageSize = 1000
+	defaultMetricsAddr    = ":8080"
+	defaultHealthAddr     = ":8081"
+	// httpReadHeaderTimeout limits the time to read the request headers of the metrics and health endpoints,
+	// so that slow clients can't hold the connections open.
+	httpReadHeaderTimeout = time.Second * 10
 )
 
 var dbIf common.KatibDBInterface
 
+var scheme = runtime.NewScheme()
+
+var logBroker = newObservationLogBroker()
+
 type server struct {
 }
 
+func init() {
+	utilruntime.Must(configv1beta1.AddToScheme(scheme))
+}
+
 // Report a log of Observations for a Trial.
 // The log consists of timestamp and value of metric.
 // Katib store every log of metrics.
 // You can see accuracy curve or other metric logs on UI.
 func (s *server) ReportObservationLog(ctx context.Context, in *api_pb.ReportObservationLogRequest) (*api_pb.ReportObservationLogReply, error) {
-	err := dbIf.RegisterObservationLog(in.TrialName, in.ObservationLog)
+	// Logs without namespace would be returned to the trials of every namespace.
+	if in.Namespace == "" {
+		return nil, status.Error(codes.InvalidArgument, "Namespace of the Trial is required")
+	}
+	err := dbIf.RegisterObservationLog(in.Namespace, in.TrialName, in.BatchId, in.ObservationLog)
+	// The batch is retried by the metrics collector, so the duplicate is not an error.
+	if errors.Is(err, common.ErrObservationLogBatchExists) {
+		klog.Infof("ObservationLog batch %s of Trial %s/%s is already registered", in.BatchId, in.Namespace, in.TrialName)
+		return &api_pb.ReportObservationLogReply{}, nil
+	}
+	if err == nil {
+		logBroker.publish(in.Namespace, in.TrialName, in.ObservationLog.GetMetricLogs())
+	}
 	return &api_pb.ReportObservationLogReply{}, err
 }
 
 // Get all log of Observations for a Trial.
 func (s *server) GetObservationLog(ctx context.Context, in *api_pb.GetObservationLogRequest) (*api_pb.GetObservationLogReply, error) {
-	ol, err := dbIf.GetObservationLog(in.TrialName, in.MetricName, in.StartTime, in.EndTime)
+	ol, nextPageToken, err := dbIf.GetObservationLog(in)
 	return &api_pb.GetObservationLogReply{
 		ObservationLog: ol,
+		NextPageToken:  nextPageToken,
 	}, err
 }
 
+// Stream all log of Observations for a Trial page by page.
+// Every reply contains at most page_size logs, defaultStreamPageSize if it isn't set.
+func (s *server) StreamObservationLog(in *api_pb.GetObservationLogRequest, stream api_pb.DBManager_StreamObservationLogServer) error {
+	request := proto.Clone(in).(*api_pb.GetObservationLogRequest)
+	if request.PageSize <= 0 {
+		request.PageSize = defaultStreamPageSize
+	}
+	for {
+		ol, nextPageToken, err := dbIf.GetObservationLog(request)
+		if err != nil {
+			return err
+		}
+		err = stream.Send(&api_pb.GetObservationLogReply{
+			ObservationLog: ol,
+			NextPageToken:  nextPageToken,
+		})
+		if err != nil {
+			return err
+		}
+		if nextPageToken == "" {
+			return nil
+		}
+		request.PageToken = nextPageToken
+	}
+}
+
+// Watch new logs of Observations for a Trial as they are reported.
+// If start_time is set, stored logs since then are sent first, so a watcher can resume
+// from the time of the last log it received. Logs reported while the stored logs are
+// being sent can be received twice.
+func (s *server) WatchObservationLog(in *api_pb.WatchObservationLogRequest, stream api_pb.DBManager_WatchObservationLogServer) error {
+	// Subscribe before reading the stored logs to not miss any log.
+	watcher := logBroker.subscribe(in.Namespace, in.TrialName, in.MetricName)
+	defer logBroker.unsubscribe(watcher)
+
+	if in.StartTime != "" {
+		request := &api_pb.GetObservationLogRequest{
+			TrialName:  in.TrialName,
+			Namespace:  in.Namespace,
+			MetricName: in.MetricName,
+			StartTime:  in.StartTime,
+			PageSize:   defaultStreamPageSize,
+		}
+		for {
+			ol, nextPageToken, err := dbIf.GetObservationLog(request)
+			if err != nil {
+				return err
+			}
+			if len(ol.MetricLogs) != 0 {
+				if err = stream.Send(&api_pb.WatchObservationLogReply{MetricLogs: ol.MetricLogs}); err != nil {
+					return err
+				}
+			}
+			if nextPageToken == "" {
+				break
+			}
+			request.PageToken = nextPageToken
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case logs, ok := <-watcher.logs:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "Watcher is too slow, watch again from the time of the last received log")
+			}
+			if err := stream.Send(&api_pb.WatchObservationLogReply{MetricLogs: logs}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // Delete all log of Observations for a Trial.
 func (s *server) DeleteObservationLog(ctx context.Context, in *api_pb.DeleteObservationLogRequest) (*api_pb.DeleteObservationLogReply, error) {
-	err := dbIf.DeleteObservationLog(in.TrialName)
+	err := dbIf.DeleteObservationLog(in.Namespace, in.TrialName)
 	return &api_pb.DeleteObservationLogReply{}, err
 }
 
+// Get min, max, latest value and number of logs for each metric of a Trial.
+// Summaries of the distributed training workers are aggregated with the requested worker aggregation.
+func (s *server) GetObservationSummary(ctx context.Context, in *api_pb.GetObservationSummaryRequest) (*api_pb.GetObservationSummaryReply, error) {
+	summaries, err := dbIf.GetObservationSummary(in.Namespace, in.TrialName, in.MetricNames)
+	if err != nil {
+		return nil, err
+	}
+	return &api_pb.GetObservationSummaryReply{
+		MetricSummaries: common.AggregateMetricSummaries(summaries, in.WorkerAggregation),
+	}, nil
+}
+
 func (s *server) Check(ctx context.Context, in *health_pb.HealthCheckRequest) (*health_pb.HealthCheckResponse, error) {
 	resp := health_pb.HealthCheckResponse{
 		Status: health_pb.HealthCheckResponse_SERVING,
@@ -88,12 +218,57 @@ func (s *server) Check(ctx context.Context, in *health_pb.HealthCheckRequest) (*
 	return &resp, nil
 }
 
+func newHTTPServer(addr string, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: httpReadHeaderTimeout,
+	}
+}
+
+func newKubeClient() (client.Client, error) {
+	katibClient, err := katibclient.NewClient(client.Options{})
+	if err != nil {
+		return nil, err
+	}
+	return katibClient.GetClient(), nil
+}
+
+// healthz serves the gRPC health check over HTTP, so that the liveness probe
+// works when the gRPC server requires client certificates.
+func healthz(w http.ResponseWriter, r *http.Request) {
+	if _, err := (&server{}).Check(r.Context(), nil); err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
 func main() {
 	var connectTimeout time.Duration
+	var metricsAddr string
+	var healthAddr string
+	var katibConfigFile string
+	var janitor janitorConfig
 	flag.DurationVar(&connectTimeout, "connect-timeout", defaultConnectTimeout, "Timeout before calling error during database connection. (e.g. 120s)")
+	flag.StringVar(&metricsAddr, "metrics-addr", defaultMetricsAddr, "The address the metric endpoint binds to. Set it to empty to disable the endpoint.")
+	flag.StringVar(&healthAddr, "health-addr", defaultHealthAddr, "The address the health probe endpoint binds to. Set it to empty to disable the endpoint.")
+	flag.DurationVar(&janitor.interval, "janitor-interval", time.Hour, "Interval between the observation log clean ups. Set it to 0 to disable the clean up.")
+	flag.DurationVar(&janitor.downsampleAfter, "downsample-after", 0, "Age after which the observation logs are downsampled. Set it to 0 to disable downsampling. (e.g. 24h)")
+	flag.DurationVar(&janitor.downsampleBucket, "downsample-bucket", time.Minute, "Width of the buckets in which the minimum, maximum and latest observation logs are kept.")
+	flag.DurationVar(&janitor.ttl, "observation-log-ttl", 0, "Time after the last observation log of a Trial at which its logs are deleted. Set it to 0 to keep the logs. (e.g. 720h)")
+	flag.StringVar(&katibConfigFile, "katib-config", "", "The katib-db-manager will load the gRPC TLS configuration from this file. Omit this flag to use the default configuration values.")
+	flag.BoolVar(&janitor.purgeOrphans, "purge-orphan-observation-logs", false, "Delete the observation logs of the Trials which don't exist in the cluster, including the logs kept for the Trials killed by the Experiment suspend.")
 	flag.Parse()
 
-	var err error
+	initConfig, err := katibconfig.GetInitConfigData(scheme, katibConfigFile)
+	if err != nil {
+		klog.Fatalf("Failed to get KatibConfig: %v", err)
+	}
+	if initConfig.GRPCTLSConfig.Enable {
+		grpctls.SetCertDir(consts.GRPCCertDir)
+	}
+
 	dbNameEnvName := common.DBNameEnvName
 	dbName := os.Getenv(dbNameEnvName)
 	if dbName == "" {
@@ -103,7 +278,47 @@ func main() {
 	if err != nil {
 		klog.Fatalf("Failed to open db connection: %v", err)
 	}
+	if flag.Arg(0) == "migrate" {
+		if err = runMigrate(flag.Args()[1:], os.Stdout, newKubeClient); err != nil {
+			klog.Fatalf("Failed to migrate DB schema: %v", err)
+		}
+		return
+	}
 	dbIf.DBInit()
+	if err = checkLegacyObservationLogs(); err != nil {
+		klog.Fatalf("Failed to validate observation logs: %v", err)
+	}
+	if janitor.enabled() {
+		if janitor.downsampleAfter > 0 && janitor.downsampleBucket < time.Second {
+			klog.Fatalf("Invalid downsample bucket %v, it must be at least 1s", janitor.downsampleBucket)
+		}
+		var kubeClient client.Client
+		if janitor.purgeOrphans {
+			kubeClient, err = newKubeClient()
+			if err != nil {
+				klog.Fatalf("Failed to create Kubernetes client: %v", err)
+			}
+		}
+		go newObservationLogJanitor(janitor, kubeClient, prometheus.DefaultRegisterer).run(context.Background())
+	}
+	if metricsAddr != "" {
+		go func() {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", promhttp.Handler())
+			if err := newHTTPServer(metricsAddr, mux).ListenAndServe(); err != nil {
+				klog.Fatalf("Failed to serve metrics: %v", err)
+			}
+		}()
+	}
+	if healthAddr != "" {
+		go func() {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/healthz", healthz)
+			if err := newHTTPServer(healthAddr, mux).ListenAndServe(); err != nil {
+				klog.Fatalf("Failed to serve health probe: %v", err)
+			}
+		}()
+	}
 	listener, err := net.Listen("tcp", port)
 	if err != nil {
 		klog.Fatalf("Failed to listen: %v", err)
@@ -111,7 +326,18 @@ func main() {
 
 	size := 1<<31 - 1
 	klog.Infof("Start Katib manager: %s", port)
-	s := grpc.NewServer(grpc.MaxRecvMsgSize(size), grpc.MaxSendMsgSize(size))
+	serverOpts, err := grpctls.ServerOptions()
+	if err != nil {
+		klog.Fatalf("Failed to set up TLS: %v", err)
+	}
+	serverOpts = append(serverOpts,
+		grpc.MaxRecvMsgSize(size),
+		grpc.MaxSendMsgSize(size),
+		// Clients with the namespace certificate can only access the Trials of their namespace.
+		grpc.ChainUnaryInterceptor(grpctls.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(grpctls.StreamServerInterceptor()),
+	)
+	s := grpc.NewServer(serverOpts...)
 	api_pb.RegisterDBManagerServer(s, &server{})
 	health_pb.RegisterHealthServer(s, &server{})
 	reflection.Register(s)
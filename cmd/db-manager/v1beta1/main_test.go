@@ -17,13 +17,27 @@ limitations under the License.
 package main
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	health_pb "github.com/kubeflow/katib/pkg/apis/manager/health"
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
 	mockdb "github.com/kubeflow/katib/pkg/mock/v1beta1/db"
 )
 
@@ -36,6 +50,7 @@ func TestReportObservationLog(t *testing.T) {
 
 	req := &api_pb.ReportObservationLogRequest{
 		TrialName: "test1-trial1",
+		Namespace: "test-namespace",
 		ObservationLog: &api_pb.ObservationLog{
 			MetricLogs: []*api_pb.MetricLog{
 				{
@@ -69,11 +84,17 @@ func TestReportObservationLog(t *testing.T) {
 			},
 		},
 	}
-	mockDB.EXPECT().RegisterObservationLog(req.TrialName, req.ObservationLog).Return(nil)
+	mockDB.EXPECT().RegisterObservationLog(req.Namespace, req.TrialName, req.BatchId, req.ObservationLog).Return(nil)
 	_, err := s.ReportObservationLog(context.Background(), req)
 	if err != nil {
 		t.Fatalf("ReportObservationLog Error %v", err)
 	}
+
+	// Logs without namespace are rejected.
+	req.Namespace = ""
+	if _, err = s.ReportObservationLog(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for report without namespace, got %v", err)
+	}
 }
 
 func TestGetObservationLog(t *testing.T) {
@@ -85,6 +106,7 @@ func TestGetObservationLog(t *testing.T) {
 
 	req := &api_pb.GetObservationLogRequest{
 		TrialName: "test1-trial1",
+		Namespace: "test-namespace",
 		StartTime: "2019-02-03T03:05:06+09:00",
 		EndTime:   "2019-02-03T05:05:06+09:00",
 	}
@@ -122,7 +144,7 @@ func TestGetObservationLog(t *testing.T) {
 		},
 	}
 
-	mockDB.EXPECT().GetObservationLog(req.TrialName, req.MetricName, req.StartTime, req.EndTime).Return(obs, nil)
+	mockDB.EXPECT().GetObservationLog(protoEq(req)).Return(obs, "", nil)
 	ret, err := s.GetObservationLog(context.Background(), req)
 	if err != nil {
 		t.Fatalf("GetObservationLog Error %v", err)
@@ -132,6 +154,92 @@ func TestGetObservationLog(t *testing.T) {
 	}
 }
 
+// protoMatcher matches proto messages by content rather than by pointer.
+type protoMatcher struct {
+	want proto.Message
+}
+
+func protoEq(want proto.Message) gomock.Matcher {
+	return protoMatcher{want: want}
+}
+
+func (m protoMatcher) Matches(x interface{}) bool {
+	got, ok := x.(proto.Message)
+	return ok && proto.Equal(m.want, got)
+}
+
+func (m protoMatcher) String() string {
+	return fmt.Sprintf("is equal to %v", m.want)
+}
+
+type fakeObservationLogStream struct {
+	grpc.ServerStream
+	replies []*api_pb.GetObservationLogReply
+}
+
+func (s *fakeObservationLogStream) Send(reply *api_pb.GetObservationLogReply) error {
+	s.replies = append(s.replies, reply)
+	return nil
+}
+
+func TestStreamObservationLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := &server{}
+	mockDB := mockdb.NewMockKatibDBInterface(ctrl)
+	dbIf = mockDB
+
+	req := &api_pb.GetObservationLogRequest{
+		TrialName: "test1-trial1",
+		Namespace: "test-namespace",
+	}
+	firstPage := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			{
+				TimeStamp: "2019-02-03T04:05:06+09:00",
+				Metric: &api_pb.Metric{
+					Name:  "f1_score",
+					Value: "88.95",
+				},
+			},
+		},
+	}
+	secondPage := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			{
+				TimeStamp: "2019-02-03T04:05:07+09:00",
+				Metric: &api_pb.Metric{
+					Name:  "f1_score",
+					Value: "89.2",
+				},
+			},
+		},
+	}
+	gomock.InOrder(
+		mockDB.EXPECT().GetObservationLog(protoEq(&api_pb.GetObservationLogRequest{
+			TrialName: req.TrialName,
+			Namespace: req.Namespace,
+			PageSize:  defaultStreamPageSize,
+		})).Return(firstPage, "token", nil),
+		mockDB.EXPECT().GetObservationLog(protoEq(&api_pb.GetObservationLogRequest{
+			TrialName: req.TrialName,
+			Namespace: req.Namespace,
+			PageSize:  defaultStreamPageSize,
+			PageToken: "token",
+		})).Return(secondPage, "", nil),
+	)
+	stream := &fakeObservationLogStream{}
+	if err := s.StreamObservationLog(req, stream); err != nil {
+		t.Fatalf("StreamObservationLog Error %v", err)
+	}
+	if len(stream.replies) != 2 {
+		t.Fatalf("StreamObservationLog Test fail expect replies number 2 got %d", len(stream.replies))
+	}
+	if stream.replies[0].NextPageToken != "token" || stream.replies[1].NextPageToken != "" {
+		t.Errorf("StreamObservationLog Test fail unexpected page tokens %v", stream.replies)
+	}
+}
+
 func TestDeleteObservationLog(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -141,14 +249,53 @@ func TestDeleteObservationLog(t *testing.T) {
 
 	req := &api_pb.DeleteObservationLogRequest{
 		TrialName: "test1-trial1",
+		Namespace: "test-namespace",
 	}
-	mockDB.EXPECT().DeleteObservationLog(req.TrialName).Return(nil)
+	mockDB.EXPECT().DeleteObservationLog(req.Namespace, req.TrialName).Return(nil)
 	_, err := s.DeleteObservationLog(context.Background(), req)
 	if err != nil {
 		t.Fatalf("DeleteExperiment Error %v", err)
 	}
 }
 
+func TestGetObservationSummary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := &server{}
+	mockDB := mockdb.NewMockKatibDBInterface(ctrl)
+	dbIf = mockDB
+
+	req := &api_pb.GetObservationSummaryRequest{
+		TrialName:         "test1-trial1",
+		Namespace:         "test-namespace",
+		MetricNames:       []string{"f1_score", "loss"},
+		WorkerAggregation: api_pb.WorkerAggregation_MEAN,
+	}
+	summaries := []*api_pb.MetricSummary{
+		{Name: "f1_score", Min: "88.7", Max: "89.2", Latest: "88.95", Count: 3, Worker: "worker-0"},
+		{Name: "f1_score", Min: "88.5", Max: "89", Latest: "88.75", Count: 3, Worker: "worker-1"},
+		{Name: "loss", Min: "0.5", Max: "0.5", Latest: "0.5", Count: 1},
+	}
+	expected := []*api_pb.MetricSummary{
+		{Name: "f1_score", Min: "88.6", Max: "89.1", Latest: "88.85", Count: 6},
+		{Name: "loss", Min: "0.5", Max: "0.5", Latest: "0.5", Count: 1},
+	}
+
+	mockDB.EXPECT().GetObservationSummary(req.Namespace, req.TrialName, req.MetricNames).Return(summaries, nil)
+	ret, err := s.GetObservationSummary(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetObservationSummary Error %v", err)
+	}
+	if len(expected) != len(ret.MetricSummaries) {
+		t.Fatalf("GetObservationSummary Test fail expect summaries number %d got %d", len(expected), len(ret.MetricSummaries))
+	}
+	for i := range expected {
+		if !proto.Equal(expected[i], ret.MetricSummaries[i]) {
+			t.Errorf("GetObservationSummary Test fail expect summary %v got %v", expected[i], ret.MetricSummaries[i])
+		}
+	}
+}
+
 func TestCheck(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -185,3 +332,143 @@ func TestCheck(t *testing.T) {
 		}
 	}
 }
+
+func TestHealthz(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mockdb.NewMockKatibDBInterface(ctrl)
+	dbIf = mockDB
+
+	gomock.InOrder(
+		mockDB.EXPECT().SelectOne().Return(nil),
+		mockDB.EXPECT().SelectOne().Return(fmt.Errorf("connection refused")),
+	)
+
+	for _, expectedCode := range []int{http.StatusOK, http.StatusServiceUnavailable} {
+		rec := httptest.NewRecorder()
+		healthz(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+		if rec.Code != expectedCode {
+			t.Errorf("Expected status %d, got %d", expectedCode, rec.Code)
+		}
+	}
+}
+
+func TestRunMigrate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mockdb.NewMockKatibDBInterface(ctrl)
+	dbIf = mockDB
+
+	mockDB.EXPECT().MigrateUp().Return(nil)
+	mockDB.EXPECT().MigrateDown(1).Return(nil)
+	mockDB.EXPECT().MigrateDown(3).Return(nil)
+	mockDB.EXPECT().MigrationStatus().Return(&common.MigrationStatus{
+		CurrentVersion: 2,
+		LatestVersion:  3,
+		Pending: []common.Migration{
+			{Version: 3, Description: "Add index"},
+		},
+	}, nil)
+	mockDB.EXPECT().ListLegacyObservationLogTrials().Return([]string{"trial-a", "trial-b", "trial-c"}, nil)
+	mockDB.EXPECT().BackfillObservationLogNamespace("namespace-1", "trial-a").Return(int64(3), nil)
+	mockDB.EXPECT().BackfillObservationLogNamespace("namespace-2", "trial-b").Return(int64(2), nil)
+
+	scheme := runtime.NewScheme()
+	if err := trialsv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add Trial to scheme: %v", err)
+	}
+	newKubeClient := func() (client.Client, error) {
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&trialsv1beta1.Trial{ObjectMeta: metav1.ObjectMeta{Name: "trial-a", Namespace: "namespace-1"}},
+			&trialsv1beta1.Trial{ObjectMeta: metav1.ObjectMeta{Name: "trial-b", Namespace: "namespace-2"}},
+			&trialsv1beta1.Trial{ObjectMeta: metav1.ObjectMeta{Name: "trial-b", Namespace: "namespace-1"}},
+		).Build(), nil
+	}
+
+	testCases := []struct {
+		Name           string
+		Args           []string
+		Err            bool
+		ExpectedOutput string
+	}{
+		{
+			Name: "Migrate up",
+			Args: []string{"up"},
+		},
+		{
+			Name: "Migrate down one step by default",
+			Args: []string{"down"},
+		},
+		{
+			Name: "Migrate down several steps",
+			Args: []string{"down", "3"},
+		},
+		{
+			Name:           "Migration status",
+			Args:           []string{"status"},
+			ExpectedOutput: "Current version: 2\nLatest version: 3\nPending migration 3: Add index\n",
+		},
+		{
+			Name: "Backfill namespace of the found trials",
+			Args: []string{"backfill-namespace"},
+			Err:  true,
+			ExpectedOutput: "Backfilled namespace of 3 logs of trial namespace-1/trial-a\n" +
+				"Skipped trial trial-b, it exists in namespaces namespace-1, namespace-2\n" +
+				"Skipped trial trial-c, it doesn't exist\n",
+		},
+		{
+			Name:           "Backfill namespace of a trial",
+			Args:           []string{"backfill-namespace", "namespace-2", "trial-b"},
+			ExpectedOutput: "Backfilled namespace of 2 logs of trial namespace-2/trial-b\n",
+		},
+		{
+			Name: "Backfill namespace without trial name",
+			Args: []string{"backfill-namespace", "namespace-2"},
+			Err:  true,
+		},
+		{
+			Name: "Invalid number of steps",
+			Args: []string{"down", "0"},
+			Err:  true,
+		},
+		{
+			Name: "Missing direction",
+			Args: []string{},
+			Err:  true,
+		},
+		{
+			Name: "Unknown direction",
+			Args: []string{"sideways"},
+			Err:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		out := &bytes.Buffer{}
+		err := runMigrate(tc.Args, out, newKubeClient)
+		if tc.Err && err == nil {
+			t.Errorf("Case %v failed. Expected error, got nil", tc.Name)
+		} else if !tc.Err && err != nil {
+			t.Errorf("Case %v failed. Expected nil, got %v", tc.Name, err)
+		}
+		if out.String() != tc.ExpectedOutput {
+			t.Errorf("Case %v failed. Expected output %q, got %q", tc.Name, tc.ExpectedOutput, out.String())
+		}
+	}
+}
+
+func TestCheckLegacyObservationLogs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mockdb.NewMockKatibDBInterface(ctrl)
+	dbIf = mockDB
+
+	mockDB.EXPECT().ListLegacyObservationLogTrials().Return([]string{}, nil)
+	if err := checkLegacyObservationLogs(); err != nil {
+		t.Errorf("Expected nil without legacy logs, got %v", err)
+	}
+	mockDB.EXPECT().ListLegacyObservationLogTrials().Return([]string{"trial-a"}, nil)
+	if err := checkLegacyObservationLogs(); err == nil {
+		t.Errorf("Expected error with legacy logs, got nil")
+	}
+}
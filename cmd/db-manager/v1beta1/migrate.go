@@ -0,0 +1,151 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+const migrateUsage = "usage: katib-db-manager migrate up|down [steps]|status|backfill-namespace [namespace trial-name]"
+
+// runMigrate runs the `migrate` sub-command against the Katib DB.
+// `down` reverts one migration unless the number of steps is given.
+// `backfill-namespace` sets the namespace of the legacy logs of the given trial, or of every trial
+// whose namespace is found with the Kubernetes client.
+func runMigrate(args []string, out io.Writer, newKubeClient func() (client.Client, error)) error {
+	if len(args) == 0 {
+		return fmt.Errorf(migrateUsage)
+	}
+	switch args[0] {
+	case "up":
+		if len(args) != 1 {
+			return fmt.Errorf(migrateUsage)
+		}
+		return dbIf.MigrateUp()
+	case "down":
+		steps := 1
+		if len(args) == 2 {
+			var err error
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				return fmt.Errorf("Invalid number of steps %q, it must be a positive integer", args[1])
+			}
+		} else if len(args) > 2 {
+			return fmt.Errorf(migrateUsage)
+		}
+		return dbIf.MigrateDown(steps)
+	case "status":
+		if len(args) != 1 {
+			return fmt.Errorf(migrateUsage)
+		}
+		status, err := dbIf.MigrationStatus()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Current version: %d\n", status.CurrentVersion)
+		fmt.Fprintf(out, "Latest version: %d\n", status.LatestVersion)
+		for _, migration := range status.Pending {
+			fmt.Fprintf(out, "Pending migration %d: %s\n", migration.Version, migration.Description)
+		}
+		return nil
+	case "backfill-namespace":
+		switch len(args) {
+		case 1:
+			kubeClient, err := newKubeClient()
+			if err != nil {
+				return err
+			}
+			return backfillNamespace(context.Background(), kubeClient, out)
+		case 3:
+			updated, err := dbIf.BackfillObservationLogNamespace(args[1], args[2])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "Backfilled namespace of %d logs of trial %s/%s\n", updated, args[1], args[2])
+			return nil
+		default:
+			return fmt.Errorf(migrateUsage)
+		}
+	default:
+		return fmt.Errorf(migrateUsage)
+	}
+}
+
+// backfillNamespace sets the namespace of the logs stored before the logs were scoped by namespace.
+// The logs only have the trial name, so the namespace is taken from the only trial with this name.
+// The trials which don't exist or exist in several namespaces are reported, so that their namespace is set explicitly.
+func backfillNamespace(ctx context.Context, kubeClient client.Client, out io.Writer) error {
+	legacyTrials, err := dbIf.ListLegacyObservationLogTrials()
+	if err != nil || len(legacyTrials) == 0 {
+		return err
+	}
+	trialList := &trialsv1beta1.TrialList{}
+	if err = kubeClient.List(ctx, trialList); err != nil {
+		return err
+	}
+	namespaces := map[string][]string{}
+	for _, trial := range trialList.Items {
+		namespaces[trial.Name] = append(namespaces[trial.Name], trial.Namespace)
+	}
+
+	var unresolved []string
+	for _, trialName := range legacyTrials {
+		switch len(namespaces[trialName]) {
+		case 0:
+			fmt.Fprintf(out, "Skipped trial %s, it doesn't exist\n", trialName)
+			unresolved = append(unresolved, trialName)
+		case 1:
+			namespace := namespaces[trialName][0]
+			updated, err := dbIf.BackfillObservationLogNamespace(namespace, trialName)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "Backfilled namespace of %d logs of trial %s/%s\n", updated, namespace, trialName)
+		default:
+			sort.Strings(namespaces[trialName])
+			fmt.Fprintf(out, "Skipped trial %s, it exists in namespaces %s\n", trialName, strings.Join(namespaces[trialName], ", "))
+			unresolved = append(unresolved, trialName)
+		}
+	}
+	if len(unresolved) != 0 {
+		return fmt.Errorf("Namespace of trials %s is unknown, set it with `katib-db-manager migrate backfill-namespace <namespace> <trial-name>`",
+			strings.Join(unresolved, ", "))
+	}
+	return nil
+}
+
+// checkLegacyObservationLogs returns an error if logs are stored without namespace,
+// because they are not returned to any trial until their namespace is backfilled.
+func checkLegacyObservationLogs() error {
+	legacyTrials, err := dbIf.ListLegacyObservationLogTrials()
+	if err != nil {
+		return err
+	}
+	if len(legacyTrials) != 0 {
+		return fmt.Errorf("Logs of %d trials have no namespace, run `katib-db-manager migrate backfill-namespace` to set it", len(legacyTrials))
+	}
+	return nil
+}
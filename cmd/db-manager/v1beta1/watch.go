@@ -0,0 +1,114 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"sync"
+
+	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+// watcherBufferSize is the number of reports buffered for a watcher before it is dropped.
+const watcherBufferSize = 100
+
+// observationLogWatcher receives the logs reported for a Trial.
+// Its channel is closed if the watcher doesn't keep up with the reports.
+type observationLogWatcher struct {
+	namespace  string
+	trialName  string
+	metricName string
+	logs       chan []*api_pb.MetricLog
+}
+
+// observationLogBroker fans out the reported logs to the watchers.
+// It only knows about the logs reported to this DB manager replica,
+// so the DB manager Deployment is limited to a single replica.
+type observationLogBroker struct {
+	mu       sync.Mutex
+	watchers map[string]map[*observationLogWatcher]bool
+}
+
+func newObservationLogBroker() *observationLogBroker {
+	return &observationLogBroker{
+		watchers: map[string]map[*observationLogWatcher]bool{},
+	}
+}
+
+func (b *observationLogBroker) subscribe(namespace, trialName, metricName string) *observationLogWatcher {
+	w := &observationLogWatcher{
+		namespace:  namespace,
+		trialName:  trialName,
+		metricName: metricName,
+		logs:       make(chan []*api_pb.MetricLog, watcherBufferSize),
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.watchers[trialName] == nil {
+		b.watchers[trialName] = map[*observationLogWatcher]bool{}
+	}
+	b.watchers[trialName][w] = true
+	return w
+}
+
+func (b *observationLogBroker) unsubscribe(w *observationLogWatcher) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.remove(w)
+}
+
+// remove must be called with the lock held.
+func (b *observationLogBroker) remove(w *observationLogWatcher) {
+	if !b.watchers[w.trialName][w] {
+		return
+	}
+	delete(b.watchers[w.trialName], w)
+	if len(b.watchers[w.trialName]) == 0 {
+		delete(b.watchers, w.trialName)
+	}
+	close(w.logs)
+}
+
+// publish sends the stored logs to the watchers of the Trial.
+// Logs reported without namespace are sent to the watchers of every namespace.
+func (b *observationLogBroker) publish(namespace, trialName string, metricLogs []*api_pb.MetricLog) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for w := range b.watchers[trialName] {
+		if namespace != "" && namespace != w.namespace {
+			continue
+		}
+		logs := []*api_pb.MetricLog{}
+		for _, mlog := range metricLogs {
+			// Logs without timestamp are not stored.
+			if mlog.TimeStamp == "" {
+				continue
+			}
+			if w.metricName == "" || w.metricName == mlog.Metric.Name {
+				logs = append(logs, mlog)
+			}
+		}
+		if len(logs) == 0 {
+			continue
+		}
+		select {
+		case w.logs <- logs:
+		default:
+			// Don't block the reports because of a slow watcher.
+			b.remove(w)
+		}
+	}
+}
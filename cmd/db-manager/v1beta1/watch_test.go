@@ -0,0 +1,206 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	mockdb "github.com/kubeflow/katib/pkg/mock/v1beta1/db"
+)
+
+func newTestMetricLog(timeStamp, name, value string) *api_pb.MetricLog {
+	return &api_pb.MetricLog{
+		TimeStamp: timeStamp,
+		Metric: &api_pb.Metric{
+			Name:  name,
+			Value: value,
+		},
+	}
+}
+
+func TestObservationLogBroker(t *testing.T) {
+	b := newObservationLogBroker()
+	allMetrics := b.subscribe("test-namespace", "test1-trial1", "")
+	lossOnly := b.subscribe("test-namespace", "test1-trial1", "loss")
+	otherNamespace := b.subscribe("other-namespace", "test1-trial1", "")
+
+	b.publish("test-namespace", "test1-trial1", []*api_pb.MetricLog{
+		newTestMetricLog("2019-02-03T04:05:06+09:00", "f1_score", "88.95"),
+		newTestMetricLog("2019-02-03T04:05:06+09:00", "loss", "0.5"),
+		newTestMetricLog("", "loss", "0.4"),
+	})
+	b.publish("test-namespace", "test1-trial2", []*api_pb.MetricLog{
+		newTestMetricLog("2019-02-03T04:05:06+09:00", "loss", "0.3"),
+	})
+	// Logs reported without namespace are sent to every namespace.
+	b.publish("", "test1-trial1", []*api_pb.MetricLog{
+		newTestMetricLog("2019-02-03T04:05:07+09:00", "f1_score", "89.2"),
+	})
+
+	testCases := []struct {
+		Name     string
+		Watcher  *observationLogWatcher
+		Expected [][]string
+	}{
+		{
+			Name:     "All metrics",
+			Watcher:  allMetrics,
+			Expected: [][]string{{"88.95", "0.5"}, {"89.2"}},
+		},
+		{
+			Name:     "Metric filter",
+			Watcher:  lossOnly,
+			Expected: [][]string{{"0.5"}},
+		},
+		{
+			Name:     "Other namespace",
+			Watcher:  otherNamespace,
+			Expected: [][]string{{"89.2"}},
+		},
+	}
+	for _, tc := range testCases {
+		b.unsubscribe(tc.Watcher)
+		var got [][]string
+		for logs := range tc.Watcher.logs {
+			values := []string{}
+			for _, mlog := range logs {
+				values = append(values, mlog.Metric.Value)
+			}
+			got = append(got, values)
+		}
+		if len(got) != len(tc.Expected) {
+			t.Errorf("Case %v failed. Expected %v, got %v", tc.Name, tc.Expected, got)
+			continue
+		}
+		for i := range got {
+			if len(got[i]) != len(tc.Expected[i]) {
+				t.Errorf("Case %v failed. Expected %v, got %v", tc.Name, tc.Expected, got)
+				break
+			}
+			for j := range got[i] {
+				if got[i][j] != tc.Expected[i][j] {
+					t.Errorf("Case %v failed. Expected %v, got %v", tc.Name, tc.Expected, got)
+				}
+			}
+		}
+	}
+	if len(b.watchers) != 0 {
+		t.Errorf("Expected no watchers after unsubscribe, got %v", b.watchers)
+	}
+}
+
+func TestObservationLogBrokerSlowWatcher(t *testing.T) {
+	b := newObservationLogBroker()
+	w := b.subscribe("test-namespace", "test1-trial1", "")
+	for i := 0; i <= watcherBufferSize; i++ {
+		b.publish("test-namespace", "test1-trial1", []*api_pb.MetricLog{
+			newTestMetricLog("2019-02-03T04:05:06+09:00", "loss", "0.5"),
+		})
+	}
+	received := 0
+	for range w.logs {
+		received++
+	}
+	if received != watcherBufferSize {
+		t.Errorf("Expected %d buffered reports, got %d", watcherBufferSize, received)
+	}
+	// Unsubscribe after the watcher has been dropped must not panic.
+	b.unsubscribe(w)
+}
+
+type fakeWatchObservationLogStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	replies chan *api_pb.WatchObservationLogReply
+}
+
+func (s *fakeWatchObservationLogStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchObservationLogStream) Send(reply *api_pb.WatchObservationLogReply) error {
+	s.replies <- reply
+	return nil
+}
+
+func TestWatchObservationLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := &server{}
+	mockDB := mockdb.NewMockKatibDBInterface(ctrl)
+	dbIf = mockDB
+
+	req := &api_pb.WatchObservationLogRequest{
+		TrialName:  "test1-trial1",
+		Namespace:  "test-namespace",
+		MetricName: "loss",
+		StartTime:  "2019-02-03T04:05:06+09:00",
+	}
+	stored := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			newTestMetricLog("2019-02-03T04:05:06+09:00", "loss", "0.5"),
+		},
+	}
+	mockDB.EXPECT().GetObservationLog(protoEq(&api_pb.GetObservationLogRequest{
+		TrialName:  req.TrialName,
+		Namespace:  req.Namespace,
+		MetricName: req.MetricName,
+		StartTime:  req.StartTime,
+		PageSize:   defaultStreamPageSize,
+	})).Return(stored, "", nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &fakeWatchObservationLogStream{
+		ctx:     ctx,
+		replies: make(chan *api_pb.WatchObservationLogReply, 10),
+	}
+	done := make(chan error)
+	go func() {
+		done <- s.WatchObservationLog(req, stream)
+	}()
+
+	reply := <-stream.replies
+	if len(reply.MetricLogs) != 1 || reply.MetricLogs[0].Metric.Value != "0.5" {
+		t.Errorf("WatchObservationLog Test fail unexpected stored logs %v", reply.MetricLogs)
+	}
+
+	// The stored logs are sent after subscribing, so the new log can't be missed.
+	logBroker.publish(req.Namespace, req.TrialName, []*api_pb.MetricLog{
+		newTestMetricLog("2019-02-03T04:05:07+09:00", "loss", "0.4"),
+	})
+	select {
+	case reply = <-stream.replies:
+		if len(reply.MetricLogs) != 1 || reply.MetricLogs[0].Metric.Value != "0.4" {
+			t.Errorf("WatchObservationLog Test fail unexpected new logs %v", reply.MetricLogs)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("WatchObservationLog Test fail timeout waiting for new logs")
+	}
+
+	cancel()
+	if err := <-done; status.Code(err) != codes.Canceled {
+		t.Errorf("WatchObservationLog Test fail expected canceled error, got %v", err)
+	}
+}
@@ -20,6 +20,7 @@ import grpc
 
 from pkg.apis.manager.v1beta1.python import api_pb2_grpc
 from pkg.earlystopping.v1beta1.medianstop.service import MedianStopService
+from pkg.util.v1beta1.grpctls import grpctls
 
 _ONE_DAY_IN_SECONDS = 60 * 60 * 24
 DEFAULT_PORT = "0.0.0.0:6788"
@@ -33,7 +34,7 @@ def serve():
     service = MedianStopService()
     api_pb2_grpc.add_EarlyStoppingServicer_to_server(service, server)
 
-    server.add_insecure_port(DEFAULT_PORT)
+    grpctls.add_port(server, DEFAULT_PORT)
     logger.info("Start Median Stop service at address {}".format(DEFAULT_PORT))
     server.start()
     try:
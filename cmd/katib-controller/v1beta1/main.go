@@ -24,8 +24,12 @@ import (
 	"os"
 
 	"github.com/spf13/viper"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -40,6 +44,7 @@ import (
 	cert "github.com/kubeflow/katib/pkg/certgenerator/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
 	webhookv1beta1 "github.com/kubeflow/katib/pkg/webhook/v1beta1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -83,6 +88,10 @@ func main() {
 		os.Exit(1)
 	}
 	viper.Set(consts.ConfigTrialResources, trialGVKs)
+	viper.Set(consts.ConfigGRPCTLSEnable, initConfig.GRPCTLSConfig.Enable)
+	if initConfig.GRPCTLSConfig.Enable {
+		grpctls.SetCertDir(consts.GRPCCertDir)
+	}
 
 	log.Info("Config:",
 		consts.ConfigExperimentSuggestionName,
@@ -99,6 +108,8 @@ func main() {
 		viper.GetBool(consts.ConfigEnableGRPCProbeInSuggestion),
 		"trial-resources",
 		viper.Get(consts.ConfigTrialResources),
+		consts.ConfigGRPCTLSEnable,
+		viper.GetBool(consts.ConfigGRPCTLSEnable),
 	)
 
 	// Get a config to talk to the apiserver
@@ -117,6 +128,15 @@ func main() {
 		LeaderElection:         initConfig.ControllerConfig.EnableLeaderElection,
 		LeaderElectionID:       initConfig.ControllerConfig.LeaderElectionID,
 		Scheme:                 scheme,
+		Cache: cache.Options{
+			ByObject: map[client.Object]cache.ByObject{
+				// The controller only reads the Secrets with the gRPC certs,
+				// so we don't cache the other Secrets in the cluster.
+				&corev1.Secret{}: {
+					Label: labels.SelectorFromSet(labels.Set{consts.LabelComponent: consts.LabelGRPCCertValue}),
+				},
+			},
+		},
 	})
 	if err != nil {
 		log.Error(err, "Failed to create the manager")
@@ -134,10 +154,11 @@ func main() {
 	ctx := signals.SetupSignalHandler()
 	certsReady := make(chan struct{})
 	defer close(certsReady)
+	grpcCertsReady := make(chan struct{})
 
 	// The setupControllers will register controllers to the manager
-	// after generated certs for the admission webhooks.
-	go setupControllers(mgr, certsReady, hookServer)
+	// after generated certs for the admission webhooks and the gRPC services.
+	go setupControllers(mgr, certsReady, grpcCertsReady, hookServer)
 
 	if initConfig.CertGeneratorConfig.Enable {
 		if err = cert.AddToManager(mgr, initConfig.CertGeneratorConfig, certsReady); err != nil {
@@ -147,6 +168,16 @@ func main() {
 		certsReady <- struct{}{}
 	}
 
+	// Certs for the gRPC services are generated only when the cert-generator is enabled.
+	// Otherwise, they must be provided in the mounted secret.
+	if initConfig.CertGeneratorConfig.Enable && initConfig.GRPCTLSConfig.Enable {
+		if err = cert.AddGRPCCertToManager(mgr, initConfig.GRPCTLSConfig, grpcCertsReady); err != nil {
+			log.Error(err, "Failed to set up cert-generator for gRPC services")
+		}
+	} else {
+		close(grpcCertsReady)
+	}
+
 	log.Info("Setting up health checker.")
 	if err := mgr.AddReadyzCheck("readyz", hookServer.StartedChecker()); err != nil {
 		log.Error(err, "Unable to add readyz endpoint to the manager")
@@ -165,9 +196,10 @@ func main() {
 	}
 }
 
-func setupControllers(mgr manager.Manager, certsReady chan struct{}, hookServer webhook.Server) {
-	// The certsReady blocks to register controllers until generated certs.
+func setupControllers(mgr manager.Manager, certsReady, grpcCertsReady chan struct{}, hookServer webhook.Server) {
+	// The certsReady and grpcCertsReady block to register controllers until generated certs.
 	<-certsReady
+	<-grpcCertsReady
 	log.Info("Certs ready")
 
 	// Setup all Controllers
@@ -33,18 +33,19 @@ For example, the objective value name is F1 and the metrics are loss, your train
      F1=0.7
      ---
 The metrics collector will collect all logs of metrics.
+The metrics file is parsed while the training is running, including across log rotation,
+and the collected metrics are reported to the DB manager on every flush interval.
 */
 
 package main
 
 import (
 	"context"
-	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
-	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -52,50 +53,32 @@ import (
 	"github.com/nxadm/tail"
 	psutil "github.com/shirou/gopsutil/v3/process"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"k8s.io/klog"
 
 	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	api "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
 	filemc "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/file-metricscollector"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 )
 
-type stopRulesFlag []commonv1beta1.EarlyStoppingRule
+// selectorsFlag is the list of the metric value selectors in the <metric_name>=<path> format.
+type selectorsFlag map[string]string
 
-func (flag *stopRulesFlag) String() string {
-	stopRuleStrings := []string{}
-	for _, r := range *flag {
-		stopRuleStrings = append(stopRuleStrings, r.Name)
-		stopRuleStrings = append(stopRuleStrings, r.Value)
-		stopRuleStrings = append(stopRuleStrings, string(r.Comparison))
-		stopRuleStrings = append(stopRuleStrings, strconv.Itoa(r.StartStep))
+func (flag selectorsFlag) String() string {
+	selectors := make([]string, 0, len(flag))
+	for name, path := range flag {
+		selectors = append(selectors, name+"="+path)
 	}
-	return strings.Join(stopRuleStrings, ";")
+	return strings.Join(selectors, ",")
 }
 
-func (flag *stopRulesFlag) Set(value string) error {
-	stopRuleParsed := strings.Split(value, ";")
-	if len(stopRuleParsed) != 4 {
-		return fmt.Errorf("Invalid Early Stopping rule: %v", value)
+func (flag selectorsFlag) Set(value string) error {
+	name, path, found := strings.Cut(value, "=")
+	if !found || name == "" {
+		return fmt.Errorf("selector must be in the <metric_name>=<path> format: %s", value)
 	}
-
-	// Get int start step.
-	startStep, err := strconv.Atoi(stopRuleParsed[3])
-	if err != nil {
-		klog.Fatalf("Parse start step: %v to int error: %v", stopRuleParsed[3], err)
-	}
-
-	// For each stop rule this order: 1 - metric name, 2 - metric value, 3 - comparison type, 4 - start step.
-	// Start step is equal to 0, if it's not defined.
-	stopRule := commonv1beta1.EarlyStoppingRule{
-		Name:       stopRuleParsed[0],
-		Value:      stopRuleParsed[1],
-		Comparison: commonv1beta1.ComparisonType(stopRuleParsed[2]),
-		StartStep:  startStep,
-	}
-
-	*flag = append(*flag, stopRule)
+	flag[name] = path
 	return nil
 }
 
@@ -103,15 +86,24 @@ var (
 	dbManagerServiceAddr = flag.String("s-db", "", "Katib DB Manager service endpoint")
 	earlyStopServiceAddr = flag.String("s-earlystop", "", "Katib Early Stopping service endpoint")
 	trialName            = flag.String("t", "", "Trial Name")
+	trialNamespace       = flag.String("t-ns", "", "Trial Namespace")
 	metricsFilePath      = flag.String("path", "", "Metrics File Path")
 	metricsFileFormat    = flag.String("format", "", "Metrics File Format")
 	metricNames          = flag.String("m", "", "Metric names")
 	objectiveType        = flag.String("o-type", "", "Objective type")
 	metricFilters        = flag.String("f", "", "Metric filters")
+	timestampColumn      = flag.String("timestamp-column", "", "Timestamp column in the CSV format metrics file")
+	stepColumn           = flag.String("step-column", "", "Step column in the CSV format metrics file")
+	timestampFormat      = flag.String("timestamp-format", "", "Timestamp format in the TEXT format metrics file")
+	flushInterval        = flag.Duration("flush-interval", common.DefaultFlushInterval, "Interval between the metrics reports to DB manager")
+	reportTimeout        = flag.Duration("report-timeout", common.DefaultReportTimeout, "Timeout of the metrics report retries once the training is completed")
 	pollInterval         = flag.Duration("p", common.DefaultPollInterval, "Poll interval between running processes check")
 	timeout              = flag.Duration("timeout", common.DefaultTimeout, "Timeout before invoke error during running processes check")
 	waitAllProcesses     = flag.String("w", common.DefaultWaitAllProcesses, "Whether wait for all other main process of container exiting")
-	stopRules            stopRulesFlag
+	nativeSidecar        = flag.Bool("native-sidecar", false, "Whether metrics collector is injected as the native sidecar container")
+	worker               = flag.String("worker", "", "Name of the distributed training worker which reports the metrics")
+	stopRules            common.StopRulesFlag
+	selectors            = selectorsFlag{}
 	isEarlyStopped       = false
 )
 
@@ -128,274 +120,113 @@ func checkMetricFile(mFile string) {
 	}
 }
 
-func printMetricsFile(mFile string) {
-
-	// Check that metric file exists.
-	checkMetricFile(mFile)
-
-	// Print lines from metrics file.
-	t, err := tail.TailFile(mFile, tail.Config{Follow: true, ReOpen: true})
-	if err != nil {
-		klog.Errorf("Failed to open metrics file: %v", err)
-	}
-
-	for line := range t.Lines {
-		klog.Info(line.Text)
-	}
-}
-
-func watchMetricsFile(mFile string, stopRules stopRulesFlag, filters []string, fileFormat commonv1beta1.FileFormat) {
-
-	// metricStartStep is the dict where key = metric name, value = start step.
-	// We should apply early stopping rule only if metric is reported at least "start_step" times.
-	metricStartStep := make(map[string]int)
-	for _, stopRule := range stopRules {
-		if stopRule.StartStep != 0 {
-			metricStartStep[stopRule.Name] = stopRule.StartStep
+// watchMetricsFile parses the metrics file lines as they arrive and adds the metrics logs to the reporter.
+// pos is the position in the metrics file where the tail is started.
+// If stop rules are set, the training is early stopped once all rules are reached.
+func watchMetricsFile(t *tail.Tail, pos common.Position, parser *filemc.LineParser, reporter *common.MetricsReporter, stopRules *common.StopRules, done chan<- struct{}) {
+	defer close(done)
+
+	// Native sidecar doesn't share the process namespace with the training container.
+	var mainProc *psutil.Process
+	if stopRules != nil && !*nativeSidecar {
+		// Check that metric file exists.
+		checkMetricFile(*metricsFilePath)
+
+		// Get Main process.
+		// Extract the metric file dir path based on the file name.
+		mDirPath, _ := filepath.Split(*metricsFilePath)
+		_, mainProcPid, err := common.GetMainProcesses(mDirPath)
+		if err != nil {
+			klog.Fatalf("GetMainProcesses failed: %v", err)
+		}
+		mainProc, err = psutil.NewProcess(int32(mainProcPid))
+		if err != nil {
+			klog.Fatalf("Failed to create new Process from pid %v, error: %v", mainProcPid, err)
 		}
 	}
 
-	// For objective metric we calculate best optimal value from the recorded metrics.
-	// This is workaround for Median Stop algorithm.
-	// TODO (andreyvelich): Think about it, maybe define latest, max or min strategy type in stop-rule as well ?
-	var optimalObjValue *float64
-
-	// Check that metric file exists.
-	checkMetricFile(mFile)
-
-	// Get Main process.
-	// Extract the metric file dir path based on the file name.
-	mDirPath, _ := filepath.Split(mFile)
-	_, mainProcPid, err := common.GetMainProcesses(mDirPath)
-	if err != nil {
-		klog.Fatalf("GetMainProcesses failed: %v", err)
-	}
-	mainProc, err := psutil.NewProcess(int32(mainProcPid))
-	if err != nil {
-		klog.Fatalf("Failed to create new Process from pid %v, error: %v", mainProcPid, err)
-	}
-
-	// Start watch log lines.
-	t, _ := tail.TailFile(mFile, tail.Config{Follow: true})
+	// Lines channel is closed once the tail is stopped at the end of the file.
 	for line := range t.Lines {
+		if line.Err != nil {
+			klog.Warningf("Failed to read metrics file: %v", line.Err)
+			continue
+		}
+		// The line offset is decreased once the rotated or truncated metrics file is read from the beginning.
+		if line.SeekInfo.Offset < pos.Offset {
+			pos.Generation++
+		}
+		pos.Offset = line.SeekInfo.Offset
 		logText := line.Text
 		// Print log line
 		klog.Info(logText)
 
-		switch fileFormat {
-		case commonv1beta1.TextFormat:
-			// Get list of regural expressions from filters.
-			var metricRegList []*regexp.Regexp
-			metricRegList = filemc.GetFilterRegexpList(filters)
-
-			// Check if log line contains metric from stop rules.
-			isRuleLine := false
-			for _, rule := range stopRules {
-				if strings.Contains(logText, rule.Name) {
-					isRuleLine = true
-					break
-				}
-			}
-			// If log line doesn't contain appropriate metric, continue track file.
-			if !isRuleLine {
-				continue
-			}
+		// Metrics are reported before the Trial is early stopped, so the rest of the lines are only printed.
+		if isEarlyStopped {
+			continue
+		}
 
-			// If log line contains appropriate metric, find all submatches from metric filters.
-			for _, metricReg := range metricRegList {
-				matchStrings := metricReg.FindAllStringSubmatch(logText, -1)
-				for _, subMatchList := range matchStrings {
-					if len(subMatchList) < 3 {
-						continue
-					}
-					// Submatch must have metric name and float value
-					metricName := strings.TrimSpace(subMatchList[1])
-					metricValue, err := strconv.ParseFloat(strings.TrimSpace(subMatchList[2]), 64)
-					if err != nil {
-						klog.Fatalf("Unable to parse value %v to float for metric %v", metricValue, metricName)
-					}
-
-					// stopRules contains array of EarlyStoppingRules that has not been reached yet.
-					// After rule is reached we delete appropriate element from the array.
-					for idx, rule := range stopRules {
-						if metricName != rule.Name {
-							continue
-						}
-						stopRules, optimalObjValue = updateStopRules(stopRules, optimalObjValue, metricValue, metricStartStep, rule, idx)
-					}
-				}
-			}
-		case commonv1beta1.JsonFormat:
-			var logJsonObj map[string]interface{}
-			if err = json.Unmarshal([]byte(logText), &logJsonObj); err != nil {
-				klog.Fatalf("Failed to unmarshal logs in %v format, log: %s, error: %v", commonv1beta1.JsonFormat, logText, err)
-			}
-			// Check if log line contains metric from stop rules.
-			isRuleLine := false
-			for _, rule := range stopRules {
-				if _, exist := logJsonObj[rule.Name]; exist {
-					isRuleLine = true
-					break
-				}
+		mlogs, err := parser.Parse(logText)
+		if err != nil {
+			klog.Fatalf("Failed to parse logs in %v format, log: %s, error: %v", *metricsFileFormat, logText, err)
+		}
+		// Position is advanced by the lines without metrics as well.
+		reporter.AddAt(*metricsFilePath, pos, mlogs...)
+
+		if stopRules == nil {
+			continue
+		}
+		// stopRules contains EarlyStoppingRules that has not been reached yet.
+		for _, mlog := range mlogs {
+			if !stopRules.Contains(mlog.Metric.Name) {
+				continue
 			}
-			// If log line doesn't contain appropriate metric, continue track file.
-			if !isRuleLine {
+			metricValue, err := strconv.ParseFloat(strings.TrimSpace(mlog.Metric.Value), 64)
+			// The value which isn't a number is reported, but can't be compared with the rules.
+			if err != nil {
+				klog.Warningf("Unable to parse value %v to float for metric %v, early stopping rules are not applied", mlog.Metric.Value, mlog.Metric.Name)
 				continue
 			}
-
-			// stopRules contains array of EarlyStoppingRules that has not been reached yet.
-			// After rule is reached we delete appropriate element from the array.
-			for idx, rule := range stopRules {
-				value, exist := logJsonObj[rule.Name].(string)
-				if !exist {
-					continue
-				}
-				metricValue, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
-				if err != nil {
-					klog.Fatalf("Unable to parse value %v to float for metric %v", metricValue, rule.Name)
-				}
-				stopRules, optimalObjValue = updateStopRules(stopRules, optimalObjValue, metricValue, metricStartStep, rule, idx)
+			if err = stopRules.Update(mlog.Metric.Name, metricValue); err != nil {
+				klog.Fatal(err)
 			}
-		default:
-			klog.Fatalf("Format must be set to %v or %v", commonv1beta1.TextFormat, commonv1beta1.JsonFormat)
 		}
 
-		// If stopRules array is empty, Trial is early stopped.
-		if len(stopRules) == 0 {
+		// If all stop rules are reached, Trial is early stopped.
+		if stopRules.Reached() {
 			klog.Info("Training container is early stopped")
 			isEarlyStopped = true
 
-			// Create ".pid" file with "early-stopped" line.
-			// Which means that training is early stopped and Trial status is updated.
-			markFile := filepath.Join(filepath.Dir(mFile), fmt.Sprintf("%d.pid", mainProcPid))
-			_, err := os.Create(markFile)
-			if err != nil {
-				klog.Fatalf("Create mark file %v error: %v", markFile, err)
-			}
-
-			err = os.WriteFile(markFile, []byte(common.TrainingEarlyStopped), 0)
-			if err != nil {
-				klog.Fatalf("Write to file %v error: %v", markFile, err)
-			}
-
-			// Get child process from main PID.
-			childProc, err := mainProc.Children()
-			if err != nil {
-				klog.Fatalf("Get children proceses for main PID: %v failed: %v", mainProcPid, err)
-			}
-
-			// TODO (andreyvelich): Currently support only single child process.
-			if len(childProc) != 1 {
-				klog.Fatalf("Multiple children processes are not supported. Children processes: %v", childProc)
-			}
-
-			// Terminate the child process.
-			err = childProc[0].Terminate()
-			if err != nil {
-				klog.Fatalf("Unable to terminate child process %v, error: %v", childProc[0], err)
+			// Native sidecar can't terminate the training process, so the Trial's job is deleted
+			// by Trial controller once the Trial is early stopped, even if the Trial retains the run.
+			if !*nativeSidecar {
+				if err := common.StopTraining(filepath.Dir(*metricsFilePath), mainProc); err != nil {
+					klog.Fatal(err)
+				}
 			}
 
 			// Report metrics to DB.
-			reportMetrics(filters, fileFormat)
+			closeReporter(reporter, time.Now().Add(*reportTimeout))
 
 			// Wait until main process is completed.
-			timeout := 60 * time.Second
-			endTime := time.Now().Add(timeout)
-			isProcRunning := true
-			for isProcRunning && time.Now().Before(endTime) {
-				isProcRunning, err = mainProc.IsRunning()
-				// Ignore "no such file error". It means that process is complete.
-				if err != nil && !os.IsNotExist(err) {
-					klog.Fatalf("Check process status for main PID: %v failed: %v", mainProcPid, err)
+			if !*nativeSidecar {
+				if err := common.WaitProcessCompleted(mainProc, 60*time.Second); err != nil {
+					klog.Fatal(err)
 				}
 			}
 
-			// Create connection and client for Early Stopping service.
-			conn, err := grpc.Dial(*earlyStopServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
-			if err != nil {
-				klog.Fatalf("Could not connect to Early Stopping service, error: %v", err)
-			}
-			c := api.NewEarlyStoppingClient(conn)
-
-			setTrialStatusReq := &api.SetTrialStatusRequest{
-				TrialName: *trialName,
-			}
-
 			// Send request to change Trial status to early stopped.
-			_, err = c.SetTrialStatus(context.Background(), setTrialStatusReq)
-			if err != nil {
-				klog.Fatalf("Set Trial status error: %v", err)
+			if err = common.SetTrialEarlyStopped(*earlyStopServiceAddr, *trialName, *trialNamespace); err != nil {
+				klog.Fatal(err)
 			}
-			conn.Close()
 
 			klog.Infof("Trial status is successfully updated")
 		}
 	}
 }
 
-func updateStopRules(
-	stopRules []commonv1beta1.EarlyStoppingRule,
-	optimalObjValue *float64,
-	metricValue float64,
-	metricStartStep map[string]int,
-	rule commonv1beta1.EarlyStoppingRule,
-	ruleIdx int,
-) ([]commonv1beta1.EarlyStoppingRule, *float64) {
-
-	// First metric is objective in metricNames array.
-	objMetric := strings.Split(*metricNames, ";")[0]
-	objType := commonv1beta1.ObjectiveType(*objectiveType)
-
-	// Calculate optimalObjValue.
-	if rule.Name == objMetric {
-		if optimalObjValue == nil {
-			optimalObjValue = &metricValue
-		} else if objType == commonv1beta1.ObjectiveTypeMaximize && metricValue > *optimalObjValue {
-			optimalObjValue = &metricValue
-		} else if objType == commonv1beta1.ObjectiveTypeMinimize && metricValue < *optimalObjValue {
-			optimalObjValue = &metricValue
-		}
-		// Assign best optimal value to metric value.
-		metricValue = *optimalObjValue
-	}
-
-	// Reduce steps if appropriate metric is reported.
-	// Once rest steps are empty we apply early stopping rule.
-	if _, ok := metricStartStep[rule.Name]; ok {
-		metricStartStep[rule.Name]--
-		if metricStartStep[rule.Name] != 0 {
-			return stopRules, optimalObjValue
-		}
-	}
-
-	ruleValue, err := strconv.ParseFloat(rule.Value, 64)
-	if err != nil {
-		klog.Fatalf("Unable to parse value %v to float for rule metric %v", rule.Value, rule.Name)
-	}
-
-	// Metric value can be equal, less or greater than stop rule.
-	// Deleting suitable stop rule from the array.
-	if rule.Comparison == commonv1beta1.ComparisonTypeEqual && metricValue == ruleValue {
-		return deleteStopRule(stopRules, ruleIdx), optimalObjValue
-	} else if rule.Comparison == commonv1beta1.ComparisonTypeLess && metricValue < ruleValue {
-		return deleteStopRule(stopRules, ruleIdx), optimalObjValue
-	} else if rule.Comparison == commonv1beta1.ComparisonTypeGreater && metricValue > ruleValue {
-		return deleteStopRule(stopRules, ruleIdx), optimalObjValue
-	}
-	return stopRules, optimalObjValue
-}
-
-func deleteStopRule(stopRules []commonv1beta1.EarlyStoppingRule, idx int) []commonv1beta1.EarlyStoppingRule {
-	if idx >= len(stopRules) {
-		klog.Fatalf("Index %v out of range stopRules: %v", idx, stopRules)
-	}
-	stopRules[idx] = stopRules[len(stopRules)-1]
-	stopRules[len(stopRules)-1] = commonv1beta1.EarlyStoppingRule{}
-	return stopRules[:len(stopRules)-1]
-}
-
 func main() {
 	flag.Var(&stopRules, "stop-rule", "The list of early stopping stop rules")
+	flag.Var(selectors, "selector", "Selector of the metric value in the metrics file in the <metric_name>=<path> format")
 	flag.Parse()
 	klog.Infof("Trial Name: %s", *trialName)
 
@@ -403,14 +234,81 @@ func main() {
 	if len(*metricFilters) != 0 {
 		filters = strings.Split(*metricFilters, ";")
 	}
+	var metricList []string
+	if len(*metricNames) != 0 {
+		metricList = strings.Split(*metricNames, ";")
+	}
 
-	fileFormat := commonv1beta1.FileFormat(*metricsFileFormat)
+	opts := filemc.ParserOptions{
+		Metrics:         metricList,
+		Filters:         filters,
+		Selectors:       selectors,
+		TimestampColumn: *timestampColumn,
+		StepColumn:      *stepColumn,
+		TimestampFormat: *timestampFormat,
+		FileFormat:      commonv1beta1.FileFormat(*metricsFileFormat),
+	}
+	// The document formats are parsed once the training is completed.
+	isDocumentFormat := filemc.IsDocumentFormat(opts.FileFormat)
+	var parser *filemc.LineParser
+	if !isDocumentFormat {
+		var err error
+		if parser, err = filemc.NewLineParser(opts); err != nil {
+			klog.Fatalf("Failed to create metrics parser: %v", err)
+		}
+	}
 
-	// If stop rule is set we need to parse metrics during run.
-	if len(stopRules) != 0 {
-		go watchMetricsFile(*metricsFilePath, stopRules, filters, fileFormat)
+	credsOpt, err := grpctls.DialOption(grpctls.ServerName)
+	if err != nil {
+		klog.Fatalf("Failed to set up credentials for DB manager service, error: %v", err)
+	}
+	conn, err := grpc.Dial(*dbManagerServiceAddr, credsOpt)
+	if err != nil {
+		klog.Fatalf("Could not connect to DB manager service, error: %v", err)
+	}
+	defer conn.Close()
+	// The batches are spooled to the metrics volume, so they survive the metrics collector restart.
+	spoolDir := filepath.Join(filepath.Dir(*metricsFilePath), common.MetricsSpoolDir)
+	reporter, err := common.NewMetricsReporter(api.NewDBManagerClient(conn), *trialName, *trialNamespace, *worker, metricList, spoolDir)
+	if err != nil {
+		klog.Fatalf("Failed to create metrics reporter: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go reporter.Run(ctx, *flushInterval)
+
+	var t *tail.Tail
+	done := make(chan struct{})
+	if isDocumentFormat {
+		if len(stopRules) != 0 {
+			klog.Warningf("Early stopping rules are not applied since metrics file in %v format is parsed once the training is completed", opts.FileFormat)
+		}
+		close(done)
 	} else {
-		go printMetricsFile(*metricsFilePath)
+		// Tail follows the metrics file when it is rotated or truncated.
+		// The file doesn't exist until the training is started.
+		config := tail.Config{Follow: true, ReOpen: true}
+		// The restarted metrics collector continues to read the metrics file after the spooled metrics logs.
+		pos := reporter.Position(*metricsFilePath)
+		if pos.Offset != 0 {
+			if info, err := os.Stat(*metricsFilePath); err == nil && info.Size() >= pos.Offset {
+				config.Location = &tail.SeekInfo{Offset: pos.Offset, Whence: io.SeekStart}
+			} else {
+				// The metrics file was rotated or truncated while the metrics collector was restarted.
+				pos = common.Position{Generation: pos.Generation + 1}
+			}
+		}
+		t, err = tail.TailFile(*metricsFilePath, config)
+		if err != nil {
+			klog.Fatalf("Failed to open metrics file: %v", err)
+		}
+
+		// If stop rule is set we need to apply it during run.
+		var rules *common.StopRules
+		if len(stopRules) != 0 {
+			rules = common.NewStopRules(stopRules, metricList[0], commonv1beta1.ObjectiveType(*objectiveType))
+		}
+		go watchMetricsFile(t, pos, parser, reporter, rules, done)
 	}
 
 	waitAll, _ := strconv.ParseBool(*waitAllProcesses)
@@ -420,41 +318,61 @@ func main() {
 		Timeout:                *timeout,
 		WaitAll:                waitAll,
 		CompletedMarkedDirPath: filepath.Dir(*metricsFilePath),
+		NativeSidecar:          *nativeSidecar,
 	}
 	if err := common.WaitMainProcesses(wopts); err != nil {
 		klog.Fatalf("Failed to wait for worker container: %v", err)
 	}
+	// The rest of the metrics file is parsed and reported within the single report timeout,
+	// since the native sidecar is killed at the end of the Pod's termination grace period.
+	reportDeadline := time.Now().Add(*reportTimeout)
 
-	// If training was not early stopped, report the metrics.
+	if isDocumentFormat {
+		reportMetricsDocument(reporter, opts)
+	} else {
+		// Parse the rest of the metrics file before the final flush.
+		// StopAtEOF always returns the stop reason, so the error is ignored.
+		_ = t.StopAtEOF()
+	}
+	// The parsing waits for the reports while the reporter's buffer is full,
+	// so the rest of the metrics file is parsed regardless of the buffer limit after the report timeout.
+	select {
+	case <-done:
+	case <-time.After(time.Until(reportDeadline)):
+	}
+	cancel()
+	<-done
+
+	// If training was not early stopped, report the rest of the metrics.
 	if !isEarlyStopped {
-		reportMetrics(filters, fileFormat)
+		closeReporter(reporter, reportDeadline)
 	}
 }
 
-func reportMetrics(filters []string, fileFormat commonv1beta1.FileFormat) {
-
-	conn, err := grpc.Dial(*dbManagerServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		klog.Fatalf("Could not connect to DB manager service, error: %v", err)
-	}
-	defer conn.Close()
-	c := api.NewDBManagerClient(conn)
-	ctx := context.Background()
-	var metricList []string
-	if len(*metricNames) != 0 {
-		metricList = strings.Split(*metricNames, ";")
+// reportMetricsDocument parses the whole metrics file and adds the metrics logs to the reporter.
+// If the training doesn't write the metrics file, the objective metric is reported as unavailable.
+func reportMetricsDocument(reporter *common.MetricsReporter, opts filemc.ParserOptions) {
+	document, err := os.ReadFile(*metricsFilePath)
+	if os.IsNotExist(err) {
+		klog.Warningf("Metrics file %v is not found", *metricsFilePath)
+		return
+	} else if err != nil {
+		klog.Fatalf("Failed to read metrics file: %v", err)
 	}
-	olog, err := filemc.CollectObservationLog(*metricsFilePath, metricList, filters, fileFormat)
+	mlogs, err := filemc.ParseDocument(document, opts)
 	if err != nil {
-		klog.Fatalf("Failed to collect logs: %v", err)
-	}
-	reportreq := &api.ReportObservationLogRequest{
-		TrialName:      *trialName,
-		ObservationLog: olog,
+		klog.Fatalf("Failed to parse metrics file in %v format, error: %v", opts.FileFormat, err)
 	}
-	_, err = c.ReportObservationLog(ctx, reportreq)
-	if err != nil {
-		klog.Fatalf("Failed to Report logs: %v", err)
+	reporter.Add(mlogs...)
+}
+
+// closeReporter reports the rest of the metrics until the deadline.
+// If the metrics can't be delivered, the metrics collector exits successfully,
+// so the Trial is marked as MetricsUnavailable instead of Failed.
+func closeReporter(reporter *common.MetricsReporter, deadline time.Time) {
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	if err := reporter.Close(ctx); err != nil {
+		klog.Errorf("Failed to deliver metrics to DB manager: %v", err)
 	}
-	klog.Infof("Metrics reported. :\n%v", olog)
 }
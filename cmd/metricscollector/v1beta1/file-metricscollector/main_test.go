@@ -0,0 +1,123 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nxadm/tail"
+	"google.golang.org/grpc"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	api "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+	filemc "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/file-metricscollector"
+)
+
+type fakeDBManagerClient struct {
+	api.DBManagerClient
+	values []string
+}
+
+func (c *fakeDBManagerClient) ReportObservationLog(ctx context.Context, in *api.ReportObservationLogRequest, opts ...grpc.CallOption) (*api.ReportObservationLogReply, error) {
+	for _, mlog := range in.ObservationLog.MetricLogs {
+		c.values = append(c.values, mlog.Metric.Name+"="+mlog.Metric.Value)
+	}
+	return &api.ReportObservationLogReply{}, nil
+}
+
+type fakeEarlyStoppingServer struct {
+	api.EarlyStoppingServer
+	trialNames chan string
+}
+
+func (s *fakeEarlyStoppingServer) SetTrialStatus(ctx context.Context, in *api.SetTrialStatusRequest) (*api.SetTrialStatusReply, error) {
+	s.trialNames <- in.TrialName
+	return &api.SetTrialStatusReply{}, nil
+}
+
+func TestWatchMetricsFileDivergence(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "metrics.log")
+	if err := os.WriteFile(path, []byte("loss=0.5\nloss=nan\nloss=0.4\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write metrics file: %v", err)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	server := grpc.NewServer()
+	earlyStopping := &fakeEarlyStoppingServer{trialNames: make(chan string, 1)}
+	api.RegisterEarlyStoppingServer(server, earlyStopping)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	*earlyStopServiceAddr = listener.Addr().String()
+	*metricsFilePath = path
+	*nativeSidecar = true
+	*trialName = "test-trial"
+	*trialNamespace = "test-namespace"
+	isEarlyStopped = false
+
+	parser, err := filemc.NewLineParser(filemc.ParserOptions{
+		Metrics:    []string{"loss"},
+		FileFormat: commonv1beta1.TextFormat,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create metrics parser: %v", err)
+	}
+	client := &fakeDBManagerClient{}
+	reporter, err := common.NewMetricsReporter(client, *trialName, *trialNamespace, "", []string{"loss"}, filepath.Join(dir, common.MetricsSpoolDir))
+	if err != nil {
+		t.Fatalf("Failed to create metrics reporter: %v", err)
+	}
+	rules := common.NewStopRules([]commonv1beta1.EarlyStoppingRule{{
+		Name: "loss",
+		Type: commonv1beta1.EarlyStoppingRuleTypeDivergence,
+	}}, "loss", commonv1beta1.ObjectiveTypeMinimize)
+	// Lines channel is closed at the end of the file, since the file isn't followed.
+	tailFile, err := tail.TailFile(path, tail.Config{})
+	if err != nil {
+		t.Fatalf("Failed to open metrics file: %v", err)
+	}
+	defer tailFile.Cleanup()
+
+	done := make(chan struct{})
+	watchMetricsFile(tailFile, common.Position{}, parser, reporter, rules, done)
+
+	if !isEarlyStopped {
+		t.Errorf("Expected the training to be early stopped by the divergence rule")
+	}
+	select {
+	case name := <-earlyStopping.trialNames:
+		if name != "test-trial" {
+			t.Errorf("Expected test-trial to be early stopped, got %v", name)
+		}
+	default:
+		t.Errorf("Expected the Trial status to be set to early stopped")
+	}
+	// The logs after the early stop are not reported.
+	if diff := cmp.Diff([]string{"loss=0.5", "loss=nan"}, client.values); diff != "" {
+		t.Errorf("Unexpected reported metrics (-want,+got):\n%s", diff)
+	}
+}
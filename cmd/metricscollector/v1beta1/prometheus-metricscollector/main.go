@@ -0,0 +1,295 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Prometheus MetricsCollector periodically scrapes the metrics endpoint of the worker.
+Metrics must be exposed in the Prometheus text or OpenMetrics format.
+For example, the objective metric is accuracy and the additional metric is loss, your training code should expose:
+     ---
+     # TYPE accuracy gauge
+     accuracy{phase="train"} 0.91
+     accuracy{phase="validation"} 0.87
+     # TYPE loss gauge
+     loss 0.32
+     # TYPE epoch gauge
+     epoch 3
+     ---
+The series of the metric can be selected by labels with the -selector flag, e.g. accuracy{phase="validation"}.
+The step and epoch metrics are used as the step of the collected metrics.
+The metrics collector records the sample only when its value or step is changed since the previous scrape.
+The collected metrics are reported to the DB manager on every flush interval.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	psutil "github.com/shirou/gopsutil/v3/process"
+	"google.golang.org/grpc"
+	"k8s.io/klog"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	api "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+	promc "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/prometheus-metricscollector"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
+)
+
+type stringsFlag []string
+
+func (flag *stringsFlag) String() string {
+	return strings.Join(*flag, ",")
+}
+
+func (flag *stringsFlag) Set(value string) error {
+	*flag = append(*flag, value)
+	return nil
+}
+
+var (
+	dbManagerServiceAddr = flag.String("s-db", "", "Katib DB Manager service endpoint")
+	earlyStopServiceAddr = flag.String("s-earlystop", "", "Katib Early Stopping service endpoint")
+	trialName            = flag.String("t", "", "Trial Name")
+	trialNamespace       = flag.String("t-ns", "", "Trial Namespace")
+	metricsURL           = flag.String("url", "", "Metrics endpoint URL")
+	markDirPath          = flag.String("path", "", "Directory for the completed marker of the main process")
+	metricNames          = flag.String("m", "", "Metric names")
+	objectiveType        = flag.String("o-type", "", "Objective type")
+	scrapeInterval       = flag.Duration("scrape-interval", common.DefaultScrapeInterval, "Interval between the metrics endpoint scrapes")
+	scrapeTimeout        = flag.Duration("scrape-timeout", common.DefaultScrapeTimeout, "Timeout of the metrics endpoint scrape")
+	flushInterval        = flag.Duration("flush-interval", common.DefaultFlushInterval, "Interval between the metrics reports to DB manager")
+	reportTimeout        = flag.Duration("report-timeout", common.DefaultReportTimeout, "Timeout of the metrics report retries once the training is completed")
+	pollInterval         = flag.Duration("p", common.DefaultPollInterval, "Poll interval between running processes check")
+	timeout              = flag.Duration("timeout", common.DefaultTimeout, "Timeout before invoke error during running processes check")
+	waitAllProcesses     = flag.String("w", common.DefaultWaitAllProcesses, "Whether wait for all other main process of container exiting")
+	nativeSidecar        = flag.Bool("native-sidecar", false, "Whether metrics collector is injected as the native sidecar container")
+	worker               = flag.String("worker", "", "Name of the distributed training worker which reports the metrics")
+	headers              stringsFlag
+	selectors            stringsFlag
+	stopRules            common.StopRulesFlag
+	isEarlyStopped       = false
+)
+
+// scrapeMetrics scrapes the metrics endpoint until the context is cancelled and adds the metrics logs to the reporter.
+// If stop rules are set, the training is early stopped once all rules are reached.
+func scrapeMetrics(ctx context.Context, collector *promc.MetricsCollector, reporter *common.MetricsReporter, stopRules *common.StopRules, done chan<- struct{}) {
+	defer close(done)
+
+	// Native sidecar doesn't share the process namespace with the training container.
+	var mainProc *psutil.Process
+	if stopRules != nil && !*nativeSidecar {
+		_, mainProcPid, err := common.GetMainProcesses(*markDirPath)
+		if err != nil {
+			klog.Fatalf("GetMainProcesses failed: %v", err)
+		}
+		mainProc, err = psutil.NewProcess(int32(mainProcPid))
+		if err != nil {
+			klog.Fatalf("Failed to create new Process from pid %v, error: %v", mainProcPid, err)
+		}
+	}
+
+	ticker := time.NewTicker(*scrapeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// The metrics endpoint can be unavailable until the training is started.
+		metricLogs, err := scrape(ctx, collector, reporter)
+		if err != nil {
+			klog.Warningf("Failed to scrape metrics: %v", err)
+			continue
+		}
+		if stopRules == nil {
+			continue
+		}
+
+		for _, mlog := range metricLogs {
+			if !stopRules.Contains(mlog.Metric.Name) {
+				continue
+			}
+			metricValue, err := strconv.ParseFloat(mlog.Metric.Value, 64)
+			// The value which isn't a number is reported, but can't be compared with the rules.
+			if err != nil {
+				klog.Warningf("Unable to parse value %v to float for metric %v, early stopping rules are not applied", mlog.Metric.Value, mlog.Metric.Name)
+				continue
+			}
+			if err = stopRules.Update(mlog.Metric.Name, metricValue); err != nil {
+				klog.Fatal(err)
+			}
+		}
+
+		// If all stop rules are reached, Trial is early stopped.
+		if stopRules.Reached() {
+			klog.Info("Training container is early stopped")
+			isEarlyStopped = true
+
+			// Native sidecar can't terminate the training process, so the Trial's job is deleted
+			// by Trial controller once the Trial is early stopped, even if the Trial retains the run.
+			if !*nativeSidecar {
+				if err := common.StopTraining(*markDirPath, mainProc); err != nil {
+					klog.Fatal(err)
+				}
+			}
+
+			// Report metrics to DB.
+			closeReporter(reporter, time.Now().Add(*reportTimeout))
+
+			// Wait until main process is completed.
+			if !*nativeSidecar {
+				if err := common.WaitProcessCompleted(mainProc, 60*time.Second); err != nil {
+					klog.Fatal(err)
+				}
+			}
+
+			// Send request to change Trial status to early stopped.
+			if err = common.SetTrialEarlyStopped(*earlyStopServiceAddr, *trialName, *trialNamespace); err != nil {
+				klog.Fatal(err)
+			}
+
+			klog.Infof("Trial status is successfully updated")
+			return
+		}
+	}
+}
+
+func main() {
+	flag.Var(&headers, "header", "HTTP header of the metrics endpoint request in the <name>: <value> format")
+	flag.Var(&selectors, "selector", "Selector of the metric series in the <metric_name>{<label>=\"<value>\",...} format")
+	flag.Var(&stopRules, "stop-rule", "The list of early stopping stop rules")
+	flag.Parse()
+	klog.Infof("Trial Name: %s", *trialName)
+
+	var metricList []string
+	if len(*metricNames) != 0 {
+		metricList = strings.Split(*metricNames, ";")
+	}
+
+	httpHeaders := http.Header{}
+	for _, h := range headers {
+		name, value, ok := strings.Cut(h, ":")
+		if !ok {
+			klog.Fatalf("Invalid header: %v", h)
+		}
+		httpHeaders.Add(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+
+	var metricSelectors []promc.Selector
+	for _, s := range selectors {
+		selector, err := promc.ParseSelector(s)
+		if err != nil {
+			klog.Fatalf("Invalid selector: %v", err)
+		}
+		metricSelectors = append(metricSelectors, selector)
+	}
+
+	collector := promc.New(*metricsURL, httpHeaders, metricList, metricSelectors, *scrapeTimeout)
+
+	credsOpt, err := grpctls.DialOption(grpctls.ServerName)
+	if err != nil {
+		klog.Fatalf("Failed to set up credentials for DB manager service, error: %v", err)
+	}
+	conn, err := grpc.Dial(*dbManagerServiceAddr, credsOpt)
+	if err != nil {
+		klog.Fatalf("Could not connect to DB manager service, error: %v", err)
+	}
+	defer conn.Close()
+	// The batches are spooled to the metrics volume, so they survive the metrics collector restart.
+	spoolDir := filepath.Join(*markDirPath, common.MetricsSpoolDir)
+	reporter, err := common.NewMetricsReporter(api.NewDBManagerClient(conn), *trialName, *trialNamespace, *worker, metricList, spoolDir)
+	if err != nil {
+		klog.Fatalf("Failed to create metrics reporter: %v", err)
+	}
+	reportCtx, cancelReport := context.WithCancel(context.Background())
+	defer cancelReport()
+	go reporter.Run(reportCtx, *flushInterval)
+
+	// If stop rule is set we need to apply it during run.
+	var rules *common.StopRules
+	if len(stopRules) != 0 {
+		rules = common.NewStopRules(stopRules, metricList[0], commonv1beta1.ObjectiveType(*objectiveType))
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go scrapeMetrics(ctx, collector, reporter, rules, done)
+
+	waitAll, _ := strconv.ParseBool(*waitAllProcesses)
+
+	wopts := common.WaitPidsOpts{
+		PollInterval:           *pollInterval,
+		Timeout:                *timeout,
+		WaitAll:                waitAll,
+		CompletedMarkedDirPath: *markDirPath,
+		NativeSidecar:          *nativeSidecar,
+	}
+	if err := common.WaitMainProcesses(wopts); err != nil {
+		klog.Fatalf("Failed to wait for worker container: %v", err)
+	}
+	// The last scrape and the report are finished within the single report timeout,
+	// since the native sidecar is killed at the end of the Pod's termination grace period.
+	reportDeadline := time.Now().Add(*reportTimeout)
+
+	// Stop the periodic scrapes before the metrics are reported.
+	cancel()
+	<-done
+	cancelReport()
+
+	// If training was not early stopped, report the rest of the metrics.
+	if !isEarlyStopped {
+		// The metrics endpoint is scraped once more, so the metrics which are exposed after the last scrape
+		// are collected. The endpoint can be unavailable once the training is completed.
+		scrapeCtx, cancelScrape := context.WithDeadline(context.Background(), reportDeadline)
+		if _, err := scrape(scrapeCtx, collector, reporter); err != nil {
+			klog.Warningf("Failed to scrape metrics after the training is completed: %v", err)
+		}
+		cancelScrape()
+		closeReporter(reporter, reportDeadline)
+	}
+}
+
+// scrape scrapes the metrics endpoint once and adds the new metrics logs to the reporter.
+func scrape(ctx context.Context, collector *promc.MetricsCollector, reporter *common.MetricsReporter) ([]*api.MetricLog, error) {
+	metricLogs, err := collector.Scrape(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, mlog := range metricLogs {
+		klog.Infof("%s=%s", mlog.Metric.Name, mlog.Metric.Value)
+	}
+	reporter.Add(metricLogs...)
+	return metricLogs, nil
+}
+
+// closeReporter reports the rest of the metrics until the deadline.
+// If the metrics can't be delivered, the metrics collector exits successfully,
+// so the Trial is marked as MetricsUnavailable instead of Failed.
+func closeReporter(reporter *common.MetricsReporter, deadline time.Time) {
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	if err := reporter.Close(ctx); err != nil {
+		klog.Errorf("Failed to deliver metrics to DB manager: %v", err)
+	}
+}
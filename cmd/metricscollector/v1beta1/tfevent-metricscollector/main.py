@@ -13,16 +13,22 @@
 # limitations under the License.
 
 import argparse
+import hashlib
+import time
 from logging import INFO, StreamHandler, getLogger
 
 import api_pb2
 import api_pb2_grpc
 import const
 import grpc
-from pns import WaitMainProcesses
+from pkg.util.v1beta1.grpctls import grpctls
+from pns import WaitCompletedMarker, WaitMainProcesses
 from tfevent_loader import MetricsCollector
 
 timeout_in_seconds = 60
+# Delay limits between the failed metrics reports.
+initial_retry_backoff = 1
+max_retry_backoff = 60
 
 
 def parse_options():
@@ -34,6 +40,7 @@ def parse_options():
     # TODO (andreyvelich): Add early stopping flags.
     parser.add_argument("-s-db", "--db_manager_server_addr", type=str, default="")
     parser.add_argument("-t", "--trial_name", type=str, default="")
+    parser.add_argument("-t-ns", "--trial_namespace", type=str, default="")
     parser.add_argument("-path", "--metrics_file_dir", type=str, default=const.DEFAULT_METRICS_FILE_DIR)
     parser.add_argument("-m", "--metric_names", type=str, default="")
     parser.add_argument("-o-type", "--objective_type", type=str, default="")
@@ -41,11 +48,51 @@ def parse_options():
     parser.add_argument("-p", "--poll_interval", type=int, default=const.DEFAULT_POLL_INTERVAL)
     parser.add_argument("-timeout", "--timeout", type=int, default=const.DEFAULT_TIMEOUT)
     parser.add_argument("-w", "--wait_all_processes", type=str, default=const.DEFAULT_WAIT_ALL_PROCESSES)
+    parser.add_argument("-native-sidecar", "--native_sidecar", action="store_true")
+    parser.add_argument("-worker", "--worker", type=str, default="")
+    parser.add_argument(
+        "-report-timeout", "--report_timeout", type=int, default=const.DEFAULT_REPORT_TIMEOUT
+    )
 
     opt = parser.parse_args()
     return opt
 
 
+def batch_id(opt, observation_log):
+    # Batch ID is derived from the Trial, the worker and the metrics directory in the same way
+    # as the Go metrics collectors, so the DB manager registers the retried report only once.
+    source = "/".join([opt.trial_namespace, opt.trial_name, opt.worker, opt.metrics_file_dir])
+    source_id = hashlib.sha256(source.encode()).hexdigest()[:16]
+    return f"{source_id}-{0:010d}-{len(observation_log.metric_logs):020d}"
+
+
+def report_observation_log(opt, observation_log, logger):
+    """Reports the observation log and retries the failed reports until the report timeout."""
+    request = api_pb2.ReportObservationLogRequest(
+        trial_name=opt.trial_name,
+        observation_log=observation_log,
+        namespace=opt.trial_namespace,
+        batch_id=batch_id(opt, observation_log),
+    )
+    deadline = time.monotonic() + opt.report_timeout
+    backoff = initial_retry_backoff
+    with grpctls.channel(opt.db_manager_server_addr) as channel:
+        stub = api_pb2_grpc.DBManagerStub(channel)
+        while True:
+            try:
+                stub.ReportObservationLog(request, timeout=timeout_in_seconds)
+                return True
+            except grpc.RpcError as e:
+                if time.monotonic() + backoff > deadline:
+                    logger.error(f"Failed to deliver metrics to DB manager: {e}")
+                    return False
+                logger.warning(
+                    f"Failed to Report logs: {e}. Metrics will be reported again in {backoff}s"
+                )
+                time.sleep(backoff)
+                backoff = min(backoff * 2, max_retry_backoff)
+
+
 if __name__ == '__main__':
     logger = getLogger(__name__)
     handler = StreamHandler()
@@ -61,24 +108,30 @@ if __name__ == '__main__':
             f"Invalid Katib DB manager service address: {opt.db_manager_server_addr}"
         )
 
-    WaitMainProcesses(
-        pool_interval=opt.poll_interval,
-        timout=opt.timeout,
-        wait_all=wait_all_processes,
-        completed_marked_dir=opt.metrics_file_dir,
-    )
+    # Native sidecar doesn't share the process namespace with the training container.
+    if opt.native_sidecar:
+        WaitCompletedMarker(
+            pool_interval=opt.poll_interval,
+            timout=opt.timeout,
+            completed_marked_dir=opt.metrics_file_dir,
+        )
+    else:
+        WaitMainProcesses(
+            pool_interval=opt.poll_interval,
+            timout=opt.timeout,
+            wait_all=wait_all_processes,
+            completed_marked_dir=opt.metrics_file_dir,
+        )
 
     mc = MetricsCollector(opt.metric_names.split(";"))
     observation_log = mc.parse_file(opt.metrics_file_dir)
+    # Metrics of the distributed training are reported with the worker which produced them.
+    for metric_log in observation_log.metric_logs:
+        metric_log.worker = opt.worker
 
-    with grpc.insecure_channel(opt.db_manager_server_addr) as channel:
-        stub = api_pb2_grpc.DBManagerStub(channel)
-        logger.info(
-            f"In {opt.trial_name} {str(len(observation_log.metric_logs))} metrics will be reported."
-        )
-        stub.ReportObservationLog(
-            api_pb2.ReportObservationLogRequest(
-                trial_name=opt.trial_name, observation_log=observation_log
-            ),
-            timeout=timeout_in_seconds,
-        )
+    logger.info(
+        f"In {opt.trial_name} {str(len(observation_log.metric_logs))} metrics will be reported."
+    )
+    # If the metrics can't be delivered, the metrics collector exits successfully,
+    # so the Trial is marked as MetricsUnavailable instead of Failed.
+    report_observation_log(opt, observation_log, logger)
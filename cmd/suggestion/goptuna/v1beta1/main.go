@@ -23,6 +23,7 @@ import (
 	health_pb "github.com/kubeflow/katib/pkg/apis/manager/health"
 	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	suggestion "github.com/kubeflow/katib/pkg/suggestion/v1beta1/goptuna"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 	"google.golang.org/grpc"
 	"k8s.io/klog"
 )
@@ -45,7 +46,11 @@ func main() {
 	if err != nil {
 		klog.Fatalf("Failed to listen: %v", err)
 	}
-	srv := grpc.NewServer()
+	serverOpts, err := grpctls.ServerOptions()
+	if err != nil {
+		klog.Fatalf("Failed to set up TLS: %v", err)
+	}
+	srv := grpc.NewServer(serverOpts...)
 	api_v1_beta1.RegisterSuggestionServer(srv, suggestion.NewSuggestionService())
 	health_pb.RegisterHealthServer(srv, &healthService{})
 
@@ -20,6 +20,7 @@ import grpc
 from pkg.apis.manager.health.python import health_pb2_grpc
 from pkg.apis.manager.v1beta1.python import api_pb2_grpc
 from pkg.suggestion.v1beta1.nas.darts.service import DartsService
+from pkg.util.v1beta1.grpctls import grpctls
 
 _ONE_DAY_IN_SECONDS = 60 * 60 * 24
 DEFAULT_PORT = "0.0.0.0:6789"
@@ -31,7 +32,7 @@ def serve():
     service = DartsService()
     api_pb2_grpc.add_SuggestionServicer_to_server(service, server)
     health_pb2_grpc.add_HealthServicer_to_server(service, server)
-    server.add_insecure_port(DEFAULT_PORT)
+    grpctls.add_port(server, DEFAULT_PORT)
     print("Listening...")
     server.start()
     try:
@@ -24,14 +24,22 @@ import (
 	"os"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 
+	configv1beta1 "github.com/kubeflow/katib/pkg/apis/config/v1beta1"
 	common_v1beta1 "github.com/kubeflow/katib/pkg/common/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	ui "github.com/kubeflow/katib/pkg/ui/v1beta1"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
 )
 
 var (
-	port, host, buildDir, dbManagerAddr *string
+	port, host, buildDir, dbManagerAddr, katibConfigFile *string
+
+	scheme = runtime.NewScheme()
 )
 
 func init() {
@@ -39,10 +47,20 @@ func init() {
 	host = flag.String("host", "0.0.0.0", "The host to listen to for incoming HTTP connections")
 	buildDir = flag.String("build-dir", "/app/build", "The dir of frontend")
 	dbManagerAddr = flag.String("db-manager-address", common_v1beta1.GetDBManagerAddr(), "The address of Katib DB manager")
+	katibConfigFile = flag.String("katib-config", "", "The Katib UI will load the gRPC TLS configuration from this file. Omit this flag to use the default configuration values.")
+
+	utilruntime.Must(configv1beta1.AddToScheme(scheme))
 }
 
 func main() {
 	flag.Parse()
+	initConfig, err := katibconfig.GetInitConfigData(scheme, *katibConfigFile)
+	if err != nil {
+		log.Fatalf("Failed to get KatibConfig: %v", err)
+	}
+	if initConfig.GRPCTLSConfig.Enable {
+		grpctls.SetCertDir(consts.GRPCCertDir)
+	}
 	kuh := ui.NewKatibUIHandler(*dbManagerAddr)
 
     baseHref := os.Getenv("KATIB_BASE_HREF")
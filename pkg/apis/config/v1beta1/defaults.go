@@ -40,6 +40,8 @@ const (
 	DefaultWebhookServiceName = "katib-controller"
 	// DefaultWebhookSecretName is the default secret name to save the certs for the admission webhooks.
 	DefaultWebhookSecretName = "katib-webhook-cert"
+	// DefaultGRPCTLSSecretName is the default secret name to save the certs for the gRPC services.
+	DefaultGRPCTLSSecretName = "katib-grpc-cert"
 )
 
 var (
@@ -69,6 +71,7 @@ func SetDefaults_KatibConfig(cfg *KatibConfig) {
 func setInitConfig(initConfig *InitConfig) {
 	setControllerConfig(&initConfig.ControllerConfig)
 	setCertGeneratorConfig(&initConfig.CertGeneratorConfig)
+	setGRPCTLSConfig(&initConfig.GRPCTLSConfig)
 }
 
 func setControllerConfig(controllerConfig *ControllerConfig) {
@@ -114,6 +117,12 @@ func setCertGeneratorConfig(certGeneratorConfig *CertGeneratorConfig) {
 	}
 }
 
+func setGRPCTLSConfig(grpcTLSConfig *GRPCTLSConfig) {
+	if grpcTLSConfig.Enable && len(grpcTLSConfig.SecretName) == 0 {
+		grpcTLSConfig.SecretName = DefaultGRPCTLSSecretName
+	}
+}
+
 func setRuntimeConfig(runtimeConfig *RuntimeConfig) {
 	setSuggestionConfigs(runtimeConfig.SuggestionConfigs)
 	setMetricsCollectorConfigs(runtimeConfig.MetricsCollectorConfigs)
@@ -171,6 +180,11 @@ func setMetricsCollectorConfigs(metricsCollectorConfigs []MetricsCollectorConfig
 
 		// Set resource requirements for metrics collector
 		metricsCollectorConfigs[i].Resource = setResourceRequirements(metricsCollectorConfigs[i].Resource)
+
+		// Set injection mode for metrics collector
+		if metricsCollectorConfigs[i].InjectionMode == "" {
+			metricsCollectorConfigs[i].InjectionMode = ContainerInjectionMode
+		}
 	}
 }
 
@@ -167,6 +167,26 @@ func TestSetMetricsCollectorConfigs(t *testing.T) {
 			}(),
 			wantConfig: []MetricsCollectorConfig{*newFakeMetricsCollectorConfig(testCollectorKind)},
 		},
+		fmt.Sprintf("GetMetricsConfigData sets %s to injectionMode", ContainerInjectionMode): {
+			config: func() []MetricsCollectorConfig {
+				c := newFakeMetricsCollectorConfig(testCollectorKind)
+				c.InjectionMode = ""
+				return []MetricsCollectorConfig{*c}
+			}(),
+			wantConfig: []MetricsCollectorConfig{*newFakeMetricsCollectorConfig(testCollectorKind)},
+		},
+		"GetMetricsConfigData keeps NativeSidecar injectionMode": {
+			config: func() []MetricsCollectorConfig {
+				c := newFakeMetricsCollectorConfig(testCollectorKind)
+				c.InjectionMode = NativeSidecarInjectionMode
+				return []MetricsCollectorConfig{*c}
+			}(),
+			wantConfig: func() []MetricsCollectorConfig {
+				c := newFakeMetricsCollectorConfig(testCollectorKind)
+				c.InjectionMode = NativeSidecarInjectionMode
+				return []MetricsCollectorConfig{*c}
+			}(),
+		},
 		"GetMetricsConfigData nukes resource.requests and resource.limits for the metrics collector": {
 			config: func() []MetricsCollectorConfig {
 				c := newFakeMetricsCollectorConfig(testCollectorKind)
@@ -334,6 +354,50 @@ func TestSetCertGeneratorConfig(t *testing.T) {
 	}
 }
 
+func TestSetGRPCTLSConfig(t *testing.T) {
+	cases := map[string]struct {
+		config     GRPCTLSConfig
+		wantConfig GRPCTLSConfig
+	}{
+		"All parameters correctly are specified": {
+			config: GRPCTLSConfig{
+				Enable:     true,
+				SecretName: "katib-test",
+			},
+			wantConfig: GRPCTLSConfig{
+				Enable:     true,
+				SecretName: "katib-test",
+			},
+		},
+		"GRPCTLSConfig is empty": {
+			config:     GRPCTLSConfig{},
+			wantConfig: GRPCTLSConfig{},
+		},
+		"Enable is true and secretName is empty": {
+			config: GRPCTLSConfig{
+				Enable: true,
+			},
+			wantConfig: GRPCTLSConfig{
+				Enable:     true,
+				SecretName: DefaultGRPCTLSSecretName,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kc := &KatibConfig{
+				InitConfig: InitConfig{
+					GRPCTLSConfig: tc.config,
+				},
+			}
+			SetDefaults_KatibConfig(kc)
+			if diff := cmp.Diff(tc.wantConfig, kc.InitConfig.GRPCTLSConfig); len(diff) != 0 {
+				t.Errorf("Unexpected GRPCTLSConfig (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func newFakeSuggestionConfig(algorithmName string) *SuggestionConfig {
 	defaultVolumeStorage, _ := resource.ParseQuantity(DefaultSuggestionVolumeStorage)
 
@@ -376,6 +440,7 @@ func newFakeMetricsCollectorConfig(collectorKind commonv1beta1.CollectorKind) *M
 		Image:           "metrics-collector-image",
 		ImagePullPolicy: DefaultImagePullPolicy,
 		Resource:        *setFakeResourceRequirements(),
+		InjectionMode:   ContainerInjectionMode,
 	}
 }
 
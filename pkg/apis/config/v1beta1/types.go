@@ -42,6 +42,7 @@ type RuntimeConfig struct {
 type InitConfig struct {
 	ControllerConfig    ControllerConfig    `json:"controller,omitempty"`
 	CertGeneratorConfig CertGeneratorConfig `json:"certGenerator,omitempty"`
+	GRPCTLSConfig       GRPCTLSConfig       `json:"grpcTLS,omitempty"`
 
 	// TODO: Adding a config for the following components would be nice.
 	// - Katib DB
@@ -97,6 +98,20 @@ type CertGeneratorConfig struct {
 	WebhookSecretName string `json:"webhookSecretName,omitempty"`
 }
 
+// GRPCTLSConfig is the grpcTLS structure in Katib config.
+type GRPCTLSConfig struct {
+	// Enable indicates whether Katib gRPC services and clients use mutual TLS.
+	// Defaults to 'false'.
+	Enable bool `json:"enable,omitempty"`
+	// SecretName indicates which secret stores the CA, certificate and key for the Katib control plane.
+	// The controller issues the namespace certs for Suggestions and Trials with the CA private key
+	// from this secret, which is `ca.key` or `tls.key` if the certificate is the CA itself.
+	// When the cert-generator is enabled, the certs in this secret are generated and rotated by Katib.
+	// Otherwise, the secret must be provided by the user (e.g. by cert-manager).
+	// Defaults to 'katib-grpc-cert'.
+	SecretName string `json:"secretName,omitempty"`
+}
+
 // SuggestionConfig is the suggestion structure in Katib config.
 type SuggestionConfig struct {
 	AlgorithmName             string `json:"algorithmName"`
@@ -123,4 +138,23 @@ type MetricsCollectorConfig struct {
 	ImagePullPolicy  corev1.PullPolicy           `json:"imagePullPolicy,omitempty"`
 	Resource         corev1.ResourceRequirements `json:"resources,omitempty"`
 	WaitAllProcesses *bool                       `json:"waitAllProcesses,omitempty"`
+	// InjectionMode indicates how the metrics collector is injected in the Trial's pods.
+	// 'Container' appends the metrics collector to the pod containers and shares the process namespace
+	// to wait for the training processes.
+	// 'NativeSidecar' injects the metrics collector as the init container with the 'Always' restart policy.
+	// The training command is not wrapped, so the training completion is signaled by SIGTERM once the training
+	// containers are completed, and the metrics are reported within the Pod's termination grace period.
+	// 'StdOut' metrics collector can't be injected in this mode.
+	// Defaults to 'Container'.
+	InjectionMode InjectionMode `json:"injectionMode,omitempty"`
 }
+
+// InjectionMode is the injection mode of the metrics collector.
+type InjectionMode string
+
+const (
+	// ContainerInjectionMode injects the metrics collector as the pod container.
+	ContainerInjectionMode InjectionMode = "Container"
+	// NativeSidecarInjectionMode injects the metrics collector as the Kubernetes native sidecar container.
+	NativeSidecarInjectionMode InjectionMode = "NativeSidecar"
+)
@@ -85,11 +85,27 @@ func (in *EarlyStoppingConfig) DeepCopy() *EarlyStoppingConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCTLSConfig) DeepCopyInto(out *GRPCTLSConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCTLSConfig.
+func (in *GRPCTLSConfig) DeepCopy() *GRPCTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InitConfig) DeepCopyInto(out *InitConfig) {
 	*out = *in
 	in.ControllerConfig.DeepCopyInto(&out.ControllerConfig)
 	out.CertGeneratorConfig = in.CertGeneratorConfig
+	out.GRPCTLSConfig = in.GRPCTLSConfig
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InitConfig.
@@ -61,18 +61,36 @@ type EarlyStoppingSetting struct {
 // EarlyStoppingRule represents each rule for early stopping.
 type EarlyStoppingRule struct {
 	// Name contains metric name for the rule.
+	// Rule can be set for the objective or any additional metric.
 	Name string `json:"name,omitempty"`
 
 	// Value contains metric value for the rule.
+	// For the patience rule, value is the minimum change of the metric counted as improvement.
+	// For the divergence rule, value is the optional bound of the metric.
 	Value string `json:"value,omitempty"`
 
 	// Comparison defines correlation between name and value.
+	// For the patience rule, comparison defines the direction of the improvement,
+	// if it is not set, the objective metric improves in the objective type direction.
 	Comparison ComparisonType `json:"comparison,omitempty"`
 
 	// StartStep defines quantity of intermediate results
 	// that should be received before applying the rule.
 	// If start step is empty, rule is applied from the first recorded metric.
 	StartStep int `json:"startStep,omitempty"`
+
+	// Type of the rule, one of threshold, patience or divergence.
+	// Defaults to threshold.
+	Type EarlyStoppingRuleType `json:"type,omitempty"`
+
+	// Patience is the number of the reported metric values without improvement
+	// after which the patience rule is reached.
+	Patience int `json:"patience,omitempty"`
+
+	// Group of the rule. Rules in the same group are reached together,
+	// and training is early stopped once all rules in any group are reached.
+	// Rules without group belong to the same default group.
+	Group string `json:"group,omitempty"`
 }
 
 // ComparisonType is the type of comparison, one of equal, less or greater.
@@ -89,6 +107,23 @@ const (
 	ComparisonTypeGreater ComparisonType = "greater"
 )
 
+// EarlyStoppingRuleType is the type of early stopping rule, one of threshold, patience or divergence.
+type EarlyStoppingRuleType string
+
+const (
+	// EarlyStoppingRuleTypeThreshold means that metric value is compared with the rule value.
+	// For the objective metric, the optimal reported value is compared.
+	EarlyStoppingRuleTypeThreshold EarlyStoppingRuleType = "threshold"
+
+	// EarlyStoppingRuleTypePatience means that metric value is not improved
+	// for the patience number of the reported values.
+	EarlyStoppingRuleTypePatience EarlyStoppingRuleType = "patience"
+
+	// EarlyStoppingRuleTypeDivergence means that the latest metric value is NaN or Inf,
+	// or exceeds the rule value in the comparison direction.
+	EarlyStoppingRuleTypeDivergence EarlyStoppingRuleType = "divergence"
+)
+
 // ObjectiveSpec represents Experiment's objective specification.
 // +k8s:deepcopy-gen=true
 type ObjectiveSpec struct {
@@ -110,6 +145,48 @@ type ObjectiveSpec struct {
 	// MetricStrategies defines various rules (min, max or latest) to extract metrics values.
 	// This field is allowed to missing, experiment defaulter (webhook) will fill it.
 	MetricStrategies []MetricStrategy `json:"metricStrategies,omitempty"`
+
+	// WorkerAggregation defines how the metrics reported by the workers of the distributed training
+	// are aggregated, one of rank0, mean, min or max.
+	// For rank0, metrics of the first worker in the rank order which reported the metric are used.
+	// For mean, min and max, the min, max and latest values of the workers are aggregated.
+	// This field is allowed to missing, experiment defaulter (webhook) will set it to rank0.
+	WorkerAggregation WorkerAggregationType `json:"workerAggregation,omitempty"`
+
+	// AdditionalObjectives represents metrics that are optimized together with the objective metric.
+	// If it is set, Experiment is the multi-objective optimization and the Pareto optimal Trials are
+	// reported in the Experiment status. The objective goal is still checked for the objective metric only.
+	// Metrics of the additional objectives are collected as the additional metrics.
+	AdditionalObjectives []AdditionalObjective `json:"additionalObjectives,omitempty"`
+
+	// Constraints represents the constraints on the metric values which the feasible Trial must satisfy.
+	// Trials which violate any constraint are marked as infeasible and they are not considered
+	// for the optimal Trial and the objective goal.
+	// Only the nsga2 algorithm takes the constraints into account when it samples the parameters,
+	// other algorithms don't avoid the infeasible parameters.
+	// Metrics of the constraints are collected as the additional metrics.
+	Constraints []ObjectiveConstraint `json:"constraints,omitempty"`
+}
+
+// AdditionalObjective represents the additional objective of the multi-objective optimization.
+type AdditionalObjective struct {
+	// ObjectiveMetricName represents metric to optimize.
+	ObjectiveMetricName string `json:"objectiveMetricName,omitempty"`
+
+	// Type for the metric optimization, one of minimize or maximize.
+	Type ObjectiveType `json:"type,omitempty"`
+}
+
+// ObjectiveConstraint represents the constraint on the metric value, e.g. latency < 20.
+type ObjectiveConstraint struct {
+	// MetricName represents the constrained metric.
+	MetricName string `json:"metricName,omitempty"`
+
+	// Comparison between the metric value and the bound, one of less or greater.
+	Comparison ComparisonType `json:"comparison,omitempty"`
+
+	// Bound of the metric value.
+	Bound float64 `json:"bound"`
 }
 
 // ObjectiveType is the type of Experiment optimization, one of minimize or maximize.
@@ -135,6 +212,16 @@ const (
 	ExtractByLatest MetricStrategyType = "latest"
 )
 
+// WorkerAggregationType describes the approaches to aggregate the metrics of the distributed training workers.
+type WorkerAggregationType string
+
+const (
+	WorkerAggregationRank0 WorkerAggregationType = "rank0"
+	WorkerAggregationMean  WorkerAggregationType = "mean"
+	WorkerAggregationMin   WorkerAggregationType = "min"
+	WorkerAggregationMax   WorkerAggregationType = "max"
+)
+
 type MetricStrategy struct {
 	Name  string             `json:"name,omitempty"`
 	Value MetricStrategyType `json:"value,omitempty"`
@@ -178,6 +265,34 @@ type FilterSpec struct {
 	// When the metrics output follows format as this field specified, metricsCollector
 	// collects it and reports to metrics server, it can be "<metric_name>: <float>" or else
 	MetricsFormat []string `json:"metricsFormat,omitempty"`
+	// Selectors of the metric values when format of metrics file is JSON, JSONDocument, YAML or CSV.
+	// If selector is not set for the metric, metric value is taken from the top-level key
+	// or the column with the metric name.
+	MetricsSelectors []MetricSelector `json:"metricsSelectors,omitempty"`
+	// Column with the timestamp of the metrics when format of metrics file is CSV.
+	// By default, the timestamp column is used if it exists.
+	TimestampColumn string `json:"timestampColumn,omitempty"`
+	// Column with the training step of the metrics when format of metrics file is CSV.
+	// By default, the step or epoch column is used if it exists.
+	StepColumn string `json:"stepColumn,omitempty"`
+	// Format of the timestamp in the metrics lines when format of metrics file is TEXT.
+	// It can be the Go time layout of the timestamp at the beginning of the line, for example: 2006-01-02 15:04:05,000,
+	// the preset: RFC3339, RFC3339Nano, Python, Glog, Unix or UnixMilli,
+	// or the regular expression with the capture group of the timestamp, for example: ts=([0-9.]+).
+	// The captured timestamp must be in RFC3339Nano, Python or Unix format.
+	// Timestamp without the time zone is in UTC. Defaults to RFC3339Nano.
+	TimestampFormat string `json:"timestampFormat,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+type MetricSelector struct {
+	// Name of the objective or additional metric
+	Name string `json:"name,omitempty"`
+	// Path of the metric value in the JSON object.
+	// Path can be in GJSON format, ref https://github.com/tidwall/gjson, for example: eval.acc
+	// or in JSONPath format, for example: $.eval.acc
+	// When format of metrics file is CSV, path is the column name.
+	Path string `json:"path,omitempty"`
 }
 
 type FileSystemKind string
@@ -193,6 +308,10 @@ type FileFormat string
 const (
 	TextFormat FileFormat = "TEXT"
 	JsonFormat FileFormat = "JSON"
+	CsvFormat  FileFormat = "CSV"
+	// YamlFormat and JsonDocumentFormat are the whole documents, which are parsed once the training is completed.
+	YamlFormat         FileFormat = "YAML"
+	JsonDocumentFormat FileFormat = "JSONDocument"
 )
 
 // +k8s:deepcopy-gen=true
@@ -223,6 +342,11 @@ const (
 	// directly, sidecar container isn't in need, and its kind is "pushCollector"
 	PushCollector CollectorKind = "Push"
 
+	// When metrics are printed to the stdout of the primary container, Trial controller
+	// streams the container logs from the Kubernetes API and parses them with the StdOut filters.
+	// The Trial's pods aren't mutated, and its kind is "KubernetesLogs"
+	KubernetesLogsCollector CollectorKind = "KubernetesLogs"
+
 	MetricsVolume = "metrics-volume"
 )
 
@@ -232,3 +356,21 @@ type CollectorSpec struct {
 	// When kind is "customCollector", this field will be used
 	CustomCollector *v1.Container `json:"customCollector,omitempty"`
 }
+
+// RetryPolicy describes how the failed Trial jobs are retried.
+// The retried Trial job is created again with the same parameter assignments.
+// +k8s:deepcopy-gen=true
+type RetryPolicy struct {
+	// Max number of retries of the failed Trial job, at most 100.
+	// Defaults to 0, the failed Trial job is not retried.
+	MaxRetries int32 `json:"maxRetries,omitempty"`
+
+	// Number of seconds to wait before the failed Trial job is created again.
+	// The delay is doubled for each subsequent retry up to 1 hour.
+	BackoffSeconds int32 `json:"backoffSeconds,omitempty"`
+
+	// List of the retryable failure reasons, e.g. Evicted, OOMKilled, ErrImagePull or PreemptionByScheduler.
+	// Reasons are matched against the Trial job failure reason and the status of the Trial job's pods.
+	// If it is empty, all failures are retryable.
+	RetryableReasons []string `json:"retryableReasons,omitempty"`
+}
@@ -25,6 +25,22 @@ import (
 	v1 "k8s.io/api/core/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalObjective) DeepCopyInto(out *AdditionalObjective) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalObjective.
+func (in *AdditionalObjective) DeepCopy() *AdditionalObjective {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalObjective)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlgorithmSetting) DeepCopyInto(out *AlgorithmSetting) {
 	*out = *in
@@ -160,6 +176,11 @@ func (in *FilterSpec) DeepCopyInto(out *FilterSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MetricsSelectors != nil {
+		in, out := &in.MetricsSelectors, &out.MetricsSelectors
+		*out = make([]MetricSelector, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -189,6 +210,22 @@ func (in *Metric) DeepCopy() *Metric {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricSelector) DeepCopyInto(out *MetricSelector) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricSelector.
+func (in *MetricSelector) DeepCopy() *MetricSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricStrategy) DeepCopyInto(out *MetricStrategy) {
 	*out = *in
@@ -231,6 +268,22 @@ func (in *MetricsCollectorSpec) DeepCopy() *MetricsCollectorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectiveConstraint) DeepCopyInto(out *ObjectiveConstraint) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectiveConstraint.
+func (in *ObjectiveConstraint) DeepCopy() *ObjectiveConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectiveConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectiveSpec) DeepCopyInto(out *ObjectiveSpec) {
 	*out = *in
@@ -249,6 +302,16 @@ func (in *ObjectiveSpec) DeepCopyInto(out *ObjectiveSpec) {
 		*out = make([]MetricStrategy, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdditionalObjectives != nil {
+		in, out := &in.AdditionalObjectives, &out.AdditionalObjectives
+		*out = make([]AdditionalObjective, len(*in))
+		copy(*out, *in)
+	}
+	if in.Constraints != nil {
+		in, out := &in.Constraints, &out.Constraints
+		*out = make([]ObjectiveConstraint, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -299,6 +362,27 @@ func (in *ParameterAssignment) DeepCopy() *ParameterAssignment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
+	*out = *in
+	if in.RetryableReasons != nil {
+		in, out := &in.RetryableReasons, &out.RetryableReasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SourceSpec) DeepCopyInto(out *SourceSpec) {
 	*out = *in
@@ -23,6 +23,12 @@ const (
 	// DefaultResumePolicy is the default value of spec.resumePolicy.
 	DefaultResumePolicy = NeverResume
 
+	// DefaultSuspendPolicy is the default value of spec.suspendPolicy.
+	DefaultSuspendPolicy = DrainSuspend
+
+	// DefaultBudgetPolicy is the default value of spec.budgetPolicy.
+	DefaultBudgetPolicy = DrainBudget
+
 	// DefaultJobSuccessCondition is the default value of spec.trialTemplate.successCondition for Job.
 	DefaultJobSuccessCondition = "status.conditions.#(type==\"Complete\")#|#(status==\"True\")#"
 
@@ -27,6 +27,8 @@ import (
 func (e *Experiment) SetDefault() {
 	e.setDefaultParallelTrialCount()
 	e.setDefaultResumePolicy()
+	e.setDefaultSuspendPolicy()
+	e.setDefaultBudgetPolicy()
 	e.setDefaultObjective()
 	e.setDefaultTrialTemplate()
 	e.setDefaultMetricsCollector()
@@ -45,6 +47,18 @@ func (e *Experiment) setDefaultResumePolicy() {
 	}
 }
 
+func (e *Experiment) setDefaultSuspendPolicy() {
+	if e.Spec.SuspendPolicy == "" {
+		e.Spec.SuspendPolicy = DefaultSuspendPolicy
+	}
+}
+
+func (e *Experiment) setDefaultBudgetPolicy() {
+	if e.Spec.BudgetPolicy == "" && (e.Spec.MaxDuration != nil || len(e.Spec.ResourceBudget) != 0) {
+		e.Spec.BudgetPolicy = DefaultBudgetPolicy
+	}
+}
+
 func (e *Experiment) setDefaultObjective() {
 	obj := e.Spec.Objective
 	if obj != nil {
@@ -75,6 +89,41 @@ func (e *Experiment) setDefaultObjective() {
 			obj.MetricStrategies = append(obj.MetricStrategies, strategy)
 		}
 
+		// Metrics of additional objectives are collected as additional metrics.
+		// Set default strategy of additional objectives according to their ObjectiveType.
+		for _, objective := range obj.AdditionalObjectives {
+			if !containsString(obj.AdditionalMetricNames, objective.ObjectiveMetricName) {
+				obj.AdditionalMetricNames = append(obj.AdditionalMetricNames, objective.ObjectiveMetricName)
+			}
+			if _, ok := metricsWithDefault[objective.ObjectiveMetricName]; !ok {
+				strategy := common.MetricStrategy{Name: objective.ObjectiveMetricName, Value: common.ExtractByLatest}
+				switch objective.Type {
+				case common.ObjectiveTypeMinimize:
+					strategy.Value = common.ExtractByMin
+				case common.ObjectiveTypeMaximize:
+					strategy.Value = common.ExtractByMax
+				}
+				obj.MetricStrategies = append(obj.MetricStrategies, strategy)
+				metricsWithDefault[objective.ObjectiveMetricName] = 1
+			}
+		}
+
+		// Metrics of constraints are collected as additional metrics.
+		// Set default strategy of constraint metrics to ExtractByLatest.
+		for _, constraint := range obj.Constraints {
+			if constraint.MetricName == obj.ObjectiveMetricName {
+				continue
+			}
+			if !containsString(obj.AdditionalMetricNames, constraint.MetricName) {
+				obj.AdditionalMetricNames = append(obj.AdditionalMetricNames, constraint.MetricName)
+			}
+			if _, ok := metricsWithDefault[constraint.MetricName]; !ok {
+				obj.MetricStrategies = append(obj.MetricStrategies,
+					common.MetricStrategy{Name: constraint.MetricName, Value: common.ExtractByLatest})
+				metricsWithDefault[constraint.MetricName] = 1
+			}
+		}
+
 		// Set default strategy of additional metrics to ExtractByLatest.
 		for _, metricName := range obj.AdditionalMetricNames {
 			if _, ok := metricsWithDefault[metricName]; !ok {
@@ -90,6 +139,10 @@ func (e *Experiment) setDefaultObjective() {
 				obj.MetricStrategies = append(obj.MetricStrategies, strategy)
 			}
 		}
+
+		if obj.WorkerAggregation == "" {
+			obj.WorkerAggregation = common.WorkerAggregationRank0
+		}
 	}
 }
 
@@ -176,3 +229,12 @@ func (e *Experiment) setDefaultMetricsCollector() {
 		}
 	}
 }
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -58,6 +58,28 @@ type ExperimentSpec struct {
 	// Describes resuming policy which usually take effect after experiment terminated.
 	// Default value is Never.
 	ResumePolicy ResumePolicyType `json:"resumePolicy,omitempty"`
+
+	// Suspend indicates whether the experiment should stop creating new trials.
+	// Unsuspending the experiment resumes it from the point where it was suspended.
+	// Defaults to false.
+	Suspend bool `json:"suspend,omitempty"`
+
+	// Describes what happens to the active trials when experiment is suspended.
+	// Default value is Drain.
+	SuspendPolicy SuspendPolicyType `json:"suspendPolicy,omitempty"`
+
+	// Max wall clock duration of the experiment from its start time.
+	// Experiment is succeeded when the duration is reached.
+	MaxDuration *metav1.Duration `json:"maxDuration,omitempty"`
+
+	// Max total resource usage of the trials in resource-hours, for example cpu: 100 means 100 CPU-hours.
+	// Resource usage of the trial is the resource requests of the pod templates in the trial run spec
+	// multiplied by the trial runtime. Experiment is succeeded when any resource budget is exhausted.
+	ResourceBudget v1.ResourceList `json:"resourceBudget,omitempty"`
+
+	// Describes what happens to the active trials when maxDuration is reached or resourceBudget is exhausted.
+	// Default value is Drain if maxDuration or resourceBudget is set.
+	BudgetPolicy BudgetPolicyType `json:"budgetPolicy,omitempty"`
 }
 
 // ExperimentStatus is the current status of an Experiment.
@@ -83,6 +105,19 @@ type ExperimentStatus struct {
 	// Current optimal trial parameters and observations.
 	CurrentOptimalTrial OptimalTrial `json:"currentOptimalTrial,omitempty"`
 
+	// Total resource usage of the trials in resource-hours.
+	// It is set only when experiment has the resource budget.
+	// Usage is accumulated while experiment is not completed, including usage of the deleted trials.
+	ResourceUsage v1.ResourceList `json:"resourceUsage,omitempty"`
+
+	// Represents time until which the resource usage of the trials is accounted.
+	// It is represented in RFC3339 form and is in UTC.
+	ResourceUsageLastUpdateTime *metav1.Time `json:"resourceUsageLastUpdateTime,omitempty"`
+
+	// List of the Pareto optimal trials, which are succeeded and not dominated by any other succeeded trial.
+	// It is set only when experiment has additional objectives.
+	ParetoOptimalTrials []OptimalTrial `json:"paretoOptimalTrials,omitempty"`
+
 	// List of trial names which are running.
 	RunningTrialList []string `json:"runningTrialList,omitempty"`
 
@@ -171,6 +206,7 @@ const (
 	ExperimentRestarting ExperimentConditionType = "Restarting"
 	ExperimentSucceeded  ExperimentConditionType = "Succeeded"
 	ExperimentFailed     ExperimentConditionType = "Failed"
+	ExperimentSuspended  ExperimentConditionType = "Suspended"
 )
 
 // ResumePolicyType describes how the experiment should be resumed.
@@ -190,6 +226,27 @@ const (
 	FromVolume ResumePolicyType = "FromVolume"
 )
 
+// SuspendPolicyType describes how the active trials are handled when the experiment is suspended.
+type SuspendPolicyType string
+
+const (
+	// DrainSuspend indicates that pending and running trials are allowed to finish.
+	DrainSuspend SuspendPolicyType = "Drain"
+	// KillSuspend indicates that pending and running trials are deleted.
+	// Their assignments and observation logs are kept and the trials are created again when experiment is resumed.
+	KillSuspend SuspendPolicyType = "Kill"
+)
+
+// BudgetPolicyType describes how the active trials are handled when the experiment budget runs out.
+type BudgetPolicyType string
+
+const (
+	// DrainBudget indicates that pending and running trials are allowed to finish.
+	DrainBudget BudgetPolicyType = "Drain"
+	// KillBudget indicates that pending and running trials are deleted.
+	KillBudget BudgetPolicyType = "Kill"
+)
+
 type ParameterSpec struct {
 	Name          string        `json:"name,omitempty"`
 	ParameterType ParameterType `json:"parameterType,omitempty"`
@@ -251,6 +308,10 @@ type TrialTemplate struct {
 	// Condition must be in GJSON format, ref https://github.com/tidwall/gjson.
 	// For example for BatchJob: status.conditions.#(type=="Failed")#|#(status=="True")#
 	FailureCondition string `json:"failureCondition,omitempty"`
+
+	// Describes how the failed trial jobs are retried.
+	// If it is omitted, the failed trial jobs are not retried.
+	RetryPolicy *common.RetryPolicy `json:"retryPolicy,omitempty"`
 }
 
 // TrialSource represent the source for trial template
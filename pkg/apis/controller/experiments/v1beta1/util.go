@@ -86,6 +86,10 @@ func (exp *Experiment) IsRestarting() bool {
 	return hasCondition(exp, ExperimentRestarting)
 }
 
+func (exp *Experiment) IsSuspended() bool {
+	return hasCondition(exp, ExperimentSuspended)
+}
+
 func (exp *Experiment) IsCompleted() bool {
 	return exp.IsSucceeded() || exp.IsFailed()
 }
@@ -158,3 +162,14 @@ func (exp *Experiment) MarkExperimentStatusFailed(reason, message string) {
 	}
 	exp.setCondition(ExperimentFailed, v1.ConditionTrue, reason, message)
 }
+
+func (exp *Experiment) MarkExperimentStatusSuspended(reason, message string) {
+	exp.setCondition(ExperimentSuspended, v1.ConditionTrue, reason, message)
+}
+
+// MarkExperimentStatusResumed removes the Suspended condition and marks the Experiment running again,
+// so that Running is the latest condition.
+func (exp *Experiment) MarkExperimentStatusResumed(reason, message string) {
+	exp.removeCondition(ExperimentSuspended)
+	exp.setCondition(ExperimentRunning, v1.ConditionTrue, reason, message)
+}
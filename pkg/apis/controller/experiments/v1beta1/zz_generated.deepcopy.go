@@ -23,6 +23,8 @@ package v1beta1
 
 import (
 	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -176,6 +178,18 @@ func (in *ExperimentSpec) DeepCopyInto(out *ExperimentSpec) {
 		*out = new(NasConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaxDuration != nil {
+		in, out := &in.MaxDuration, &out.MaxDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ResourceBudget != nil {
+		in, out := &in.ResourceBudget, &out.ResourceBudget
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -212,6 +226,24 @@ func (in *ExperimentStatus) DeepCopyInto(out *ExperimentStatus) {
 		}
 	}
 	in.CurrentOptimalTrial.DeepCopyInto(&out.CurrentOptimalTrial)
+	if in.ResourceUsage != nil {
+		in, out := &in.ResourceUsage, &out.ResourceUsage
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.ResourceUsageLastUpdateTime != nil {
+		in, out := &in.ResourceUsageLastUpdateTime, &out.ResourceUsageLastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ParetoOptimalTrials != nil {
+		in, out := &in.ParetoOptimalTrials, &out.ParetoOptimalTrials
+		*out = make([]OptimalTrial, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.RunningTrialList != nil {
 		in, out := &in.RunningTrialList, &out.RunningTrialList
 		*out = make([]string, len(*in))
@@ -455,6 +487,11 @@ func (in *TrialTemplate) DeepCopyInto(out *TrialTemplate) {
 			(*out)[key] = val
 		}
 	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(commonv1beta1.RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -39,6 +39,9 @@ type SuggestionSpec struct {
 	// ResumePolicy describes resuming policy which usually take effect after experiment terminated.
 	// Default value is Never.
 	ResumePolicy experiment.ResumePolicyType `json:"resumePolicy,omitempty"`
+
+	// Suspend indicates that suggestion deployment is scaled down because experiment is suspended.
+	Suspend bool `json:"suspend,omitempty"`
 }
 
 // SuggestionStatus is the current status of a Suggestion.
@@ -64,6 +64,9 @@ type TrialSpec struct {
 
 	// Labels that provide additional metadata for services (e.g. Suggestions tracking)
 	Labels map[string]string `json:"labels,omitempty"`
+
+	// Describes how the failed trial job is retried.
+	RetryPolicy *common.RetryPolicy `json:"retryPolicy,omitempty"`
 }
 
 // TrialStatus is the current status of a Trial.
@@ -88,6 +91,29 @@ type TrialStatus struct {
 
 	// Results of the Trial - objectives and other metrics values.
 	Observation *common.Observation `json:"observation,omitempty"`
+
+	// Infeasible is true if the Trial observation violates any of the objective constraints.
+	Infeasible bool `json:"infeasible,omitempty"`
+
+	// History of the failed Trial job attempts which were retried.
+	Attempts []TrialAttempt `json:"attempts,omitempty"`
+}
+
+// TrialAttempt describes the failed Trial job attempt which was retried.
+type TrialAttempt struct {
+	// Represents time when the Trial job of the attempt was created.
+	// It is represented in RFC3339 form and is in UTC.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Represents time when the Trial job of the attempt was failed.
+	// It is represented in RFC3339 form and is in UTC.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// The retryable failure reason of the attempt.
+	Reason string `json:"reason,omitempty"`
+
+	// A human readable message indicating details about the failure.
+	Message string `json:"message,omitempty"`
 }
 
 // +k8s:deepcopy-gen=true
@@ -18,6 +18,9 @@ package v1beta1
 
 import (
 	"errors"
+	"strconv"
+
+	common "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 
 	v1 "k8s.io/api/core/v1"
@@ -106,6 +109,69 @@ func (trial *Trial) IsObservationAvailable() bool {
 	return false
 }
 
+// GetMetricValue returns the value of the metric extracted by the metric strategy.
+func (trial *Trial) GetMetricValue(metricName string) string {
+	if trial.Status.Observation == nil {
+		return consts.UnavailableMetricValue
+	}
+	var metricStrategy common.MetricStrategyType
+	for _, strategy := range trial.Spec.Objective.MetricStrategies {
+		if strategy.Name == metricName {
+			metricStrategy = strategy.Value
+			break
+		}
+	}
+	for _, metric := range trial.Status.Observation.Metrics {
+		if metricName == metric.Name {
+			switch metricStrategy {
+			case common.ExtractByMin:
+				if metric.Min == consts.UnavailableMetricValue {
+					return metric.Latest
+				}
+				return metric.Min
+			case common.ExtractByMax:
+				if metric.Max == consts.UnavailableMetricValue {
+					return metric.Latest
+				}
+				return metric.Max
+			case common.ExtractByLatest:
+				return metric.Latest
+			}
+		}
+	}
+	return consts.UnavailableMetricValue
+}
+
+// GetViolatedConstraints returns the objective constraints which are violated by the Trial observation.
+// Constraints are violated if the metric values are unavailable or not numeric.
+func (trial *Trial) GetViolatedConstraints() []common.ObjectiveConstraint {
+	if trial.Spec.Objective == nil {
+		return nil
+	}
+	var violated []common.ObjectiveConstraint
+	for _, constraint := range trial.Spec.Objective.Constraints {
+		value, err := strconv.ParseFloat(trial.GetMetricValue(constraint.MetricName), 64)
+		if err != nil || !isConstraintSatisfied(constraint, value) {
+			violated = append(violated, constraint)
+		}
+	}
+	return violated
+}
+
+func isConstraintSatisfied(constraint common.ObjectiveConstraint, value float64) bool {
+	switch constraint.Comparison {
+	case common.ComparisonTypeLess:
+		return value < constraint.Bound
+	case common.ComparisonTypeGreater:
+		return value > constraint.Bound
+	}
+	return false
+}
+
+func (trial *Trial) IsInfeasible() bool {
+	return trial.Status.Infeasible
+}
+
 func (trial *Trial) IsCompleted() bool {
 	return trial.IsSucceeded() || trial.IsFailed() || trial.IsKilled() || trial.IsEarlyStopped() || trial.IsMetricsUnavailable()
 }
@@ -22,9 +22,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	DBManager_ReportObservationLog_FullMethodName = "/api.v1.beta1.DBManager/ReportObservationLog"
-	DBManager_GetObservationLog_FullMethodName    = "/api.v1.beta1.DBManager/GetObservationLog"
-	DBManager_DeleteObservationLog_FullMethodName = "/api.v1.beta1.DBManager/DeleteObservationLog"
+	DBManager_ReportObservationLog_FullMethodName  = "/api.v1.beta1.DBManager/ReportObservationLog"
+	DBManager_GetObservationLog_FullMethodName     = "/api.v1.beta1.DBManager/GetObservationLog"
+	DBManager_StreamObservationLog_FullMethodName  = "/api.v1.beta1.DBManager/StreamObservationLog"
+	DBManager_WatchObservationLog_FullMethodName   = "/api.v1.beta1.DBManager/WatchObservationLog"
+	DBManager_DeleteObservationLog_FullMethodName  = "/api.v1.beta1.DBManager/DeleteObservationLog"
+	DBManager_GetObservationSummary_FullMethodName = "/api.v1.beta1.DBManager/GetObservationSummary"
 )
 
 // DBManagerClient is the client API for DBManager service.
@@ -41,8 +44,18 @@ type DBManagerClient interface {
 	// Get all log of Observations for a Trial.
 	GetObservationLog(ctx context.Context, in *GetObservationLogRequest, opts ...grpc.CallOption) (*GetObservationLogReply, error)
 	// *
+	// Stream all log of Observations for a Trial page by page.
+	StreamObservationLog(ctx context.Context, in *GetObservationLogRequest, opts ...grpc.CallOption) (DBManager_StreamObservationLogClient, error)
+	// *
+	// Watch new logs of Observations for a Trial as they are reported.
+	// Only the logs reported to the same DB manager replica are streamed, so DB manager must run as a single replica.
+	WatchObservationLog(ctx context.Context, in *WatchObservationLogRequest, opts ...grpc.CallOption) (DBManager_WatchObservationLogClient, error)
+	// *
 	// Delete all log of Observations for a Trial.
 	DeleteObservationLog(ctx context.Context, in *DeleteObservationLogRequest, opts ...grpc.CallOption) (*DeleteObservationLogReply, error)
+	// *
+	// Get min, max, latest value and number of logs for each metric of a Trial.
+	GetObservationSummary(ctx context.Context, in *GetObservationSummaryRequest, opts ...grpc.CallOption) (*GetObservationSummaryReply, error)
 }
 
 type dBManagerClient struct {
@@ -71,6 +84,70 @@ func (c *dBManagerClient) GetObservationLog(ctx context.Context, in *GetObservat
 	return out, nil
 }
 
+func (c *dBManagerClient) StreamObservationLog(ctx context.Context, in *GetObservationLogRequest, opts ...grpc.CallOption) (DBManager_StreamObservationLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DBManager_ServiceDesc.Streams[0], DBManager_StreamObservationLog_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dBManagerStreamObservationLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DBManager_StreamObservationLogClient interface {
+	Recv() (*GetObservationLogReply, error)
+	grpc.ClientStream
+}
+
+type dBManagerStreamObservationLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *dBManagerStreamObservationLogClient) Recv() (*GetObservationLogReply, error) {
+	m := new(GetObservationLogReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dBManagerClient) WatchObservationLog(ctx context.Context, in *WatchObservationLogRequest, opts ...grpc.CallOption) (DBManager_WatchObservationLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DBManager_ServiceDesc.Streams[1], DBManager_WatchObservationLog_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dBManagerWatchObservationLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DBManager_WatchObservationLogClient interface {
+	Recv() (*WatchObservationLogReply, error)
+	grpc.ClientStream
+}
+
+type dBManagerWatchObservationLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *dBManagerWatchObservationLogClient) Recv() (*WatchObservationLogReply, error) {
+	m := new(WatchObservationLogReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *dBManagerClient) DeleteObservationLog(ctx context.Context, in *DeleteObservationLogRequest, opts ...grpc.CallOption) (*DeleteObservationLogReply, error) {
 	out := new(DeleteObservationLogReply)
 	err := c.cc.Invoke(ctx, DBManager_DeleteObservationLog_FullMethodName, in, out, opts...)
@@ -80,6 +157,15 @@ func (c *dBManagerClient) DeleteObservationLog(ctx context.Context, in *DeleteOb
 	return out, nil
 }
 
+func (c *dBManagerClient) GetObservationSummary(ctx context.Context, in *GetObservationSummaryRequest, opts ...grpc.CallOption) (*GetObservationSummaryReply, error) {
+	out := new(GetObservationSummaryReply)
+	err := c.cc.Invoke(ctx, DBManager_GetObservationSummary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DBManagerServer is the server API for DBManager service.
 // All implementations should embed UnimplementedDBManagerServer
 // for forward compatibility
@@ -94,8 +180,18 @@ type DBManagerServer interface {
 	// Get all log of Observations for a Trial.
 	GetObservationLog(context.Context, *GetObservationLogRequest) (*GetObservationLogReply, error)
 	// *
+	// Stream all log of Observations for a Trial page by page.
+	StreamObservationLog(*GetObservationLogRequest, DBManager_StreamObservationLogServer) error
+	// *
+	// Watch new logs of Observations for a Trial as they are reported.
+	// Only the logs reported to the same DB manager replica are streamed, so DB manager must run as a single replica.
+	WatchObservationLog(*WatchObservationLogRequest, DBManager_WatchObservationLogServer) error
+	// *
 	// Delete all log of Observations for a Trial.
 	DeleteObservationLog(context.Context, *DeleteObservationLogRequest) (*DeleteObservationLogReply, error)
+	// *
+	// Get min, max, latest value and number of logs for each metric of a Trial.
+	GetObservationSummary(context.Context, *GetObservationSummaryRequest) (*GetObservationSummaryReply, error)
 }
 
 // UnimplementedDBManagerServer should be embedded to have forward compatible implementations.
@@ -108,9 +204,18 @@ func (UnimplementedDBManagerServer) ReportObservationLog(context.Context, *Repor
 func (UnimplementedDBManagerServer) GetObservationLog(context.Context, *GetObservationLogRequest) (*GetObservationLogReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetObservationLog not implemented")
 }
+func (UnimplementedDBManagerServer) StreamObservationLog(*GetObservationLogRequest, DBManager_StreamObservationLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamObservationLog not implemented")
+}
+func (UnimplementedDBManagerServer) WatchObservationLog(*WatchObservationLogRequest, DBManager_WatchObservationLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchObservationLog not implemented")
+}
 func (UnimplementedDBManagerServer) DeleteObservationLog(context.Context, *DeleteObservationLogRequest) (*DeleteObservationLogReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteObservationLog not implemented")
 }
+func (UnimplementedDBManagerServer) GetObservationSummary(context.Context, *GetObservationSummaryRequest) (*GetObservationSummaryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObservationSummary not implemented")
+}
 
 // UnsafeDBManagerServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to DBManagerServer will
@@ -159,6 +264,48 @@ func _DBManager_GetObservationLog_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DBManager_StreamObservationLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetObservationLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DBManagerServer).StreamObservationLog(m, &dBManagerStreamObservationLogServer{stream})
+}
+
+type DBManager_StreamObservationLogServer interface {
+	Send(*GetObservationLogReply) error
+	grpc.ServerStream
+}
+
+type dBManagerStreamObservationLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *dBManagerStreamObservationLogServer) Send(m *GetObservationLogReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DBManager_WatchObservationLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchObservationLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DBManagerServer).WatchObservationLog(m, &dBManagerWatchObservationLogServer{stream})
+}
+
+type DBManager_WatchObservationLogServer interface {
+	Send(*WatchObservationLogReply) error
+	grpc.ServerStream
+}
+
+type dBManagerWatchObservationLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *dBManagerWatchObservationLogServer) Send(m *WatchObservationLogReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _DBManager_DeleteObservationLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteObservationLogRequest)
 	if err := dec(in); err != nil {
@@ -177,6 +324,24 @@ func _DBManager_DeleteObservationLog_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DBManager_GetObservationSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObservationSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBManagerServer).GetObservationSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DBManager_GetObservationSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBManagerServer).GetObservationSummary(ctx, req.(*GetObservationSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DBManager_ServiceDesc is the grpc.ServiceDesc for DBManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -196,8 +361,23 @@ var DBManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteObservationLog",
 			Handler:    _DBManager_DeleteObservationLog_Handler,
 		},
+		{
+			MethodName: "GetObservationSummary",
+			Handler:    _DBManager_GetObservationSummary_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamObservationLog",
+			Handler:       _DBManager_StreamObservationLog_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchObservationLog",
+			Handler:       _DBManager_WatchObservationLog_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
 }
 
@@ -28,12 +28,26 @@ class ObjectiveType(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     MINIMIZE: _ClassVar[ObjectiveType]
     MAXIMIZE: _ClassVar[ObjectiveType]
 
+class WorkerAggregation(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    RANK0: _ClassVar[WorkerAggregation]
+    MEAN: _ClassVar[WorkerAggregation]
+    MIN: _ClassVar[WorkerAggregation]
+    MAX: _ClassVar[WorkerAggregation]
+
 class ComparisonType(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     __slots__ = ()
     UNKNOWN_COMPARISON: _ClassVar[ComparisonType]
     EQUAL: _ClassVar[ComparisonType]
     LESS: _ClassVar[ComparisonType]
     GREATER: _ClassVar[ComparisonType]
+
+class EarlyStoppingRuleType(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    UNKNOWN_RULE_TYPE: _ClassVar[EarlyStoppingRuleType]
+    THRESHOLD: _ClassVar[EarlyStoppingRuleType]
+    PATIENCE: _ClassVar[EarlyStoppingRuleType]
+    DIVERGENCE: _ClassVar[EarlyStoppingRuleType]
 UNKNOWN_TYPE: ParameterType
 DOUBLE: ParameterType
 INT: ParameterType
@@ -47,10 +61,18 @@ DISTRIBUTION_UNKNOWN: Distribution
 UNKNOWN: ObjectiveType
 MINIMIZE: ObjectiveType
 MAXIMIZE: ObjectiveType
+RANK0: WorkerAggregation
+MEAN: WorkerAggregation
+MIN: WorkerAggregation
+MAX: WorkerAggregation
 UNKNOWN_COMPARISON: ComparisonType
 EQUAL: ComparisonType
 LESS: ComparisonType
 GREATER: ComparisonType
+UNKNOWN_RULE_TYPE: EarlyStoppingRuleType
+THRESHOLD: EarlyStoppingRuleType
+PATIENCE: EarlyStoppingRuleType
+DIVERGENCE: EarlyStoppingRuleType
 
 class Experiment(_message.Message):
     __slots__ = ("name", "spec")
@@ -108,16 +130,38 @@ class FeasibleSpace(_message.Message):
     def __init__(self, max: _Optional[str] = ..., min: _Optional[str] = ..., list: _Optional[_Iterable[str]] = ..., step: _Optional[str] = ..., distribution: _Optional[_Union[Distribution, str]] = ...) -> None: ...
 
 class ObjectiveSpec(_message.Message):
-    __slots__ = ("type", "goal", "objective_metric_name", "additional_metric_names")
+    __slots__ = ("type", "goal", "objective_metric_name", "additional_metric_names", "additional_objectives", "constraints")
     TYPE_FIELD_NUMBER: _ClassVar[int]
     GOAL_FIELD_NUMBER: _ClassVar[int]
     OBJECTIVE_METRIC_NAME_FIELD_NUMBER: _ClassVar[int]
     ADDITIONAL_METRIC_NAMES_FIELD_NUMBER: _ClassVar[int]
+    ADDITIONAL_OBJECTIVES_FIELD_NUMBER: _ClassVar[int]
+    CONSTRAINTS_FIELD_NUMBER: _ClassVar[int]
     type: ObjectiveType
     goal: float
     objective_metric_name: str
     additional_metric_names: _containers.RepeatedScalarFieldContainer[str]
-    def __init__(self, type: _Optional[_Union[ObjectiveType, str]] = ..., goal: _Optional[float] = ..., objective_metric_name: _Optional[str] = ..., additional_metric_names: _Optional[_Iterable[str]] = ...) -> None: ...
+    additional_objectives: _containers.RepeatedCompositeFieldContainer[AdditionalObjective]
+    constraints: _containers.RepeatedCompositeFieldContainer[ObjectiveConstraint]
+    def __init__(self, type: _Optional[_Union[ObjectiveType, str]] = ..., goal: _Optional[float] = ..., objective_metric_name: _Optional[str] = ..., additional_metric_names: _Optional[_Iterable[str]] = ..., additional_objectives: _Optional[_Iterable[_Union[AdditionalObjective, _Mapping]]] = ..., constraints: _Optional[_Iterable[_Union[ObjectiveConstraint, _Mapping]]] = ...) -> None: ...
+
+class ObjectiveConstraint(_message.Message):
+    __slots__ = ("metric_name", "comparison", "bound")
+    METRIC_NAME_FIELD_NUMBER: _ClassVar[int]
+    COMPARISON_FIELD_NUMBER: _ClassVar[int]
+    BOUND_FIELD_NUMBER: _ClassVar[int]
+    metric_name: str
+    comparison: ComparisonType
+    bound: float
+    def __init__(self, metric_name: _Optional[str] = ..., comparison: _Optional[_Union[ComparisonType, str]] = ..., bound: _Optional[float] = ...) -> None: ...
+
+class AdditionalObjective(_message.Message):
+    __slots__ = ("objective_metric_name", "type")
+    OBJECTIVE_METRIC_NAME_FIELD_NUMBER: _ClassVar[int]
+    TYPE_FIELD_NUMBER: _ClassVar[int]
+    objective_metric_name: str
+    type: ObjectiveType
+    def __init__(self, objective_metric_name: _Optional[str] = ..., type: _Optional[_Union[ObjectiveType, str]] = ...) -> None: ...
 
 class AlgorithmSpec(_message.Message):
     __slots__ = ("algorithm_name", "algorithm_settings")
@@ -272,12 +316,16 @@ class Metric(_message.Message):
     def __init__(self, name: _Optional[str] = ..., value: _Optional[str] = ...) -> None: ...
 
 class ReportObservationLogRequest(_message.Message):
-    __slots__ = ("trial_name", "observation_log")
+    __slots__ = ("trial_name", "observation_log", "namespace", "batch_id")
     TRIAL_NAME_FIELD_NUMBER: _ClassVar[int]
     OBSERVATION_LOG_FIELD_NUMBER: _ClassVar[int]
+    NAMESPACE_FIELD_NUMBER: _ClassVar[int]
+    BATCH_ID_FIELD_NUMBER: _ClassVar[int]
     trial_name: str
     observation_log: ObservationLog
-    def __init__(self, trial_name: _Optional[str] = ..., observation_log: _Optional[_Union[ObservationLog, _Mapping]] = ...) -> None: ...
+    namespace: str
+    batch_id: str
+    def __init__(self, trial_name: _Optional[str] = ..., observation_log: _Optional[_Union[ObservationLog, _Mapping]] = ..., namespace: _Optional[str] = ..., batch_id: _Optional[str] = ...) -> None: ...
 
 class ReportObservationLogReply(_message.Message):
     __slots__ = ()
@@ -290,41 +338,121 @@ class ObservationLog(_message.Message):
     def __init__(self, metric_logs: _Optional[_Iterable[_Union[MetricLog, _Mapping]]] = ...) -> None: ...
 
 class MetricLog(_message.Message):
-    __slots__ = ("time_stamp", "metric")
+    __slots__ = ("time_stamp", "metric", "step", "trial_name", "worker")
     TIME_STAMP_FIELD_NUMBER: _ClassVar[int]
     METRIC_FIELD_NUMBER: _ClassVar[int]
+    STEP_FIELD_NUMBER: _ClassVar[int]
+    TRIAL_NAME_FIELD_NUMBER: _ClassVar[int]
+    WORKER_FIELD_NUMBER: _ClassVar[int]
     time_stamp: str
     metric: Metric
-    def __init__(self, time_stamp: _Optional[str] = ..., metric: _Optional[_Union[Metric, _Mapping]] = ...) -> None: ...
+    step: int
+    trial_name: str
+    worker: str
+    def __init__(self, time_stamp: _Optional[str] = ..., metric: _Optional[_Union[Metric, _Mapping]] = ..., step: _Optional[int] = ..., trial_name: _Optional[str] = ..., worker: _Optional[str] = ...) -> None: ...
 
 class GetObservationLogRequest(_message.Message):
-    __slots__ = ("trial_name", "metric_name", "start_time", "end_time")
+    __slots__ = ("trial_name", "metric_name", "start_time", "end_time", "namespace", "page_size", "page_token", "start_step", "end_step", "trial_names", "metric_names", "rank0_worker", "logs_per_trial")
     TRIAL_NAME_FIELD_NUMBER: _ClassVar[int]
     METRIC_NAME_FIELD_NUMBER: _ClassVar[int]
     START_TIME_FIELD_NUMBER: _ClassVar[int]
     END_TIME_FIELD_NUMBER: _ClassVar[int]
+    NAMESPACE_FIELD_NUMBER: _ClassVar[int]
+    PAGE_SIZE_FIELD_NUMBER: _ClassVar[int]
+    PAGE_TOKEN_FIELD_NUMBER: _ClassVar[int]
+    START_STEP_FIELD_NUMBER: _ClassVar[int]
+    END_STEP_FIELD_NUMBER: _ClassVar[int]
+    TRIAL_NAMES_FIELD_NUMBER: _ClassVar[int]
+    METRIC_NAMES_FIELD_NUMBER: _ClassVar[int]
+    RANK0_WORKER_FIELD_NUMBER: _ClassVar[int]
+    LOGS_PER_TRIAL_FIELD_NUMBER: _ClassVar[int]
     trial_name: str
     metric_name: str
     start_time: str
     end_time: str
-    def __init__(self, trial_name: _Optional[str] = ..., metric_name: _Optional[str] = ..., start_time: _Optional[str] = ..., end_time: _Optional[str] = ...) -> None: ...
+    namespace: str
+    page_size: int
+    page_token: str
+    start_step: int
+    end_step: int
+    trial_names: _containers.RepeatedScalarFieldContainer[str]
+    metric_names: _containers.RepeatedScalarFieldContainer[str]
+    rank0_worker: bool
+    logs_per_trial: int
+    def __init__(self, trial_name: _Optional[str] = ..., metric_name: _Optional[str] = ..., start_time: _Optional[str] = ..., end_time: _Optional[str] = ..., namespace: _Optional[str] = ..., page_size: _Optional[int] = ..., page_token: _Optional[str] = ..., start_step: _Optional[int] = ..., end_step: _Optional[int] = ..., trial_names: _Optional[_Iterable[str]] = ..., metric_names: _Optional[_Iterable[str]] = ..., rank0_worker: _Optional[bool] = ..., logs_per_trial: _Optional[int] = ...) -> None: ...
 
 class GetObservationLogReply(_message.Message):
-    __slots__ = ("observation_log",)
+    __slots__ = ("observation_log", "next_page_token")
     OBSERVATION_LOG_FIELD_NUMBER: _ClassVar[int]
+    NEXT_PAGE_TOKEN_FIELD_NUMBER: _ClassVar[int]
     observation_log: ObservationLog
-    def __init__(self, observation_log: _Optional[_Union[ObservationLog, _Mapping]] = ...) -> None: ...
+    next_page_token: str
+    def __init__(self, observation_log: _Optional[_Union[ObservationLog, _Mapping]] = ..., next_page_token: _Optional[str] = ...) -> None: ...
+
+class WatchObservationLogRequest(_message.Message):
+    __slots__ = ("trial_name", "namespace", "metric_name", "start_time")
+    TRIAL_NAME_FIELD_NUMBER: _ClassVar[int]
+    NAMESPACE_FIELD_NUMBER: _ClassVar[int]
+    METRIC_NAME_FIELD_NUMBER: _ClassVar[int]
+    START_TIME_FIELD_NUMBER: _ClassVar[int]
+    trial_name: str
+    namespace: str
+    metric_name: str
+    start_time: str
+    def __init__(self, trial_name: _Optional[str] = ..., namespace: _Optional[str] = ..., metric_name: _Optional[str] = ..., start_time: _Optional[str] = ...) -> None: ...
+
+class WatchObservationLogReply(_message.Message):
+    __slots__ = ("metric_logs",)
+    METRIC_LOGS_FIELD_NUMBER: _ClassVar[int]
+    metric_logs: _containers.RepeatedCompositeFieldContainer[MetricLog]
+    def __init__(self, metric_logs: _Optional[_Iterable[_Union[MetricLog, _Mapping]]] = ...) -> None: ...
 
 class DeleteObservationLogRequest(_message.Message):
-    __slots__ = ("trial_name",)
+    __slots__ = ("trial_name", "namespace")
     TRIAL_NAME_FIELD_NUMBER: _ClassVar[int]
+    NAMESPACE_FIELD_NUMBER: _ClassVar[int]
     trial_name: str
-    def __init__(self, trial_name: _Optional[str] = ...) -> None: ...
+    namespace: str
+    def __init__(self, trial_name: _Optional[str] = ..., namespace: _Optional[str] = ...) -> None: ...
 
 class DeleteObservationLogReply(_message.Message):
     __slots__ = ()
     def __init__(self) -> None: ...
 
+class GetObservationSummaryRequest(_message.Message):
+    __slots__ = ("trial_name", "namespace", "metric_names", "worker_aggregation")
+    TRIAL_NAME_FIELD_NUMBER: _ClassVar[int]
+    NAMESPACE_FIELD_NUMBER: _ClassVar[int]
+    METRIC_NAMES_FIELD_NUMBER: _ClassVar[int]
+    WORKER_AGGREGATION_FIELD_NUMBER: _ClassVar[int]
+    trial_name: str
+    namespace: str
+    metric_names: _containers.RepeatedScalarFieldContainer[str]
+    worker_aggregation: WorkerAggregation
+    def __init__(self, trial_name: _Optional[str] = ..., namespace: _Optional[str] = ..., metric_names: _Optional[_Iterable[str]] = ..., worker_aggregation: _Optional[_Union[WorkerAggregation, str]] = ...) -> None: ...
+
+class GetObservationSummaryReply(_message.Message):
+    __slots__ = ("metric_summaries",)
+    METRIC_SUMMARIES_FIELD_NUMBER: _ClassVar[int]
+    metric_summaries: _containers.RepeatedCompositeFieldContainer[MetricSummary]
+    def __init__(self, metric_summaries: _Optional[_Iterable[_Union[MetricSummary, _Mapping]]] = ...) -> None: ...
+
+class MetricSummary(_message.Message):
+    __slots__ = ("name", "min", "max", "latest", "count", "worker")
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    MIN_FIELD_NUMBER: _ClassVar[int]
+    MAX_FIELD_NUMBER: _ClassVar[int]
+    LATEST_FIELD_NUMBER: _ClassVar[int]
+    COUNT_FIELD_NUMBER: _ClassVar[int]
+    WORKER_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    min: str
+    max: str
+    latest: str
+    count: int
+    worker: str
+    def __init__(self, name: _Optional[str] = ..., min: _Optional[str] = ..., max: _Optional[str] = ..., latest: _Optional[str] = ..., count: _Optional[int] = ..., worker: _Optional[str] = ...) -> None: ...
+
 class GetSuggestionsRequest(_message.Message):
     __slots__ = ("experiment", "trials", "current_request_number", "total_request_number")
     EXPERIMENT_FIELD_NUMBER: _ClassVar[int]
@@ -390,16 +518,22 @@ class GetEarlyStoppingRulesReply(_message.Message):
     def __init__(self, early_stopping_rules: _Optional[_Iterable[_Union[EarlyStoppingRule, _Mapping]]] = ...) -> None: ...
 
 class EarlyStoppingRule(_message.Message):
-    __slots__ = ("name", "value", "comparison", "start_step")
+    __slots__ = ("name", "value", "comparison", "start_step", "type", "patience", "group")
     NAME_FIELD_NUMBER: _ClassVar[int]
     VALUE_FIELD_NUMBER: _ClassVar[int]
     COMPARISON_FIELD_NUMBER: _ClassVar[int]
     START_STEP_FIELD_NUMBER: _ClassVar[int]
+    TYPE_FIELD_NUMBER: _ClassVar[int]
+    PATIENCE_FIELD_NUMBER: _ClassVar[int]
+    GROUP_FIELD_NUMBER: _ClassVar[int]
     name: str
     value: str
     comparison: ComparisonType
     start_step: int
-    def __init__(self, name: _Optional[str] = ..., value: _Optional[str] = ..., comparison: _Optional[_Union[ComparisonType, str]] = ..., start_step: _Optional[int] = ...) -> None: ...
+    type: EarlyStoppingRuleType
+    patience: int
+    group: str
+    def __init__(self, name: _Optional[str] = ..., value: _Optional[str] = ..., comparison: _Optional[_Union[ComparisonType, str]] = ..., start_step: _Optional[int] = ..., type: _Optional[_Union[EarlyStoppingRuleType, str]] = ..., patience: _Optional[int] = ..., group: _Optional[str] = ...) -> None: ...
 
 class ValidateEarlyStoppingSettingsRequest(_message.Message):
     __slots__ = ("early_stopping",)
@@ -26,11 +26,26 @@ class DBManagerStub(object):
                 request_serializer=api__pb2.GetObservationLogRequest.SerializeToString,
                 response_deserializer=api__pb2.GetObservationLogReply.FromString,
                 _registered_method=True)
+        self.StreamObservationLog = channel.unary_stream(
+                '/api.v1.beta1.DBManager/StreamObservationLog',
+                request_serializer=api__pb2.GetObservationLogRequest.SerializeToString,
+                response_deserializer=api__pb2.GetObservationLogReply.FromString,
+                _registered_method=True)
+        self.WatchObservationLog = channel.unary_stream(
+                '/api.v1.beta1.DBManager/WatchObservationLog',
+                request_serializer=api__pb2.WatchObservationLogRequest.SerializeToString,
+                response_deserializer=api__pb2.WatchObservationLogReply.FromString,
+                _registered_method=True)
         self.DeleteObservationLog = channel.unary_unary(
                 '/api.v1.beta1.DBManager/DeleteObservationLog',
                 request_serializer=api__pb2.DeleteObservationLogRequest.SerializeToString,
                 response_deserializer=api__pb2.DeleteObservationLogReply.FromString,
                 _registered_method=True)
+        self.GetObservationSummary = channel.unary_unary(
+                '/api.v1.beta1.DBManager/GetObservationSummary',
+                request_serializer=api__pb2.GetObservationSummaryRequest.SerializeToString,
+                response_deserializer=api__pb2.GetObservationSummaryReply.FromString,
+                _registered_method=True)
 
 
 class DBManagerServicer(object):
@@ -57,6 +72,23 @@ class DBManagerServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def StreamObservationLog(self, request, context):
+        """*
+        Stream all log of Observations for a Trial page by page.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def WatchObservationLog(self, request, context):
+        """*
+        Watch new logs of Observations for a Trial as they are reported.
+        Only the logs reported to the same DB manager replica are streamed, so DB manager must run as a single replica.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def DeleteObservationLog(self, request, context):
         """*
         Delete all log of Observations for a Trial.
@@ -65,6 +97,14 @@ class DBManagerServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetObservationSummary(self, request, context):
+        """*
+        Get min, max, latest value and number of logs for each metric of a Trial.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_DBManagerServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -78,11 +118,26 @@ def add_DBManagerServicer_to_server(servicer, server):
                     request_deserializer=api__pb2.GetObservationLogRequest.FromString,
                     response_serializer=api__pb2.GetObservationLogReply.SerializeToString,
             ),
+            'StreamObservationLog': grpc.unary_stream_rpc_method_handler(
+                    servicer.StreamObservationLog,
+                    request_deserializer=api__pb2.GetObservationLogRequest.FromString,
+                    response_serializer=api__pb2.GetObservationLogReply.SerializeToString,
+            ),
+            'WatchObservationLog': grpc.unary_stream_rpc_method_handler(
+                    servicer.WatchObservationLog,
+                    request_deserializer=api__pb2.WatchObservationLogRequest.FromString,
+                    response_serializer=api__pb2.WatchObservationLogReply.SerializeToString,
+            ),
             'DeleteObservationLog': grpc.unary_unary_rpc_method_handler(
                     servicer.DeleteObservationLog,
                     request_deserializer=api__pb2.DeleteObservationLogRequest.FromString,
                     response_serializer=api__pb2.DeleteObservationLogReply.SerializeToString,
             ),
+            'GetObservationSummary': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetObservationSummary,
+                    request_deserializer=api__pb2.GetObservationSummaryRequest.FromString,
+                    response_serializer=api__pb2.GetObservationSummaryReply.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'api.v1.beta1.DBManager', rpc_method_handlers)
@@ -150,6 +205,60 @@ class DBManager(object):
             metadata,
             _registered_method=True)
 
+    @staticmethod
+    def StreamObservationLog(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/api.v1.beta1.DBManager/StreamObservationLog',
+            api__pb2.GetObservationLogRequest.SerializeToString,
+            api__pb2.GetObservationLogReply.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def WatchObservationLog(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/api.v1.beta1.DBManager/WatchObservationLog',
+            api__pb2.WatchObservationLogRequest.SerializeToString,
+            api__pb2.WatchObservationLogReply.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
     @staticmethod
     def DeleteObservationLog(request,
             target,
@@ -177,6 +286,33 @@ class DBManager(object):
             metadata,
             _registered_method=True)
 
+    @staticmethod
+    def GetObservationSummary(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/api.v1.beta1.DBManager/GetObservationSummary',
+            api__pb2.GetObservationSummaryRequest.SerializeToString,
+            api__pb2.GetObservationSummaryReply.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
 
 class SuggestionStub(object):
     """*
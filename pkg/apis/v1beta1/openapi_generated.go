@@ -30,6 +30,7 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AdditionalObjective":      schema_apis_controller_common_v1beta1_AdditionalObjective(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSetting":         schema_apis_controller_common_v1beta1_AlgorithmSetting(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSpec":            schema_apis_controller_common_v1beta1_AlgorithmSpec(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.CollectorSpec":            schema_apis_controller_common_v1beta1_CollectorSpec(ref),
@@ -39,11 +40,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.FileSystemPath":           schema_apis_controller_common_v1beta1_FileSystemPath(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.FilterSpec":               schema_apis_controller_common_v1beta1_FilterSpec(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Metric":                   schema_apis_controller_common_v1beta1_Metric(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricSelector":           schema_apis_controller_common_v1beta1_MetricSelector(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricStrategy":           schema_apis_controller_common_v1beta1_MetricStrategy(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec":     schema_apis_controller_common_v1beta1_MetricsCollectorSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveConstraint":      schema_apis_controller_common_v1beta1_ObjectiveConstraint(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec":            schema_apis_controller_common_v1beta1_ObjectiveSpec(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation":              schema_apis_controller_common_v1beta1_Observation(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment":      schema_apis_controller_common_v1beta1_ParameterAssignment(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.RetryPolicy":              schema_apis_controller_common_v1beta1_RetryPolicy(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.SourceSpec":               schema_apis_controller_common_v1beta1_SourceSpec(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ConfigMapSource":     schema_apis_controller_experiments_v1beta1_ConfigMapSource(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.Experiment":          schema_apis_controller_experiments_v1beta1_Experiment(ref),
@@ -67,6 +71,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.SuggestionStatus":    schema_apis_controller_suggestions_v1beta1_SuggestionStatus(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.TrialAssignment":     schema_apis_controller_suggestions_v1beta1_TrialAssignment(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.Trial":                    schema_apis_controller_trials_v1beta1_Trial(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialAttempt":             schema_apis_controller_trials_v1beta1_TrialAttempt(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialCondition":           schema_apis_controller_trials_v1beta1_TrialCondition(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialList":                schema_apis_controller_trials_v1beta1_TrialList(ref),
 		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialSpec":                schema_apis_controller_trials_v1beta1_TrialSpec(ref),
@@ -74,6 +79,33 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 	}
 }
 
+func schema_apis_controller_common_v1beta1_AdditionalObjective(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdditionalObjective represents the additional objective of the multi-objective optimization.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"objectiveMetricName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObjectiveMetricName represents metric to optimize.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type for the metric optimization, one of minimize or maximize.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_apis_controller_common_v1beta1_AlgorithmSetting(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -172,21 +204,21 @@ func schema_apis_controller_common_v1beta1_EarlyStoppingRule(ref common.Referenc
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name contains metric name for the rule.",
+							Description: "Name contains metric name for the rule. Rule can be set for the objective or any additional metric.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Value contains metric value for the rule.",
+							Description: "Value contains metric value for the rule. For the patience rule, value is the minimum change of the metric counted as improvement. For the divergence rule, value is the optional bound of the metric.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"comparison": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Comparison defines correlation between name and value.",
+							Description: "Comparison defines correlation between name and value. For the patience rule, comparison defines the direction of the improvement, if it is not set, the objective metric improves in the objective type direction.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -198,6 +230,27 @@ func schema_apis_controller_common_v1beta1_EarlyStoppingRule(ref common.Referenc
 							Format:      "int32",
 						},
 					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the rule, one of threshold, patience or divergence. Defaults to threshold.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"patience": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Patience is the number of the reported metric values without improvement after which the patience rule is reached.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Group of the rule. Rules in the same group are reached together, and training is early stopped once all rules in any group are reached. Rules without group belong to the same default group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -318,9 +371,46 @@ func schema_apis_controller_common_v1beta1_FilterSpec(ref common.ReferenceCallba
 							},
 						},
 					},
+					"metricsSelectors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selectors of the metric values when format of metrics file is JSON, JSONDocument, YAML or CSV. If selector is not set for the metric, metric value is taken from the top-level key or the column with the metric name.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricSelector"),
+									},
+								},
+							},
+						},
+					},
+					"timestampColumn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Column with the timestamp of the metrics when format of metrics file is CSV. By default, the timestamp column is used if it exists.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"stepColumn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Column with the training step of the metrics when format of metrics file is CSV. By default, the step or epoch column is used if it exists.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timestampFormat": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Format of the timestamp in the metrics lines when format of metrics file is TEXT. It can be the Go time layout of the timestamp at the beginning of the line, for example: 2006-01-02 15:04:05,000, the preset: RFC3339, RFC3339Nano, Python, Glog, Unix or UnixMilli, or the regular expression with the capture group of the timestamp, for example: ts=([0-9.]+). The captured timestamp must be in RFC3339Nano, Python or Unix format. Timestamp without the time zone is in UTC. Defaults to RFC3339Nano.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricSelector"},
 	}
 }
 
@@ -360,6 +450,32 @@ func schema_apis_controller_common_v1beta1_Metric(ref common.ReferenceCallback)
 	}
 }
 
+func schema_apis_controller_common_v1beta1_MetricSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the objective or additional metric",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path of the metric value in the JSON object. Path can be in GJSON format, ref https://github.com/tidwall/gjson, for example: eval.acc or in JSONPath format, for example: $.eval.acc When format of metrics file is CSV, path is the column name.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_apis_controller_common_v1beta1_MetricStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -408,6 +524,42 @@ func schema_apis_controller_common_v1beta1_MetricsCollectorSpec(ref common.Refer
 	}
 }
 
+func schema_apis_controller_common_v1beta1_ObjectiveConstraint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ObjectiveConstraint represents the constraint on the metric value, e.g. latency < 20.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metricName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MetricName represents the constrained metric.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"comparison": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Comparison between the metric value and the bound, one of less or greater.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bound": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bound of the metric value.",
+							Default:     0,
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+				},
+				Required: []string{"bound"},
+			},
+		},
+	}
+}
+
 func schema_apis_controller_common_v1beta1_ObjectiveSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -465,11 +617,46 @@ func schema_apis_controller_common_v1beta1_ObjectiveSpec(ref common.ReferenceCal
 							},
 						},
 					},
+					"workerAggregation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkerAggregation defines how the metrics reported by the workers of the distributed training are aggregated, one of rank0, mean, min or max. For rank0, metrics of the first worker in the rank order which reported the metric are used. For mean, min and max, the min, max and latest values of the workers are aggregated. This field is allowed to missing, experiment defaulter (webhook) will set it to rank0.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"additionalObjectives": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdditionalObjectives represents metrics that are optimized together with the objective metric. If it is set, Experiment is the multi-objective optimization and the Pareto optimal Trials are reported in the Experiment status. The objective goal is still checked for the objective metric only. Metrics of the additional objectives are collected as the additional metrics.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AdditionalObjective"),
+									},
+								},
+							},
+						},
+					},
+					"constraints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Constraints represents the constraints on the metric values which the feasible Trial must satisfy. Trials which violate any constraint are marked as infeasible and they are not considered for the optimal Trial and the objective goal. Only the nsga2 algorithm takes the constraints into account when it samples the parameters, other algorithms don't avoid the infeasible parameters. Metrics of the constraints are collected as the additional metrics.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveConstraint"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricStrategy"},
+			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AdditionalObjective", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricStrategy", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveConstraint"},
 	}
 }
 
@@ -525,6 +712,48 @@ func schema_apis_controller_common_v1beta1_ParameterAssignment(ref common.Refere
 	}
 }
 
+func schema_apis_controller_common_v1beta1_RetryPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RetryPolicy describes how the failed Trial jobs are retried. The retried Trial job is created again with the same parameter assignments.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max number of retries of the failed Trial job, at most 100. Defaults to 0, the failed Trial job is not retried.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"backoffSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of seconds to wait before the failed Trial job is created again. The delay is doubled for each subsequent retry up to 1 hour.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"retryableReasons": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of the retryable failure reasons, e.g. Evicted, OOMKilled, ErrImagePull or PreemptionByScheduler. Reasons are matched against the Trial job failure reason and the status of the Trial job's pods. If it is empty, all failures are retryable.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_apis_controller_common_v1beta1_SourceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -829,11 +1058,52 @@ func schema_apis_controller_experiments_v1beta1_ExperimentSpec(ref common.Refere
 							Format:      "",
 						},
 					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend indicates whether the experiment should stop creating new trials. Unsuspending the experiment resumes it from the point where it was suspended. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"suspendPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes what happens to the active trials when experiment is suspended. Default value is Drain.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max wall clock duration of the experiment from its start time. Experiment is succeeded when the duration is reached.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"resourceBudget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max total resource usage of the trials in resource-hours, for example cpu: 100 means 100 CPU-hours. Resource usage of the trial is the resource requests of the pod templates in the trial run spec multiplied by the trial runtime. Experiment is succeeded when any resource budget is exhausted.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"budgetPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes what happens to the active trials when maxDuration is reached or resourceBudget is exhausted. Default value is Drain if maxDuration or resourceBudget is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.NasConfig", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialTemplate"},
+			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.NasConfig", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialTemplate", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -883,6 +1153,40 @@ func schema_apis_controller_experiments_v1beta1_ExperimentStatus(ref common.Refe
 							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial"),
 						},
 					},
+					"resourceUsage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total resource usage of the trials in resource-hours. It is set only when experiment has the resource budget. Usage is accumulated while experiment is not completed, including usage of the deleted trials.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"resourceUsageLastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents time until which the resource usage of the trials is accounted. It is represented in RFC3339 form and is in UTC.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"paretoOptimalTrials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of the Pareto optimal trials, which are succeeded and not dominated by any other succeeded trial. It is set only when experiment has additional objectives.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial"),
+									},
+								},
+							},
+						},
+					},
 					"runningTrialList": {
 						SchemaProps: spec.SchemaProps{
 							Description: "List of trial names which are running.",
@@ -1048,7 +1352,7 @@ func schema_apis_controller_experiments_v1beta1_ExperimentStatus(ref common.Refe
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentCondition", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentCondition", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -1430,11 +1734,17 @@ func schema_apis_controller_experiments_v1beta1_TrialTemplate(ref common.Referen
 							Format:      "",
 						},
 					},
+					"retryPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes how the failed trial jobs are retried. If it is omitted, the failed trial jobs are not retried.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.RetryPolicy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ConfigMapSource", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialParameterSpec", "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured.Unstructured"},
+			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.RetryPolicy", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ConfigMapSource", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialParameterSpec", "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured.Unstructured"},
 	}
 }
 
@@ -1625,6 +1935,13 @@ func schema_apis_controller_suggestions_v1beta1_SuggestionSpec(ref common.Refere
 							Format:      "",
 						},
 					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend indicates that suggestion deployment is scaled down because experiment is suspended.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1828,6 +2145,47 @@ func schema_apis_controller_trials_v1beta1_Trial(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_apis_controller_trials_v1beta1_TrialAttempt(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TrialAttempt describes the failed Trial job attempt which was retried.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents time when the Trial job of the attempt was created. It is represented in RFC3339 form and is in UTC.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents time when the Trial job of the attempt was failed. It is represented in RFC3339 form and is in UTC.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The retryable failure reason of the attempt.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the failure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_apis_controller_trials_v1beta1_TrialCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2049,11 +2407,17 @@ func schema_apis_controller_trials_v1beta1_TrialSpec(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"retryPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes how the failed trial job is retried.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.RetryPolicy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingRule", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment", "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured.Unstructured"},
+			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingRule", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.RetryPolicy", "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured.Unstructured"},
 	}
 }
 
@@ -2102,10 +2466,31 @@ func schema_apis_controller_trials_v1beta1_TrialStatus(ref common.ReferenceCallb
 							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation"),
 						},
 					},
+					"infeasible": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Infeasible is true if the Trial observation violates any of the objective constraints.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "History of the failed Trial job attempts which were retried.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialAttempt"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation", "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation", "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialAttempt", "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package certgenerator
 
 import (
+	"crypto/x509"
 	"fmt"
 
 	cert "github.com/open-policy-agent/cert-controller/pkg/rotator"
@@ -25,6 +26,7 @@ import (
 
 	configv1beta1 "github.com/kubeflow/katib/pkg/apis/config/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 )
 
 const Webhook = "katib.kubeflow.org"
@@ -55,3 +57,25 @@ func AddToManager(mgr manager.Manager, cfg configv1beta1.CertGeneratorConfig, ce
 		RequireLeaderElection: false,
 	})
 }
+
+// AddGRPCCertToManager adds the cert-generator for the Katib gRPC services to the manager.
+// The generated certificate is used by the Katib control plane both as a server and as a client certificate.
+// The CA private key is kept in the secret to issue the namespace certificates.
+func AddGRPCCertToManager(mgr manager.Manager, cfg configv1beta1.GRPCTLSConfig, certsReady chan struct{}) error {
+	return cert.AddRotator(mgr, &cert.CertRotator{
+		SecretKey: types.NamespacedName{
+			Namespace: consts.DefaultKatibNamespace,
+			Name:      cfg.SecretName,
+		},
+		CertDir:        consts.GRPCCertDir,
+		CAName:         "katib-grpc-ca",
+		CAOrganization: "katib",
+		DNSName:        grpctls.ServerName,
+		ExtKeyUsages:   &[]x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IsReady:        certsReady,
+		FieldOwner:     "cert-generator",
+		// Certs must be ready in primary and secondary instances,
+		// since both of them dial the gRPC services.
+		RequireLeaderElection: false,
+	})
+}
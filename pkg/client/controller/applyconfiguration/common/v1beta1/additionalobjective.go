@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+)
+
+// AdditionalObjectiveApplyConfiguration represents an declarative configuration of the AdditionalObjective type for use
+// with apply.
+type AdditionalObjectiveApplyConfiguration struct {
+	ObjectiveMetricName *string                `json:"objectiveMetricName,omitempty"`
+	Type                *v1beta1.ObjectiveType `json:"type,omitempty"`
+}
+
+// AdditionalObjectiveApplyConfiguration constructs an declarative configuration of the AdditionalObjective type for use with
+// apply.
+func AdditionalObjective() *AdditionalObjectiveApplyConfiguration {
+	return &AdditionalObjectiveApplyConfiguration{}
+}
+
+// WithObjectiveMetricName sets the ObjectiveMetricName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObjectiveMetricName field is set to the value of the last call.
+func (b *AdditionalObjectiveApplyConfiguration) WithObjectiveMetricName(value string) *AdditionalObjectiveApplyConfiguration {
+	b.ObjectiveMetricName = &value
+	return b
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *AdditionalObjectiveApplyConfiguration) WithType(value v1beta1.ObjectiveType) *AdditionalObjectiveApplyConfiguration {
+	b.Type = &value
+	return b
+}
@@ -25,10 +25,13 @@ import (
 // EarlyStoppingRuleApplyConfiguration represents an declarative configuration of the EarlyStoppingRule type for use
 // with apply.
 type EarlyStoppingRuleApplyConfiguration struct {
-	Name       *string                 `json:"name,omitempty"`
-	Value      *string                 `json:"value,omitempty"`
-	Comparison *v1beta1.ComparisonType `json:"comparison,omitempty"`
-	StartStep  *int                    `json:"startStep,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Value      *string                        `json:"value,omitempty"`
+	Comparison *v1beta1.ComparisonType        `json:"comparison,omitempty"`
+	StartStep  *int                           `json:"startStep,omitempty"`
+	Type       *v1beta1.EarlyStoppingRuleType `json:"type,omitempty"`
+	Patience   *int                           `json:"patience,omitempty"`
+	Group      *string                        `json:"group,omitempty"`
 }
 
 // EarlyStoppingRuleApplyConfiguration constructs an declarative configuration of the EarlyStoppingRule type for use with
@@ -68,3 +71,27 @@ func (b *EarlyStoppingRuleApplyConfiguration) WithStartStep(value int) *EarlySto
 	b.StartStep = &value
 	return b
 }
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *EarlyStoppingRuleApplyConfiguration) WithType(value v1beta1.EarlyStoppingRuleType) *EarlyStoppingRuleApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithPatience sets the Patience field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Patience field is set to the value of the last call.
+func (b *EarlyStoppingRuleApplyConfiguration) WithPatience(value int) *EarlyStoppingRuleApplyConfiguration {
+	b.Patience = &value
+	return b
+}
+
+// WithGroup sets the Group field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Group field is set to the value of the last call.
+func (b *EarlyStoppingRuleApplyConfiguration) WithGroup(value string) *EarlyStoppingRuleApplyConfiguration {
+	b.Group = &value
+	return b
+}
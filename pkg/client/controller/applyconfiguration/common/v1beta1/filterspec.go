@@ -21,7 +21,11 @@ package v1beta1
 // FilterSpecApplyConfiguration represents an declarative configuration of the FilterSpec type for use
 // with apply.
 type FilterSpecApplyConfiguration struct {
-	MetricsFormat []string `json:"metricsFormat,omitempty"`
+	MetricsFormat    []string                           `json:"metricsFormat,omitempty"`
+	MetricsSelectors []MetricSelectorApplyConfiguration `json:"metricsSelectors,omitempty"`
+	TimestampColumn  *string                            `json:"timestampColumn,omitempty"`
+	StepColumn       *string                            `json:"stepColumn,omitempty"`
+	TimestampFormat  *string                            `json:"timestampFormat,omitempty"`
 }
 
 // FilterSpecApplyConfiguration constructs an declarative configuration of the FilterSpec type for use with
@@ -39,3 +43,40 @@ func (b *FilterSpecApplyConfiguration) WithMetricsFormat(values ...string) *Filt
 	}
 	return b
 }
+
+// WithMetricsSelectors adds the given value to the MetricsSelectors field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MetricsSelectors field.
+func (b *FilterSpecApplyConfiguration) WithMetricsSelectors(values ...*MetricSelectorApplyConfiguration) *FilterSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMetricsSelectors")
+		}
+		b.MetricsSelectors = append(b.MetricsSelectors, *values[i])
+	}
+	return b
+}
+
+// WithTimestampColumn sets the TimestampColumn field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimestampColumn field is set to the value of the last call.
+func (b *FilterSpecApplyConfiguration) WithTimestampColumn(value string) *FilterSpecApplyConfiguration {
+	b.TimestampColumn = &value
+	return b
+}
+
+// WithStepColumn sets the StepColumn field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StepColumn field is set to the value of the last call.
+func (b *FilterSpecApplyConfiguration) WithStepColumn(value string) *FilterSpecApplyConfiguration {
+	b.StepColumn = &value
+	return b
+}
+
+// WithTimestampFormat sets the TimestampFormat field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimestampFormat field is set to the value of the last call.
+func (b *FilterSpecApplyConfiguration) WithTimestampFormat(value string) *FilterSpecApplyConfiguration {
+	b.TimestampFormat = &value
+	return b
+}
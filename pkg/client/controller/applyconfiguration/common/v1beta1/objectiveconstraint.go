@@ -0,0 +1,61 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+)
+
+// ObjectiveConstraintApplyConfiguration represents an declarative configuration of the ObjectiveConstraint type for use
+// with apply.
+type ObjectiveConstraintApplyConfiguration struct {
+	MetricName *string                 `json:"metricName,omitempty"`
+	Comparison *v1beta1.ComparisonType `json:"comparison,omitempty"`
+	Bound      *float64                `json:"bound,omitempty"`
+}
+
+// ObjectiveConstraintApplyConfiguration constructs an declarative configuration of the ObjectiveConstraint type for use with
+// apply.
+func ObjectiveConstraint() *ObjectiveConstraintApplyConfiguration {
+	return &ObjectiveConstraintApplyConfiguration{}
+}
+
+// WithMetricName sets the MetricName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MetricName field is set to the value of the last call.
+func (b *ObjectiveConstraintApplyConfiguration) WithMetricName(value string) *ObjectiveConstraintApplyConfiguration {
+	b.MetricName = &value
+	return b
+}
+
+// WithComparison sets the Comparison field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Comparison field is set to the value of the last call.
+func (b *ObjectiveConstraintApplyConfiguration) WithComparison(value v1beta1.ComparisonType) *ObjectiveConstraintApplyConfiguration {
+	b.Comparison = &value
+	return b
+}
+
+// WithBound sets the Bound field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Bound field is set to the value of the last call.
+func (b *ObjectiveConstraintApplyConfiguration) WithBound(value float64) *ObjectiveConstraintApplyConfiguration {
+	b.Bound = &value
+	return b
+}
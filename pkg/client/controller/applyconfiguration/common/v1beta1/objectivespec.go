@@ -25,11 +25,14 @@ import (
 // ObjectiveSpecApplyConfiguration represents an declarative configuration of the ObjectiveSpec type for use
 // with apply.
 type ObjectiveSpecApplyConfiguration struct {
-	Type                  *v1beta1.ObjectiveType             `json:"type,omitempty"`
-	Goal                  *float64                           `json:"goal,omitempty"`
-	ObjectiveMetricName   *string                            `json:"objectiveMetricName,omitempty"`
-	AdditionalMetricNames []string                           `json:"additionalMetricNames,omitempty"`
-	MetricStrategies      []MetricStrategyApplyConfiguration `json:"metricStrategies,omitempty"`
+	Type                  *v1beta1.ObjectiveType                  `json:"type,omitempty"`
+	Goal                  *float64                                `json:"goal,omitempty"`
+	ObjectiveMetricName   *string                                 `json:"objectiveMetricName,omitempty"`
+	AdditionalMetricNames []string                                `json:"additionalMetricNames,omitempty"`
+	MetricStrategies      []MetricStrategyApplyConfiguration      `json:"metricStrategies,omitempty"`
+	WorkerAggregation     *v1beta1.WorkerAggregationType          `json:"workerAggregation,omitempty"`
+	AdditionalObjectives  []AdditionalObjectiveApplyConfiguration `json:"additionalObjectives,omitempty"`
+	Constraints           []ObjectiveConstraintApplyConfiguration `json:"constraints,omitempty"`
 }
 
 // ObjectiveSpecApplyConfiguration constructs an declarative configuration of the ObjectiveSpec type for use with
@@ -84,3 +87,37 @@ func (b *ObjectiveSpecApplyConfiguration) WithMetricStrategies(values ...*Metric
 	}
 	return b
 }
+
+// WithWorkerAggregation sets the WorkerAggregation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkerAggregation field is set to the value of the last call.
+func (b *ObjectiveSpecApplyConfiguration) WithWorkerAggregation(value v1beta1.WorkerAggregationType) *ObjectiveSpecApplyConfiguration {
+	b.WorkerAggregation = &value
+	return b
+}
+
+// WithAdditionalObjectives adds the given value to the AdditionalObjectives field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AdditionalObjectives field.
+func (b *ObjectiveSpecApplyConfiguration) WithAdditionalObjectives(values ...*AdditionalObjectiveApplyConfiguration) *ObjectiveSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAdditionalObjectives")
+		}
+		b.AdditionalObjectives = append(b.AdditionalObjectives, *values[i])
+	}
+	return b
+}
+
+// WithConstraints adds the given value to the Constraints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Constraints field.
+func (b *ObjectiveSpecApplyConfiguration) WithConstraints(values ...*ObjectiveConstraintApplyConfiguration) *ObjectiveSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConstraints")
+		}
+		b.Constraints = append(b.Constraints, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// RetryPolicyApplyConfiguration represents an declarative configuration of the RetryPolicy type for use
+// with apply.
+type RetryPolicyApplyConfiguration struct {
+	MaxRetries       *int32   `json:"maxRetries,omitempty"`
+	BackoffSeconds   *int32   `json:"backoffSeconds,omitempty"`
+	RetryableReasons []string `json:"retryableReasons,omitempty"`
+}
+
+// RetryPolicyApplyConfiguration constructs an declarative configuration of the RetryPolicy type for use with
+// apply.
+func RetryPolicy() *RetryPolicyApplyConfiguration {
+	return &RetryPolicyApplyConfiguration{}
+}
+
+// WithMaxRetries sets the MaxRetries field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRetries field is set to the value of the last call.
+func (b *RetryPolicyApplyConfiguration) WithMaxRetries(value int32) *RetryPolicyApplyConfiguration {
+	b.MaxRetries = &value
+	return b
+}
+
+// WithBackoffSeconds sets the BackoffSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BackoffSeconds field is set to the value of the last call.
+func (b *RetryPolicyApplyConfiguration) WithBackoffSeconds(value int32) *RetryPolicyApplyConfiguration {
+	b.BackoffSeconds = &value
+	return b
+}
+
+// WithRetryableReasons adds the given value to the RetryableReasons field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RetryableReasons field.
+func (b *RetryPolicyApplyConfiguration) WithRetryableReasons(values ...string) *RetryPolicyApplyConfiguration {
+	for i := range values {
+		b.RetryableReasons = append(b.RetryableReasons, values[i])
+	}
+	return b
+}
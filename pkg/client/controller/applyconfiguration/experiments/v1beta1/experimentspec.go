@@ -21,6 +21,8 @@ package v1beta1
 import (
 	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	commonv1beta1 "github.com/kubeflow/katib/pkg/client/controller/applyconfiguration/common/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // ExperimentSpecApplyConfiguration represents an declarative configuration of the ExperimentSpec type for use
@@ -37,6 +39,11 @@ type ExperimentSpecApplyConfiguration struct {
 	MetricsCollectorSpec *commonv1beta1.MetricsCollectorSpecApplyConfiguration `json:"metricsCollectorSpec,omitempty"`
 	NasConfig            *NasConfigApplyConfiguration                          `json:"nasConfig,omitempty"`
 	ResumePolicy         *experimentsv1beta1.ResumePolicyType                  `json:"resumePolicy,omitempty"`
+	Suspend              *bool                                                 `json:"suspend,omitempty"`
+	SuspendPolicy        *experimentsv1beta1.SuspendPolicyType                 `json:"suspendPolicy,omitempty"`
+	MaxDuration          *v1.Duration                                          `json:"maxDuration,omitempty"`
+	ResourceBudget       *corev1.ResourceList                                  `json:"resourceBudget,omitempty"`
+	BudgetPolicy         *experimentsv1beta1.BudgetPolicyType                  `json:"budgetPolicy,omitempty"`
 }
 
 // ExperimentSpecApplyConfiguration constructs an declarative configuration of the ExperimentSpec type for use with
@@ -137,3 +144,43 @@ func (b *ExperimentSpecApplyConfiguration) WithResumePolicy(value experimentsv1b
 	b.ResumePolicy = &value
 	return b
 }
+
+// WithSuspend sets the Suspend field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Suspend field is set to the value of the last call.
+func (b *ExperimentSpecApplyConfiguration) WithSuspend(value bool) *ExperimentSpecApplyConfiguration {
+	b.Suspend = &value
+	return b
+}
+
+// WithSuspendPolicy sets the SuspendPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SuspendPolicy field is set to the value of the last call.
+func (b *ExperimentSpecApplyConfiguration) WithSuspendPolicy(value experimentsv1beta1.SuspendPolicyType) *ExperimentSpecApplyConfiguration {
+	b.SuspendPolicy = &value
+	return b
+}
+
+// WithMaxDuration sets the MaxDuration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxDuration field is set to the value of the last call.
+func (b *ExperimentSpecApplyConfiguration) WithMaxDuration(value v1.Duration) *ExperimentSpecApplyConfiguration {
+	b.MaxDuration = &value
+	return b
+}
+
+// WithResourceBudget sets the ResourceBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceBudget field is set to the value of the last call.
+func (b *ExperimentSpecApplyConfiguration) WithResourceBudget(value corev1.ResourceList) *ExperimentSpecApplyConfiguration {
+	b.ResourceBudget = &value
+	return b
+}
+
+// WithBudgetPolicy sets the BudgetPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BudgetPolicy field is set to the value of the last call.
+func (b *ExperimentSpecApplyConfiguration) WithBudgetPolicy(value experimentsv1beta1.BudgetPolicyType) *ExperimentSpecApplyConfiguration {
+	b.BudgetPolicy = &value
+	return b
+}
@@ -19,6 +19,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -30,6 +31,9 @@ type ExperimentStatusApplyConfiguration struct {
 	LastReconcileTime           *v1.Time                                `json:"lastReconcileTime,omitempty"`
 	Conditions                  []ExperimentConditionApplyConfiguration `json:"conditions,omitempty"`
 	CurrentOptimalTrial         *OptimalTrialApplyConfiguration         `json:"currentOptimalTrial,omitempty"`
+	ResourceUsage               *corev1.ResourceList                    `json:"resourceUsage,omitempty"`
+	ResourceUsageLastUpdateTime *v1.Time                                `json:"resourceUsageLastUpdateTime,omitempty"`
+	ParetoOptimalTrials         []OptimalTrialApplyConfiguration        `json:"paretoOptimalTrials,omitempty"`
 	RunningTrialList            []string                                `json:"runningTrialList,omitempty"`
 	PendingTrialList            []string                                `json:"pendingTrialList,omitempty"`
 	FailedTrialList             []string                                `json:"failedTrialList,omitempty"`
@@ -98,6 +102,35 @@ func (b *ExperimentStatusApplyConfiguration) WithCurrentOptimalTrial(value *Opti
 	return b
 }
 
+// WithResourceUsage sets the ResourceUsage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceUsage field is set to the value of the last call.
+func (b *ExperimentStatusApplyConfiguration) WithResourceUsage(value corev1.ResourceList) *ExperimentStatusApplyConfiguration {
+	b.ResourceUsage = &value
+	return b
+}
+
+// WithResourceUsageLastUpdateTime sets the ResourceUsageLastUpdateTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceUsageLastUpdateTime field is set to the value of the last call.
+func (b *ExperimentStatusApplyConfiguration) WithResourceUsageLastUpdateTime(value v1.Time) *ExperimentStatusApplyConfiguration {
+	b.ResourceUsageLastUpdateTime = &value
+	return b
+}
+
+// WithParetoOptimalTrials adds the given value to the ParetoOptimalTrials field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ParetoOptimalTrials field.
+func (b *ExperimentStatusApplyConfiguration) WithParetoOptimalTrials(values ...*OptimalTrialApplyConfiguration) *ExperimentStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithParetoOptimalTrials")
+		}
+		b.ParetoOptimalTrials = append(b.ParetoOptimalTrials, *values[i])
+	}
+	return b
+}
+
 // WithRunningTrialList adds the given value to the RunningTrialList field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the RunningTrialList field.
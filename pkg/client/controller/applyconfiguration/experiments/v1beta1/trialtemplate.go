@@ -19,6 +19,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	commonv1beta1 "github.com/kubeflow/katib/pkg/client/controller/applyconfiguration/common/v1beta1"
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
@@ -27,11 +28,12 @@ import (
 type TrialTemplateApplyConfiguration struct {
 	Retain                        *bool `json:"retain,omitempty"`
 	TrialSourceApplyConfiguration `json:",inline"`
-	TrialParameters               []TrialParameterSpecApplyConfiguration `json:"trialParameters,omitempty"`
-	PrimaryPodLabels              map[string]string                      `json:"primaryPodLabels,omitempty"`
-	PrimaryContainerName          *string                                `json:"primaryContainerName,omitempty"`
-	SuccessCondition              *string                                `json:"successCondition,omitempty"`
-	FailureCondition              *string                                `json:"failureCondition,omitempty"`
+	TrialParameters               []TrialParameterSpecApplyConfiguration       `json:"trialParameters,omitempty"`
+	PrimaryPodLabels              map[string]string                            `json:"primaryPodLabels,omitempty"`
+	PrimaryContainerName          *string                                      `json:"primaryContainerName,omitempty"`
+	SuccessCondition              *string                                      `json:"successCondition,omitempty"`
+	FailureCondition              *string                                      `json:"failureCondition,omitempty"`
+	RetryPolicy                   *commonv1beta1.RetryPolicyApplyConfiguration `json:"retryPolicy,omitempty"`
 }
 
 // TrialTemplateApplyConfiguration constructs an declarative configuration of the TrialTemplate type for use with
@@ -114,3 +116,11 @@ func (b *TrialTemplateApplyConfiguration) WithFailureCondition(value string) *Tr
 	b.FailureCondition = &value
 	return b
 }
+
+// WithRetryPolicy sets the RetryPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RetryPolicy field is set to the value of the last call.
+func (b *TrialTemplateApplyConfiguration) WithRetryPolicy(value *commonv1beta1.RetryPolicyApplyConfiguration) *TrialTemplateApplyConfiguration {
+	b.RetryPolicy = value
+	return b
+}
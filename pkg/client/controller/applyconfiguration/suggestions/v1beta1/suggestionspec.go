@@ -30,6 +30,7 @@ type SuggestionSpecApplyConfiguration struct {
 	EarlyStopping *v1beta1.EarlyStoppingSpecApplyConfiguration `json:"earlyStopping,omitempty"`
 	Requests      *int32                                       `json:"requests,omitempty"`
 	ResumePolicy  *experimentsv1beta1.ResumePolicyType         `json:"resumePolicy,omitempty"`
+	Suspend       *bool                                        `json:"suspend,omitempty"`
 }
 
 // SuggestionSpecApplyConfiguration constructs an declarative configuration of the SuggestionSpec type for use with
@@ -69,3 +70,11 @@ func (b *SuggestionSpecApplyConfiguration) WithResumePolicy(value experimentsv1b
 	b.ResumePolicy = &value
 	return b
 }
+
+// WithSuspend sets the Suspend field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Suspend field is set to the value of the last call.
+func (b *SuggestionSpecApplyConfiguration) WithSuspend(value bool) *SuggestionSpecApplyConfiguration {
+	b.Suspend = &value
+	return b
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TrialAttemptApplyConfiguration represents an declarative configuration of the TrialAttempt type for use
+// with apply.
+type TrialAttemptApplyConfiguration struct {
+	StartTime      *v1.Time `json:"startTime,omitempty"`
+	CompletionTime *v1.Time `json:"completionTime,omitempty"`
+	Reason         *string  `json:"reason,omitempty"`
+	Message        *string  `json:"message,omitempty"`
+}
+
+// TrialAttemptApplyConfiguration constructs an declarative configuration of the TrialAttempt type for use with
+// apply.
+func TrialAttempt() *TrialAttemptApplyConfiguration {
+	return &TrialAttemptApplyConfiguration{}
+}
+
+// WithStartTime sets the StartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartTime field is set to the value of the last call.
+func (b *TrialAttemptApplyConfiguration) WithStartTime(value v1.Time) *TrialAttemptApplyConfiguration {
+	b.StartTime = &value
+	return b
+}
+
+// WithCompletionTime sets the CompletionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletionTime field is set to the value of the last call.
+func (b *TrialAttemptApplyConfiguration) WithCompletionTime(value v1.Time) *TrialAttemptApplyConfiguration {
+	b.CompletionTime = &value
+	return b
+}
+
+// WithReason sets the Reason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reason field is set to the value of the last call.
+func (b *TrialAttemptApplyConfiguration) WithReason(value string) *TrialAttemptApplyConfiguration {
+	b.Reason = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *TrialAttemptApplyConfiguration) WithMessage(value string) *TrialAttemptApplyConfiguration {
+	b.Message = &value
+	return b
+}
@@ -37,6 +37,7 @@ type TrialSpecApplyConfiguration struct {
 	SuccessCondition     *string                                         `json:"successCondition,omitempty"`
 	FailureCondition     *string                                         `json:"failureCondition,omitempty"`
 	Labels               map[string]string                               `json:"labels,omitempty"`
+	RetryPolicy          *v1beta1.RetryPolicyApplyConfiguration          `json:"retryPolicy,omitempty"`
 }
 
 // TrialSpecApplyConfiguration constructs an declarative configuration of the TrialSpec type for use with
@@ -154,3 +155,11 @@ func (b *TrialSpecApplyConfiguration) WithLabels(entries map[string]string) *Tri
 	}
 	return b
 }
+
+// WithRetryPolicy sets the RetryPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RetryPolicy field is set to the value of the last call.
+func (b *TrialSpecApplyConfiguration) WithRetryPolicy(value *v1beta1.RetryPolicyApplyConfiguration) *TrialSpecApplyConfiguration {
+	b.RetryPolicy = value
+	return b
+}
@@ -31,6 +31,8 @@ type TrialStatusApplyConfiguration struct {
 	LastReconcileTime *v1.Time                                     `json:"lastReconcileTime,omitempty"`
 	Conditions        []TrialConditionApplyConfiguration           `json:"conditions,omitempty"`
 	Observation       *commonv1beta1.ObservationApplyConfiguration `json:"observation,omitempty"`
+	Infeasible        *bool                                        `json:"infeasible,omitempty"`
+	Attempts          []TrialAttemptApplyConfiguration             `json:"attempts,omitempty"`
 }
 
 // TrialStatusApplyConfiguration constructs an declarative configuration of the TrialStatus type for use with
@@ -83,3 +85,24 @@ func (b *TrialStatusApplyConfiguration) WithObservation(value *commonv1beta1.Obs
 	b.Observation = value
 	return b
 }
+
+// WithInfeasible sets the Infeasible field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Infeasible field is set to the value of the last call.
+func (b *TrialStatusApplyConfiguration) WithInfeasible(value bool) *TrialStatusApplyConfiguration {
+	b.Infeasible = &value
+	return b
+}
+
+// WithAttempts adds the given value to the Attempts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Attempts field.
+func (b *TrialStatusApplyConfiguration) WithAttempts(values ...*TrialAttemptApplyConfiguration) *TrialStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAttempts")
+		}
+		b.Attempts = append(b.Attempts, *values[i])
+	}
+	return b
+}
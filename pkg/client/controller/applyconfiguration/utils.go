@@ -35,6 +35,8 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=common.kubeflow.org, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithKind("AdditionalObjective"):
+		return &commonv1beta1.AdditionalObjectiveApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("AlgorithmSetting"):
 		return &commonv1beta1.AlgorithmSettingApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("AlgorithmSpec"):
@@ -55,14 +57,20 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &commonv1beta1.MetricApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MetricsCollectorSpec"):
 		return &commonv1beta1.MetricsCollectorSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MetricSelector"):
+		return &commonv1beta1.MetricSelectorApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MetricStrategy"):
 		return &commonv1beta1.MetricStrategyApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ObjectiveConstraint"):
+		return &commonv1beta1.ObjectiveConstraintApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ObjectiveSpec"):
 		return &commonv1beta1.ObjectiveSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Observation"):
 		return &commonv1beta1.ObservationApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ParameterAssignment"):
 		return &commonv1beta1.ParameterAssignmentApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RetryPolicy"):
+		return &commonv1beta1.RetryPolicyApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("SourceSpec"):
 		return &commonv1beta1.SourceSpecApplyConfiguration{}
 
@@ -111,6 +119,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		// Group=trial.kubeflow.org, Version=v1beta1
 	case trialsv1beta1.SchemeGroupVersion.WithKind("Trial"):
 		return &applyconfigurationtrialsv1beta1.TrialApplyConfiguration{}
+	case trialsv1beta1.SchemeGroupVersion.WithKind("TrialAttempt"):
+		return &applyconfigurationtrialsv1beta1.TrialAttemptApplyConfiguration{}
 	case trialsv1beta1.SchemeGroupVersion.WithKind("TrialCondition"):
 		return &applyconfigurationtrialsv1beta1.TrialConditionApplyConfiguration{}
 	case trialsv1beta1.SchemeGroupVersion.WithKind("TrialSpec"):
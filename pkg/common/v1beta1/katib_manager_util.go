@@ -20,10 +20,10 @@ import (
 	"context"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 )
 
 type katibDBManagerClientAndConn struct {
@@ -46,7 +46,11 @@ func GetDBManagerAddr() string {
 
 func getKatibDBManagerClientAndConn() (*katibDBManagerClientAndConn, error) {
 	addr := GetDBManagerAddr()
-	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	credsOpt, err := grpctls.DialOption(grpctls.ServerName)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.Dial(addr, credsOpt)
 	if err != nil {
 		return nil, err
 	}
@@ -93,3 +97,14 @@ func DeleteObservationLog(request *api_pb.DeleteObservationLogRequest) (*api_pb.
 	kc := kcc.KatibDBManagerClient
 	return kc.DeleteObservationLog(ctx, request)
 }
+
+func GetObservationSummary(request *api_pb.GetObservationSummaryRequest) (*api_pb.GetObservationSummaryReply, error) {
+	ctx := context.Background()
+	kcc, err := getKatibDBManagerClientAndConn()
+	if err != nil {
+		return nil, err
+	}
+	defer closeKatibDBManagerConnection(kcc)
+	kc := kcc.KatibDBManagerClient
+	return kc.GetObservationSummary(ctx, request)
+}
@@ -48,6 +48,17 @@ const (
 
 	// CertDir is the location saved certs for the webhooks.
 	CertDir = "/tmp/cert"
+	// GRPCCertDir is the location saved certs for the Katib gRPC services.
+	GRPCCertDir = "/tmp/katib-grpc-cert"
+	// GRPCCertVolumeName is the name of the volume with the certs for the Katib gRPC services.
+	GRPCCertVolumeName = "katib-grpc-cert"
+
+	// ConfigGRPCTLSEnable is the config name which indicates
+	// if Katib gRPC services and clients use mutual TLS.
+	ConfigGRPCTLSEnable = "grpc-tls-enable"
+	// GRPCNamespaceCertSecretName is the name of the secret with the namespace certs for the Katib gRPC services.
+	// It is created by the controller in every namespace with Suggestions.
+	GRPCNamespaceCertSecretName = "katib-grpc-namespace-cert"
 
 	// ConfigInjectSecurityContext is the config name which indicates
 	// if we should inject the security context into the metrics collector
@@ -71,6 +82,10 @@ const (
 	LabelTrialName = "katib.kubeflow.org/trial"
 	// LabelDeploymentName is the label of deployment name.
 	LabelDeploymentName = "katib.kubeflow.org/deployment"
+	// LabelComponent is the label of Katib component.
+	LabelComponent = "katib.kubeflow.org/component"
+	// LabelGRPCCertValue is the component label value of the secrets with the certs for the Katib gRPC services.
+	LabelGRPCCertValue = "grpc-cert"
 
 	// ContainerSuggestion is the container name to run Suggestion service.
 	ContainerSuggestion = "suggestion"
@@ -93,6 +108,12 @@ const (
 	// DefaultGRPCRetryPeriod is a fixed period of time between gRPC call retries
 	DefaultGRPCRetryPeriod = 3 * time.Second
 
+	// MaxRetryBackoff is the maximum delay before the failed Trial job is created again
+	// unless the retry policy backoff is longer.
+	MaxRetryBackoff = time.Hour
+	// MaxTrialRetries is the maximum number of retries in the Trial retry policy.
+	MaxTrialRetries = 100
+
 	// DefaultKatibNamespaceEnvName is the default env name of katib namespace
 	DefaultKatibNamespaceEnvName = "KATIB_CORE_NAMESPACE"
 	// DefaultKatibComposerEnvName is the default env name of katib suggestion composer
@@ -119,6 +140,10 @@ const (
 	// JobKindJob is the kind of the Kubernetes Job.
 	JobKindJob = "Job"
 
+	// AnnotationKeepObservationLogs is the annotation of the Trial whose observation logs are kept when it is deleted.
+	// It is set on the Trials killed by the Experiment suspend, since they are created again when the Experiment is resumed.
+	AnnotationKeepObservationLogs = "katib.kubeflow.org/keep-observation-logs"
+
 	// AnnotationIstioSidecarInjectName is the annotation of Istio Sidecar
 	AnnotationIstioSidecarInjectName = "sidecar.istio.io/inject"
 
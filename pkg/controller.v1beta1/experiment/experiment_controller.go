@@ -189,7 +189,7 @@ func (r *ReconcileExperiment) Reconcile(ctx context.Context, request reconcile.R
 		if (util.IsCompletedExperimentRestartable(instance) &&
 			instance.Spec.MaxTrialCount != nil &&
 			*instance.Spec.MaxTrialCount > instance.Status.Trials) ||
-			(instance.Spec.MaxTrialCount == nil && instance.Status.Trials != 0) {
+			(instance.Spec.MaxTrialCount == nil && instance.Status.Trials != 0 && !util.IsCompletedByBudget(instance)) {
 			logger.Info("Experiment is restarting",
 				"MaxTrialCount", instance.Spec.MaxTrialCount,
 				"ParallelTrialCount", instance.Spec.ParallelTrialCount,
@@ -205,8 +205,8 @@ func (r *ReconcileExperiment) Reconcile(ctx context.Context, request reconcile.R
 				}
 			}
 		} else {
-			// If experiment is completed with no running trials, stop reconcile
-			if !instance.HasRunningTrials() {
+			// If experiment is completed with no pending and running trials, stop reconcile
+			if !instance.HasRunningTrials() && instance.Status.TrialsPending == 0 {
 				return reconcile.Result{}, nil
 			}
 		}
@@ -243,7 +243,8 @@ func (r *ReconcileExperiment) Reconcile(ctx context.Context, request reconcile.R
 		}
 	}
 
-	return reconcile.Result{}, nil
+	// Experiment must be reconciled to check max duration and resource budget without Trial changes.
+	return reconcile.Result{RequeueAfter: util.GetBudgetRequeueAfter(instance, time.Now())}, nil
 }
 
 // ReconcileExperiment is the main reconcile loop.
@@ -256,20 +257,57 @@ func (r *ReconcileExperiment) ReconcileExperiment(instance *experimentsv1beta1.E
 		logger.Error(err, "Trial List error")
 		return err
 	}
-	if len(trials.Items) > 0 {
+	// Trials of the suspended experiment can be deleted, so the status must be updated without them.
+	if len(trials.Items) > 0 || instance.Status.Trials > 0 {
 		if err := util.UpdateExperimentStatus(r.collector, instance, trials); err != nil {
 			logger.Error(err, "Update experiment status error")
 			return err
 		}
 	}
+	// Active trials are killed when the budget runs out and BudgetPolicy = Kill.
+	if instance.IsCompleted() && util.IsCompletedByBudget(instance) && instance.Spec.BudgetPolicy == experimentsv1beta1.KillBudget {
+		if err := r.killActiveTrials(instance, trials.Items, false); err != nil {
+			logger.Error(err, "Kill active trials error")
+			return err
+		}
+	}
 	reconcileRequired := !instance.IsCompleted()
 	if reconcileRequired {
+		if err := r.syncSuggestionSuspend(instance); err != nil {
+			logger.Error(err, "Sync suggestion suspend error")
+			return err
+		}
+		if instance.Spec.Suspend {
+			return r.suspendExperiment(instance, trials.Items)
+		}
+		if instance.IsSuspended() {
+			msg := "Experiment is resumed"
+			instance.MarkExperimentStatusResumed(util.ExperimentResumedReason, msg)
+		}
 		return r.ReconcileTrials(instance, trials.Items)
 	}
 
 	return nil
 }
 
+// suspendExperiment stops creating trials and handles the active trials according to the suspend policy.
+func (r *ReconcileExperiment) suspendExperiment(instance *experimentsv1beta1.Experiment, trials []trialsv1beta1.Trial) error {
+	logger := log.WithValues("Experiment", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+
+	if instance.Spec.SuspendPolicy == experimentsv1beta1.KillSuspend {
+		if err := r.killActiveTrials(instance, trials, true); err != nil {
+			logger.Error(err, "Kill active trials error")
+			return err
+		}
+	}
+	if !instance.IsSuspended() {
+		logger.Info("Experiment is suspended", "SuspendPolicy", instance.Spec.SuspendPolicy)
+	}
+	msg := "Experiment is suspended"
+	instance.MarkExperimentStatusSuspended(util.ExperimentSuspendedReason, msg)
+	return nil
+}
+
 // ReconcileTrials syncs trials.
 func (r *ReconcileExperiment) ReconcileTrials(instance *experimentsv1beta1.Experiment, trials []trialsv1beta1.Trial) error {
 
@@ -378,6 +416,8 @@ func (r *ReconcileExperiment) deleteTrials(instance *experimentsv1beta1.Experime
 			"expectedDeletions", expected, "trials", actual)
 		expected = actual
 	}
+	// Resource usage of the deleted trials must be accounted until they are deleted.
+	util.UpdateResourceUsage(instance, trialSlice[:expected], time.Now())
 	deletedNames := []string{}
 	for i := 0; i < expected; i++ {
 		if err := r.Delete(context.TODO(), &trialSlice[i]); err != nil {
@@ -480,6 +520,10 @@ func (r *ReconcileExperiment) ReconcileSuggestions(instance *experimentsv1beta1.
 						}
 					}
 				}
+				// Assignments of the trials killed by suspend can exceed the number of required trials.
+				if int32(len(assignments)) > addCount {
+					assignments = assignments[:addCount]
+				}
 				if suggestion.Spec.Requests != suggestionRequestsCount {
 					suggestion.Spec.Requests = suggestionRequestsCount
 					if err := r.UpdateSuggestion(suggestion); err != nil {
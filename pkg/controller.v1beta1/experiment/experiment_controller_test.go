@@ -152,6 +152,13 @@ func TestReconcileSuggestions(t *testing.T) {
 	g.Expect(err).NotTo(gomega.HaveOccurred())
 	g.Expect(len(assignments)).To(gomega.Equal(1))
 	g.Expect(assignments[0].Name).To(gomega.Equal(trialName + "-2"))
+
+	// ReconcileSuggestions should not return more assignments than requested
+	// when trials were killed by suspend
+	assignments, err = r.ReconcileSuggestions(instance, []trialsv1beta1.Trial{}, 1)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(len(assignments)).To(gomega.Equal(1))
+	g.Expect(assignments[0].Name).To(gomega.Equal(trialName + "-1"))
 }
 
 func TestReconcile(t *testing.T) {
@@ -18,17 +18,21 @@ package experiment
 
 import (
 	"context"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	suggestionsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	experimentutil "github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/util"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
 )
 
@@ -92,6 +96,10 @@ func (r *ReconcileExperiment) getTrialInstance(expInstance *experimentsv1beta1.E
 		trial.Spec.FailureCondition = expInstance.Spec.TrialTemplate.FailureCondition
 	}
 
+	if expInstance.Spec.TrialTemplate.RetryPolicy != nil {
+		trial.Spec.RetryPolicy = expInstance.Spec.TrialTemplate.RetryPolicy.DeepCopy()
+	}
+
 	return trial, nil
 }
 
@@ -204,3 +212,65 @@ func (r *ReconcileExperiment) restartSuggestion(instance *experimentsv1beta1.Exp
 	}
 	return nil
 }
+
+// syncSuggestionSuspend propagates suspend of the Experiment to its Suggestion.
+func (r *ReconcileExperiment) syncSuggestionSuspend(instance *experimentsv1beta1.Experiment) error {
+	logger := log.WithValues("Suggestion", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+	suggestion := &suggestionsv1beta1.Suggestion{}
+	err := r.Get(context.TODO(),
+		types.NamespacedName{Namespace: instance.GetNamespace(), Name: instance.GetName()}, suggestion)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if suggestion.Spec.Suspend == instance.Spec.Suspend {
+		return nil
+	}
+	logger.Info("Update suggestion suspend", "Suspend", instance.Spec.Suspend)
+	suggestion.Spec.Suspend = instance.Spec.Suspend
+	return r.UpdateSuggestion(suggestion)
+}
+
+// killActiveTrials deletes pending and running Trials of the suspended Experiment
+// or the Experiment which is completed by the budget.
+// Trial assignments are kept in the Suggestion status, so the same Trials are created again
+// when the Experiment is resumed. If keepObservationLogs is set, the observation logs of the killed Trials
+// are kept for the Trials created again.
+func (r *ReconcileExperiment) killActiveTrials(instance *experimentsv1beta1.Experiment, trials []trialsv1beta1.Trial, keepObservationLogs bool) error {
+	logger := log.WithValues("Experiment", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+	// Resource usage of the killed trials must be accounted until they are deleted.
+	if !instance.IsCompleted() {
+		experimentutil.UpdateResourceUsage(instance, trials, time.Now())
+	}
+	var killedNames []string
+	for i := range trials {
+		trial := &trials[i]
+		if trial.IsCompleted() || !trial.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if keepObservationLogs && trial.GetAnnotations()[consts.AnnotationKeepObservationLogs] != "true" {
+			original := trial.DeepCopy()
+			if trial.Annotations == nil {
+				trial.Annotations = map[string]string{}
+			}
+			trial.Annotations[consts.AnnotationKeepObservationLogs] = "true"
+			if err := r.Patch(context.TODO(), trial, client.MergeFrom(original)); err != nil {
+				if errors.IsNotFound(err) {
+					continue
+				}
+				return err
+			}
+		}
+		if err := r.Delete(context.TODO(), trial); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		killedNames = append(killedNames, trial.Name)
+	}
+	if len(killedNames) != 0 {
+		logger.Info("Active trials are killed", "trialNames", killedNames)
+	}
+	return nil
+}
@@ -83,6 +83,7 @@ func (g *General) createSuggestion(instance *experimentsv1beta1.Experiment, sugg
 			Algorithm:    instance.Spec.Algorithm.DeepCopy(),
 			Requests:     suggestionRequests,
 			ResumePolicy: instance.Spec.ResumePolicy,
+			Suspend:      instance.Spec.Suspend,
 		},
 	}
 
@@ -0,0 +1,200 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"math"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+// ResourceUsageInterval is the granularity of the resource usage accounting for the active Trials.
+// The Experiment status is updated at most once per interval while Trials are active.
+const ResourceUsageInterval = time.Minute
+
+// UpdateResourceUsage adds the resource usage of the Trials since the last update to the Experiment status.
+// Usage is accumulated, so the usage of the deleted Trials is kept in the Experiment status.
+func UpdateResourceUsage(instance *experimentsv1beta1.Experiment, trials []trialsv1beta1.Trial, now time.Time) {
+	if len(instance.Spec.ResourceBudget) == 0 {
+		instance.Status.ResourceUsage = nil
+		instance.Status.ResourceUsageLastUpdateTime = nil
+		return
+	}
+	var lastUpdateTime time.Time
+	if instance.Status.ResourceUsageLastUpdateTime != nil {
+		lastUpdateTime = instance.Status.ResourceUsageLastUpdateTime.Time
+		if !now.After(lastUpdateTime) {
+			return
+		}
+	}
+
+	usage := map[corev1.ResourceName]float64{}
+	for name, quantity := range instance.Status.ResourceUsage {
+		usage[name] = quantity.AsApproximateFloat64()
+	}
+	for i := range trials {
+		trial := &trials[i]
+		if trial.Status.StartTime == nil {
+			continue
+		}
+		start := trial.Status.StartTime.Time
+		if start.Before(lastUpdateTime) {
+			start = lastUpdateTime
+		}
+		end := now
+		if trial.Status.CompletionTime != nil && !trial.Status.CompletionTime.IsZero() {
+			if trial.Status.CompletionTime.Time.Before(now) {
+				end = trial.Status.CompletionTime.Time
+			}
+		} else if trial.IsCompleted() {
+			// Runtime of the completed Trial is unknown.
+			continue
+		}
+		hours := end.Sub(start).Hours()
+		if hours <= 0 {
+			continue
+		}
+		for name, quantity := range getTrialResourceRequests(trial) {
+			usage[name] += quantity.AsApproximateFloat64() * hours
+		}
+	}
+
+	instance.Status.ResourceUsage = corev1.ResourceList{}
+	for name := range instance.Spec.ResourceBudget {
+		instance.Status.ResourceUsage[name] = *resource.NewMilliQuantity(int64(math.Round(usage[name]*1000)), resource.DecimalSI)
+	}
+	instance.Status.ResourceUsageLastUpdateTime = &metav1.Time{Time: now}
+}
+
+// getTrialResourceRequests returns the total resource requests of the Pod templates in the Trial run spec.
+// Pod templates are the template fields with the Pod spec, e.g. for Job or Kubeflow Training Jobs.
+// Requests of the Pod template are multiplied by the replicas field of the same object if it is set.
+func getTrialResourceRequests(trial *trialsv1beta1.Trial) corev1.ResourceList {
+	requests := corev1.ResourceList{}
+	if trial.Spec.RunSpec != nil {
+		addPodTemplateRequests(trial.Spec.RunSpec.Object, requests)
+	}
+	return requests
+}
+
+func addPodTemplateRequests(obj interface{}, requests corev1.ResourceList) {
+	switch o := obj.(type) {
+	case map[string]interface{}:
+		for key, value := range o {
+			if key == "template" {
+				if template, ok := value.(map[string]interface{}); ok {
+					if podSpec, found, err := unstructured.NestedMap(template, "spec"); found && err == nil {
+						if _, ok := podSpec["containers"]; ok {
+							replicas, found, err := unstructured.NestedInt64(o, "replicas")
+							if !found || err != nil {
+								replicas = 1
+							}
+							addPodSpecRequests(podSpec, replicas, requests)
+							continue
+						}
+					}
+				}
+			}
+			addPodTemplateRequests(value, requests)
+		}
+	case []interface{}:
+		for _, value := range o {
+			addPodTemplateRequests(value, requests)
+		}
+	}
+}
+
+func addPodSpecRequests(unstructuredPodSpec map[string]interface{}, replicas int64, requests corev1.ResourceList) {
+	podSpec := corev1.PodSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredPodSpec, &podSpec); err != nil {
+		log.Info("Failed to convert Pod template of the Trial run spec", "err", err)
+		return
+	}
+	for _, container := range podSpec.Containers {
+		containerRequests := container.Resources.Requests.DeepCopy()
+		if containerRequests == nil {
+			containerRequests = corev1.ResourceList{}
+		}
+		// Requests default to limits if they are not set.
+		for name, limit := range container.Resources.Limits {
+			if _, ok := containerRequests[name]; !ok {
+				containerRequests[name] = limit
+			}
+		}
+		for name, quantity := range containerRequests {
+			quantity.Mul(replicas)
+			total := requests[name]
+			total.Add(quantity)
+			requests[name] = total
+		}
+	}
+}
+
+// IsResourceBudgetExhausted returns true if usage of any resource in the budget reaches the budget.
+func IsResourceBudgetExhausted(instance *experimentsv1beta1.Experiment) bool {
+	for name, budget := range instance.Spec.ResourceBudget {
+		if usage, ok := instance.Status.ResourceUsage[name]; ok && usage.Cmp(budget) >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// IsMaxDurationReached returns true if the Experiment runs longer than the max duration.
+func IsMaxDurationReached(instance *experimentsv1beta1.Experiment, now time.Time) bool {
+	if instance.Spec.MaxDuration == nil || instance.Status.StartTime == nil {
+		return false
+	}
+	return !now.Before(instance.Status.StartTime.Add(instance.Spec.MaxDuration.Duration))
+}
+
+// IsCompletedByBudget returns true if the Experiment is completed because max duration is reached
+// or resource budget is exhausted.
+func IsCompletedByBudget(instance *experimentsv1beta1.Experiment) bool {
+	return instance.IsCompletedReason(ExperimentMaxDurationReachedReason) ||
+		instance.IsCompletedReason(ExperimentResourceBudgetExhaustedReason)
+}
+
+// GetBudgetRequeueAfter returns the duration after which the running Experiment must be reconciled
+// to check the max duration and the resource budget. It returns zero if it is not required.
+func GetBudgetRequeueAfter(instance *experimentsv1beta1.Experiment, now time.Time) time.Duration {
+	if instance.IsCompleted() {
+		return 0
+	}
+	var requeueAfter time.Duration
+	if instance.Spec.MaxDuration != nil && instance.Status.StartTime != nil {
+		requeueAfter = instance.Status.StartTime.Add(instance.Spec.MaxDuration.Duration).Sub(now)
+		if requeueAfter <= 0 {
+			requeueAfter = time.Second
+		}
+	}
+	activeTrialsCount := instance.Status.TrialsPending + instance.Status.TrialsRunning
+	if len(instance.Spec.ResourceBudget) != 0 && activeTrialsCount != 0 {
+		if requeueAfter == 0 || requeueAfter > ResourceUsageInterval {
+			requeueAfter = ResourceUsageInterval
+		}
+	}
+	return requeueAfter
+}
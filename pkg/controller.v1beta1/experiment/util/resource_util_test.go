@@ -0,0 +1,248 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+func newPodTemplate(requests, limits map[string]interface{}) map[string]interface{} {
+	resources := map[string]interface{}{}
+	if requests != nil {
+		resources["requests"] = requests
+	}
+	if limits != nil {
+		resources["limits"] = limits
+	}
+	return map[string]interface{}{
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{
+					"name":      "training-container",
+					"resources": resources,
+				},
+			},
+		},
+	}
+}
+
+func TestGetTrialResourceRequests(t *testing.T) {
+	cases := map[string]struct {
+		runSpec      map[string]interface{}
+		wantRequests map[corev1.ResourceName]string
+	}{
+		"Job with requests and limits": {
+			runSpec: map[string]interface{}{
+				"apiVersion": "batch/v1",
+				"kind":       "Job",
+				"spec": map[string]interface{}{
+					"template": newPodTemplate(
+						map[string]interface{}{"cpu": "500m"},
+						map[string]interface{}{"cpu": "1", "nvidia.com/gpu": "1"},
+					),
+				},
+			},
+			wantRequests: map[corev1.ResourceName]string{
+				corev1.ResourceCPU: "500m",
+				"nvidia.com/gpu":   "1",
+			},
+		},
+		"TFJob with replicas": {
+			runSpec: map[string]interface{}{
+				"apiVersion": "kubeflow.org/v1",
+				"kind":       "TFJob",
+				"spec": map[string]interface{}{
+					"tfReplicaSpecs": map[string]interface{}{
+						"PS": map[string]interface{}{
+							"replicas": int64(1),
+							"template": newPodTemplate(map[string]interface{}{"cpu": "1"}, nil),
+						},
+						"Worker": map[string]interface{}{
+							"replicas": int64(2),
+							"template": newPodTemplate(map[string]interface{}{"cpu": "2"}, nil),
+						},
+					},
+				},
+			},
+			wantRequests: map[corev1.ResourceName]string{
+				corev1.ResourceCPU: "5",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			trial := &trialsv1beta1.Trial{
+				Spec: trialsv1beta1.TrialSpec{
+					RunSpec: &unstructured.Unstructured{Object: tc.runSpec},
+				},
+			}
+			gotRequests := map[corev1.ResourceName]string{}
+			for name, quantity := range getTrialResourceRequests(trial) {
+				gotRequests[name] = quantity.String()
+			}
+			if diff := cmp.Diff(tc.wantRequests, gotRequests); len(diff) != 0 {
+				t.Errorf("Unexpected resource requests (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateResourceUsage(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	newTrial := func(cpu string, startTime time.Time, completionTime *time.Time) trialsv1beta1.Trial {
+		trial := trialsv1beta1.Trial{
+			Spec: trialsv1beta1.TrialSpec{
+				RunSpec: &unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"spec": map[string]interface{}{
+							"template": newPodTemplate(map[string]interface{}{"cpu": cpu}, nil),
+						},
+					},
+				},
+			},
+			Status: trialsv1beta1.TrialStatus{
+				StartTime: &metav1.Time{Time: startTime},
+			},
+		}
+		if completionTime != nil {
+			trial.Status.CompletionTime = &metav1.Time{Time: *completionTime}
+		}
+		return trial
+	}
+	completionTime := now.Add(-time.Hour)
+	trials := []trialsv1beta1.Trial{
+		// Completed Trial: 2 CPUs for 2 hours.
+		newTrial("2", now.Add(-3*time.Hour), &completionTime),
+		// Active Trial: 1 CPU for 1 hour.
+		newTrial("1", now.Add(-time.Hour), nil),
+	}
+
+	cases := map[string]struct {
+		trials         []trialsv1beta1.Trial
+		budget         string
+		usage          string
+		lastUpdateTime *metav1.Time
+		wantUsage      string
+		wantExhausted  bool
+	}{
+		"Resource budget is not exhausted": {
+			trials:    trials,
+			budget:    "10",
+			wantUsage: "5",
+		},
+		"Resource budget is exhausted": {
+			trials:        trials,
+			budget:        "5",
+			wantUsage:     "5",
+			wantExhausted: true,
+		},
+		"Usage is accumulated since the last update": {
+			trials:         trials,
+			budget:         "10",
+			usage:          "3",
+			lastUpdateTime: &metav1.Time{Time: now.Add(-30 * time.Minute)},
+			wantUsage:      "3.5",
+		},
+		"Usage of the deleted Trials is kept": {
+			budget:         "10",
+			usage:          "4",
+			lastUpdateTime: &metav1.Time{Time: now.Add(-time.Hour)},
+			wantUsage:      "4",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			instance := &experimentsv1beta1.Experiment{
+				Spec: experimentsv1beta1.ExperimentSpec{
+					ResourceBudget: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(tc.budget)},
+				},
+				Status: experimentsv1beta1.ExperimentStatus{
+					ResourceUsageLastUpdateTime: tc.lastUpdateTime,
+				},
+			}
+			if tc.usage != "" {
+				instance.Status.ResourceUsage = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(tc.usage)}
+			}
+			UpdateResourceUsage(instance, tc.trials, now)
+			gotUsage := instance.Status.ResourceUsage[corev1.ResourceCPU]
+			if gotUsage.Cmp(resource.MustParse(tc.wantUsage)) != 0 {
+				t.Errorf("Unexpected CPU usage: want %v, got %v", tc.wantUsage, gotUsage.String())
+			}
+			if got := IsResourceBudgetExhausted(instance); got != tc.wantExhausted {
+				t.Errorf("IsResourceBudgetExhausted() should return %v, but got %v", tc.wantExhausted, got)
+			}
+		})
+	}
+}
+
+func TestGetBudgetRequeueAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		maxDuration      *metav1.Duration
+		resourceBudget   corev1.ResourceList
+		trialsRunning    int32
+		wantRequeueAfter time.Duration
+		wantReached      bool
+	}{
+		"Experiment without budget": {},
+		"Max duration is not reached": {
+			maxDuration:      &metav1.Duration{Duration: 2 * time.Hour},
+			wantRequeueAfter: time.Hour,
+		},
+		"Max duration is reached": {
+			maxDuration:      &metav1.Duration{Duration: time.Hour},
+			wantRequeueAfter: time.Second,
+			wantReached:      true,
+		},
+		"Resource budget with running Trials": {
+			maxDuration:      &metav1.Duration{Duration: 2 * time.Hour},
+			resourceBudget:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10")},
+			trialsRunning:    1,
+			wantRequeueAfter: ResourceUsageInterval,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			instance := &experimentsv1beta1.Experiment{
+				Spec: experimentsv1beta1.ExperimentSpec{
+					MaxDuration:    tc.maxDuration,
+					ResourceBudget: tc.resourceBudget,
+				},
+				Status: experimentsv1beta1.ExperimentStatus{
+					StartTime:     &metav1.Time{Time: now.Add(-time.Hour)},
+					TrialsRunning: tc.trialsRunning,
+				},
+			}
+			if got := GetBudgetRequeueAfter(instance, now); got != tc.wantRequeueAfter {
+				t.Errorf("GetBudgetRequeueAfter() should return %v, but got %v", tc.wantRequeueAfter, got)
+			}
+			if got := IsMaxDurationReached(instance, now); got != tc.wantReached {
+				t.Errorf("IsMaxDurationReached() should return %v, but got %v", tc.wantReached, got)
+			}
+		})
+	}
+}
@@ -17,7 +17,9 @@ limitations under the License.
 package util
 
 import (
+	"sort"
 	"strconv"
+	"time"
 
 	"k8s.io/apimachinery/pkg/types"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -26,6 +28,7 @@ import (
 	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/pareto"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -39,12 +42,21 @@ const (
 	ExperimentMaxTrialsReachedReason     = "ExperimentMaxTrialsReached"
 	ExperimentSuggestionEndReachedReason = "ExperimentSuggestionEndReached"
 	ExperimentFailedReason               = "ExperimentFailed"
+	ExperimentSuspendedReason            = "ExperimentSuspended"
+	ExperimentResumedReason              = "ExperimentResumed"
+
+	ExperimentMaxDurationReachedReason      = "ExperimentMaxDurationReached"
+	ExperimentResourceBudgetExhaustedReason = "ExperimentResourceBudgetExhausted"
 )
 
 // UpdateExperimentStatus checks if objective goal is reached and updates Experiment status from current Trials
 func UpdateExperimentStatus(collector *ExperimentsCollector, instance *experimentsv1beta1.Experiment, trials *trialsv1beta1.TrialList) error {
 
 	isObjectiveGoalReached := updateTrialsSummary(instance, trials)
+	// Resource usage is frozen when the Experiment is completed.
+	if !instance.IsCompleted() {
+		UpdateResourceUsage(instance, trials.Items, time.Now().Truncate(ResourceUsageInterval))
+	}
 
 	if !instance.IsCompleted() {
 		UpdateExperimentStatusCondition(collector, instance, isObjectiveGoalReached, false)
@@ -91,6 +103,12 @@ func updateTrialsSummary(instance *experimentsv1beta1.Experiment, trials *trials
 			sts.PendingTrialList = append(sts.PendingTrialList, trial.Name)
 		}
 
+		// Infeasible Trials are not considered for the optimal Trial and the objective goal.
+		// Constraints of the running Trials are checked with the current observation.
+		if isInfeasible(trial) {
+			continue
+		}
+
 		objectiveMetricValueStr := getObjectiveMetricValue(trial)
 		if objectiveMetricValueStr == consts.UnavailableMetricValue {
 			continue
@@ -147,40 +165,88 @@ func updateTrialsSummary(instance *experimentsv1beta1.Experiment, trials *trials
 		sts.CurrentOptimalTrial.Observation.Metrics = []commonv1beta1.Metric{}
 		sts.CurrentOptimalTrial.Observation.Metrics = append(sts.CurrentOptimalTrial.Observation.Metrics, bestTrial.Status.Observation.Metrics...)
 	}
+
+	sts.ParetoOptimalTrials = getParetoOptimalTrials(instance, trials)
 	return isObjectiveGoalReached
 }
 
-func getObjectiveMetricValue(trial trialsv1beta1.Trial) string {
-	if trial.Status.Observation == nil {
-		return consts.UnavailableMetricValue
-	}
-	var objectiveStrategy commonv1beta1.MetricStrategyType
-	objectiveMetricName := trial.Spec.Objective.ObjectiveMetricName
-	for _, strategy := range trial.Spec.Objective.MetricStrategies {
-		if strategy.Name == objectiveMetricName {
-			objectiveStrategy = strategy.Value
-			break
+// getParetoOptimalTrials returns succeeded Trials which are not dominated by any other succeeded Trial
+// with respect to the objective and the additional objectives.
+// Infeasible Trials and Trials without numeric values for all objectives are skipped.
+func getParetoOptimalTrials(instance *experimentsv1beta1.Experiment, trials *trialsv1beta1.TrialList) []experimentsv1beta1.OptimalTrial {
+	if len(instance.Spec.Objective.AdditionalObjectives) == 0 {
+		return nil
+	}
+	objectives := []commonv1beta1.AdditionalObjective{{
+		ObjectiveMetricName: instance.Spec.Objective.ObjectiveMetricName,
+		Type:                instance.Spec.Objective.Type,
+	}}
+	objectives = append(objectives, instance.Spec.Objective.AdditionalObjectives...)
+
+	var candidates []trialsv1beta1.Trial
+	var candidateValues [][]float64
+	for _, trial := range trials.Items {
+		if !trial.IsSucceeded() || isInfeasible(trial) {
+			continue
 		}
+		values, ok := getMinimizedObjectiveValues(trial, objectives)
+		if !ok {
+			continue
+		}
+		candidates = append(candidates, trial)
+		candidateValues = append(candidateValues, values)
 	}
-	for _, metric := range trial.Status.Observation.Metrics {
-		if objectiveMetricName == metric.Name {
-			switch objectiveStrategy {
-			case commonv1beta1.ExtractByMin:
-				if metric.Min == consts.UnavailableMetricValue {
-					return metric.Latest
-				}
-				return metric.Min
-			case commonv1beta1.ExtractByMax:
-				if metric.Max == consts.UnavailableMetricValue {
-					return metric.Latest
-				}
-				return metric.Max
-			case commonv1beta1.ExtractByLatest:
-				return metric.Latest
+
+	var paretoTrials []experimentsv1beta1.OptimalTrial
+	for i, trial := range candidates {
+		isDominated := false
+		for j := range candidates {
+			if i != j && pareto.Dominates(candidateValues[j], candidateValues[i]) {
+				isDominated = true
+				break
 			}
 		}
+		if isDominated {
+			continue
+		}
+		paretoTrial := experimentsv1beta1.OptimalTrial{
+			BestTrialName:        trial.Name,
+			ParameterAssignments: []commonv1beta1.ParameterAssignment{},
+		}
+		paretoTrial.ParameterAssignments = append(paretoTrial.ParameterAssignments, trial.Spec.ParameterAssignments...)
+		paretoTrial.Observation.Metrics = []commonv1beta1.Metric{}
+		paretoTrial.Observation.Metrics = append(paretoTrial.Observation.Metrics, trial.Status.Observation.Metrics...)
+		paretoTrials = append(paretoTrials, paretoTrial)
 	}
-	return consts.UnavailableMetricValue
+	sort.Slice(paretoTrials, func(i, j int) bool {
+		return paretoTrials[i].BestTrialName < paretoTrials[j].BestTrialName
+	})
+	return paretoTrials
+}
+
+// getMinimizedObjectiveValues returns values of the objectives for the Trial.
+// Values of the maximized objectives are negated, so all values are minimized.
+func getMinimizedObjectiveValues(trial trialsv1beta1.Trial, objectives []commonv1beta1.AdditionalObjective) ([]float64, bool) {
+	values := make([]float64, 0, len(objectives))
+	for _, objective := range objectives {
+		value, err := strconv.ParseFloat(trial.GetMetricValue(objective.ObjectiveMetricName), 64)
+		if err != nil {
+			return nil, false
+		}
+		if objective.Type == commonv1beta1.ObjectiveTypeMaximize {
+			value = -value
+		}
+		values = append(values, value)
+	}
+	return values, true
+}
+
+func isInfeasible(trial trialsv1beta1.Trial) bool {
+	return trial.IsInfeasible() || len(trial.GetViolatedConstraints()) != 0
+}
+
+func getObjectiveMetricValue(trial trialsv1beta1.Trial) string {
+	return trial.GetMetricValue(trial.Spec.Objective.ObjectiveMetricName)
 }
 
 // UpdateExperimentStatusCondition updates the experiment status.
@@ -221,6 +287,24 @@ func UpdateExperimentStatusCondition(collector *ExperimentsCollector, instance *
 		return
 	}
 
+	if IsMaxDurationReached(instance, now.Time) {
+		msg := "Experiment has succeeded because max duration has reached"
+		instance.MarkExperimentStatusSucceeded(ExperimentMaxDurationReachedReason, msg)
+		instance.Status.CompletionTime = &now
+		collector.IncreaseExperimentsSucceededCount(instance.Namespace)
+		logger.Info(msg)
+		return
+	}
+
+	if IsResourceBudgetExhausted(instance) {
+		msg := "Experiment has succeeded because resource budget has exhausted"
+		instance.MarkExperimentStatusSucceeded(ExperimentResourceBudgetExhaustedReason, msg)
+		instance.Status.CompletionTime = &now
+		collector.IncreaseExperimentsSucceededCount(instance.Namespace)
+		logger.Info(msg)
+		return
+	}
+
 	if getSuggestionDone && activeTrialsCount == 0 {
 		msg := "Experiment has succeeded because suggestion service has reached the end"
 		instance.MarkExperimentStatusSucceeded(ExperimentSuggestionEndReachedReason, msg)
@@ -0,0 +1,163 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+func TestGetParetoOptimalTrials(t *testing.T) {
+	objective := &commonv1beta1.ObjectiveSpec{
+		Type:                commonv1beta1.ObjectiveTypeMaximize,
+		ObjectiveMetricName: "accuracy",
+		MetricStrategies: []commonv1beta1.MetricStrategy{
+			{Name: "accuracy", Value: commonv1beta1.ExtractByMax},
+			{Name: "latency", Value: commonv1beta1.ExtractByMin},
+		},
+	}
+	newTrial := func(name, accuracy, latency string, conditionType trialsv1beta1.TrialConditionType) trialsv1beta1.Trial {
+		return trialsv1beta1.Trial{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: trialsv1beta1.TrialSpec{
+				Objective: objective,
+				ParameterAssignments: []commonv1beta1.ParameterAssignment{
+					{Name: "lr", Value: name},
+				},
+			},
+			Status: trialsv1beta1.TrialStatus{
+				Conditions: []trialsv1beta1.TrialCondition{
+					{Type: conditionType, Status: corev1.ConditionTrue},
+				},
+				Observation: &commonv1beta1.Observation{
+					Metrics: []commonv1beta1.Metric{
+						{Name: "accuracy", Min: consts.UnavailableMetricValue, Max: accuracy, Latest: accuracy},
+						{Name: "latency", Min: latency, Max: consts.UnavailableMetricValue, Latest: latency},
+					},
+				},
+			},
+		}
+	}
+	trials := &trialsv1beta1.TrialList{
+		Items: []trialsv1beta1.Trial{
+			newTrial("trial-d", "0.8", "20", trialsv1beta1.TrialSucceeded),
+			newTrial("trial-a", "0.9", "30", trialsv1beta1.TrialSucceeded),
+			newTrial("trial-b", "0.7", "10", trialsv1beta1.TrialSucceeded),
+			newTrial("trial-c", "0.7", "25", trialsv1beta1.TrialSucceeded),
+			newTrial("trial-e", consts.UnavailableMetricValue, "5", trialsv1beta1.TrialSucceeded),
+			// Early stopped Trial reports metrics of the partial training.
+			newTrial("trial-f", "0.9", "10", trialsv1beta1.TrialEarlyStopped),
+		},
+	}
+
+	cases := map[string]struct {
+		additionalObjectives []commonv1beta1.AdditionalObjective
+		wantTrialNames       []string
+	}{
+		"Experiment without additional objectives": {},
+		"Experiment with additional objectives": {
+			additionalObjectives: []commonv1beta1.AdditionalObjective{
+				{ObjectiveMetricName: "latency", Type: commonv1beta1.ObjectiveTypeMinimize},
+			},
+			wantTrialNames: []string{"trial-a", "trial-b", "trial-d"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			instance := &experimentsv1beta1.Experiment{
+				Spec: experimentsv1beta1.ExperimentSpec{
+					Objective: objective.DeepCopy(),
+				},
+			}
+			instance.Spec.Objective.AdditionalObjectives = tc.additionalObjectives
+
+			var gotTrialNames []string
+			for _, trial := range getParetoOptimalTrials(instance, trials) {
+				gotTrialNames = append(gotTrialNames, trial.BestTrialName)
+				if trial.ParameterAssignments[0].Value != trial.BestTrialName {
+					t.Errorf("Unexpected parameter assignments for Trial %s: %v", trial.BestTrialName, trial.ParameterAssignments)
+				}
+			}
+			if diff := cmp.Diff(tc.wantTrialNames, gotTrialNames); len(diff) != 0 {
+				t.Errorf("Unexpected Pareto optimal Trials (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateTrialsSummaryWithConstraints(t *testing.T) {
+	goal := 0.95
+	instance := &experimentsv1beta1.Experiment{
+		Spec: experimentsv1beta1.ExperimentSpec{
+			Objective: &commonv1beta1.ObjectiveSpec{
+				Type:                commonv1beta1.ObjectiveTypeMaximize,
+				Goal:                &goal,
+				ObjectiveMetricName: "accuracy",
+				MetricStrategies: []commonv1beta1.MetricStrategy{
+					{Name: "accuracy", Value: commonv1beta1.ExtractByMax},
+					{Name: "latency", Value: commonv1beta1.ExtractByLatest},
+				},
+				Constraints: []commonv1beta1.ObjectiveConstraint{
+					{MetricName: "latency", Comparison: commonv1beta1.ComparisonTypeLess, Bound: 20},
+				},
+			},
+		},
+	}
+	newTrial := func(name, accuracy, latency string, infeasible bool) trialsv1beta1.Trial {
+		return trialsv1beta1.Trial{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: trialsv1beta1.TrialSpec{
+				Objective: instance.Spec.Objective,
+			},
+			Status: trialsv1beta1.TrialStatus{
+				Observation: &commonv1beta1.Observation{
+					Metrics: []commonv1beta1.Metric{
+						{Name: "accuracy", Min: accuracy, Max: accuracy, Latest: accuracy},
+						{Name: "latency", Min: latency, Max: latency, Latest: latency},
+					},
+				},
+				Infeasible: infeasible,
+			},
+		}
+	}
+	trials := &trialsv1beta1.TrialList{
+		Items: []trialsv1beta1.Trial{
+			// Trial is marked as infeasible by the Trial controller.
+			newTrial("trial-a", "0.99", "30", true),
+			// Constraint of the running Trial is checked with the current observation.
+			newTrial("trial-b", "0.97", "25", false),
+			newTrial("trial-c", "0.96", consts.UnavailableMetricValue, false),
+			newTrial("trial-d", "0.9", "10", false),
+		},
+	}
+
+	isObjectiveGoalReached := updateTrialsSummary(instance, trials)
+	if isObjectiveGoalReached {
+		t.Errorf("Objective goal should not be reached by infeasible Trials")
+	}
+	if instance.Status.CurrentOptimalTrial.BestTrialName != "trial-d" {
+		t.Errorf("Unexpected optimal Trial: want trial-d, got %s", instance.Status.CurrentOptimalTrial.BestTrialName)
+	}
+}
@@ -18,6 +18,7 @@ package composer
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/viper"
 	appsv1 "k8s.io/api/apps/v1"
@@ -26,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -36,6 +38,7 @@ import (
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
 )
 
@@ -55,6 +58,7 @@ type Composer interface {
 	DesiredService(s *suggestionsv1beta1.Suggestion) (*corev1.Service, error)
 	DesiredVolume(s *suggestionsv1beta1.Suggestion) (*corev1.PersistentVolumeClaim, *corev1.PersistentVolume, error)
 	DesiredRBAC(s *suggestionsv1beta1.Suggestion) (*corev1.ServiceAccount, *rbacv1.Role, *rbacv1.RoleBinding, error)
+	DesiredGRPCCertSecret(s *suggestionsv1beta1.Suggestion) (*corev1.Secret, error)
 	CreateComposer(mgr manager.Manager) Composer
 }
 
@@ -113,6 +117,12 @@ func (g *General) DesiredDeployment(s *suggestionsv1beta1.Suggestion) (*appsv1.D
 		},
 	}
 
+	// Scale down the Suggestion Deployment while Suggestion is suspended.
+	if s.Spec.Suspend {
+		replicas := int32(0)
+		d.Spec.Replicas = &replicas
+	}
+
 	// Get Suggestion Service Account Name from config
 	if suggestionConfigData.ServiceAccountName != "" {
 		d.Spec.Template.Spec.ServiceAccountName = suggestionConfigData.ServiceAccountName
@@ -132,6 +142,12 @@ func (g *General) DesiredDeployment(s *suggestionsv1beta1.Suggestion) (*appsv1.D
 		}
 	}
 
+	// Mount the certs for mutual TLS between the Katib gRPC services.
+	if viper.GetBool(consts.ConfigGRPCTLSEnable) {
+		d.Spec.Template.Spec.Volumes = append(d.Spec.Template.Spec.Volumes,
+			util.GRPCCertVolume(consts.GRPCNamespaceCertSecretName))
+	}
+
 	// Attach ServiceAccount if early stopping is used.
 	// For custom service account user should manually add appropriate Role to change Trial status.
 	if s.Spec.EarlyStopping != nil && s.Spec.EarlyStopping.AlgorithmName != "" && suggestionConfigData.ServiceAccountName == "" {
@@ -207,24 +223,14 @@ func (g *General) desiredContainers(s *suggestionsv1beta1.Suggestion,
 
 	if viper.GetBool(consts.ConfigEnableGRPCProbeInSuggestion) && suggestionContainer.ReadinessProbe == nil {
 		suggestionContainer.ReadinessProbe = &corev1.Probe{
-			ProbeHandler: corev1.ProbeHandler{
-				GRPC: &corev1.GRPCAction{
-					Port:    consts.DefaultSuggestionPort,
-					Service: &consts.DefaultGRPCService,
-				},
-			},
+			ProbeHandler:        suggestionProbeHandler(),
 			InitialDelaySeconds: defaultInitialDelaySeconds,
 			PeriodSeconds:       defaultPeriodForReady,
 		}
 	}
 	if viper.GetBool(consts.ConfigEnableGRPCProbeInSuggestion) && suggestionContainer.LivenessProbe == nil {
 		suggestionContainer.LivenessProbe = &corev1.Probe{
-			ProbeHandler: corev1.ProbeHandler{
-				GRPC: &corev1.GRPCAction{
-					Port:    consts.DefaultSuggestionPort,
-					Service: &consts.DefaultGRPCService,
-				},
-			},
+			ProbeHandler: suggestionProbeHandler(),
 			// Ref https://srcco.de/posts/kubernetes-liveness-probes-are-dangerous.html
 			InitialDelaySeconds: defaultInitialDelaySeconds,
 			PeriodSeconds:       defaultPeriodForLive,
@@ -240,6 +246,10 @@ func (g *General) desiredContainers(s *suggestionsv1beta1.Suggestion,
 		suggestionContainer.VolumeMounts = append(suggestionContainer.VolumeMounts, suggestionVolume)
 	}
 
+	if viper.GetBool(consts.ConfigGRPCTLSEnable) {
+		util.AddGRPCCertToContainer(&suggestionContainer)
+	}
+
 	containers = append(containers, suggestionContainer)
 
 	if s.Spec.EarlyStopping != nil && s.Spec.EarlyStopping.AlgorithmName != "" {
@@ -255,12 +265,33 @@ func (g *General) desiredContainers(s *suggestionsv1beta1.Suggestion,
 			},
 			Resources: earlyStoppingConfigData.Resource,
 		}
+		if viper.GetBool(consts.ConfigGRPCTLSEnable) {
+			util.AddGRPCCertToContainer(&earlyStoppingContainer)
+		}
 
 		containers = append(containers, earlyStoppingContainer)
 	}
 	return containers
 }
 
+// suggestionProbeHandler returns the handler of the suggestion container probes.
+// Kubelet gRPC probes don't support TLS, so only the port is checked when mutual TLS is enabled.
+func suggestionProbeHandler() corev1.ProbeHandler {
+	if viper.GetBool(consts.ConfigGRPCTLSEnable) {
+		return corev1.ProbeHandler{
+			TCPSocket: &corev1.TCPSocketAction{
+				Port: intstr.FromInt32(consts.DefaultSuggestionPort),
+			},
+		}
+	}
+	return corev1.ProbeHandler{
+		GRPC: &corev1.GRPCAction{
+			Port:    consts.DefaultSuggestionPort,
+			Service: &consts.DefaultGRPCService,
+		},
+	}
+}
+
 func containsVolumeMountWithName(volumeMounts []corev1.VolumeMount, name string) bool {
 	for i := range volumeMounts {
 		if volumeMounts[i].Name == name {
@@ -400,6 +431,35 @@ func (g *General) DesiredRBAC(s *suggestionsv1beta1.Suggestion) (*corev1.Service
 	return serviceAccount, role, roleBinding, nil
 }
 
+// DesiredGRPCCertSecret returns desired Secret with the namespace certs for mutual TLS between the Katib gRPC services
+// in the Suggestion namespace. The certs are issued by the CA which is mounted to the controller, and the CA private key
+// is never copied to the Secret. The Secret is shared by all Suggestions in the namespace, so it has no owner.
+// If mutual TLS is disabled, return nil.
+func (g *General) DesiredGRPCCertSecret(s *suggestionsv1beta1.Suggestion) (*corev1.Secret, error) {
+	if !viper.GetBool(consts.ConfigGRPCTLSEnable) {
+		return nil, nil
+	}
+
+	certs, err := grpctls.NewNamespaceCerts(grpctls.CertDir(), s.Namespace, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      consts.GRPCNamespaceCertSecretName,
+			Namespace: s.Namespace,
+			Labels: map[string]string{
+				consts.LabelComponent: consts.LabelGRPCCertValue,
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: certs,
+	}
+
+	return secret, nil
+}
+
 // CreateComposer create instance of composer interface with given manager
 func (g *General) CreateComposer(mgr manager.Manager) Composer {
 	return &General{mgr.GetScheme(), mgr.GetClient()}
@@ -18,8 +18,15 @@ package composer
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
 	stdlog "log"
+	"math/big"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -39,6 +46,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
@@ -53,6 +61,7 @@ import (
 	suggestionsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 )
 
 var (
@@ -157,6 +166,7 @@ func TestDesiredDeployment(t *testing.T) {
 		suggestion         *suggestionsv1beta1.Suggestion
 		configMap          *corev1.ConfigMap
 		expectedDeployment *appsv1.Deployment
+		grpcTLS            bool
 		err                bool
 		testDescription    string
 	}{
@@ -231,6 +241,22 @@ func TestDesiredDeployment(t *testing.T) {
 			err:             false,
 			testDescription: "Desired Deployment valid run with default serviceAccount",
 		},
+		{
+			suggestion: func() *suggestionsv1beta1.Suggestion {
+				s := newFakeSuggestion()
+				s.Spec.Suspend = true
+				return s
+			}(),
+			configMap: newFakeKatibConfig(newFakeSuggestionConfig(), newFakeEarlyStoppingConfig()),
+			expectedDeployment: func() *appsv1.Deployment {
+				deploy := newFakeDeployment()
+				replicas := int32(0)
+				deploy.Spec.Replicas = &replicas
+				return deploy
+			}(),
+			err:             false,
+			testDescription: "Desired Deployment is scaled down for suspended Suggestion",
+		},
 		{
 			suggestion: newFakeSuggestion(),
 			configMap: func() *corev1.ConfigMap {
@@ -242,11 +268,52 @@ func TestDesiredDeployment(t *testing.T) {
 			err:             true,
 			testDescription: "Get early stopping config error, image is missed",
 		},
+		{
+			suggestion: newFakeSuggestion(),
+			configMap:  newFakeKatibConfig(newFakeSuggestionConfig(), newFakeEarlyStoppingConfig()),
+			expectedDeployment: func() *appsv1.Deployment {
+				deploy := newFakeDeployment()
+				deploy.Spec.Template.Spec.Volumes = append(deploy.Spec.Template.Spec.Volumes, corev1.Volume{
+					Name: consts.GRPCCertVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{
+							SecretName: consts.GRPCNamespaceCertSecretName,
+						},
+					},
+				})
+				tcpProbe := corev1.ProbeHandler{
+					TCPSocket: &corev1.TCPSocketAction{
+						Port: intstr.FromInt32(consts.DefaultSuggestionPort),
+					},
+				}
+				deploy.Spec.Template.Spec.Containers[0].ReadinessProbe.ProbeHandler = tcpProbe
+				deploy.Spec.Template.Spec.Containers[0].LivenessProbe.ProbeHandler = tcpProbe
+				for i := range deploy.Spec.Template.Spec.Containers {
+					c := &deploy.Spec.Template.Spec.Containers[i]
+					c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{
+						Name:      consts.GRPCCertVolumeName,
+						MountPath: consts.GRPCCertDir,
+						ReadOnly:  true,
+					})
+					c.Env = append(c.Env, corev1.EnvVar{
+						Name:  grpctls.CertDirEnvName,
+						Value: consts.GRPCCertDir,
+					})
+				}
+				return deploy
+			}(),
+			grpcTLS:         true,
+			err:             false,
+			testDescription: "Desired Deployment with mutual TLS",
+		},
 	}
 
 	viper.Set(consts.ConfigEnableGRPCProbeInSuggestion, true)
+	defer viper.Set(consts.ConfigGRPCTLSEnable, false)
 
 	for idx, tc := range tcs {
+		viper.Set(consts.ConfigGRPCTLSEnable, tc.grpcTLS)
+
 		// Create configMap with Katib config
 		g.Expect(c.Create(ctx, tc.configMap)).NotTo(gomega.HaveOccurred())
 
@@ -629,6 +696,107 @@ func TestDesiredRBAC(t *testing.T) {
 	}
 }
 
+func TestDesiredGRPCCertSecret(t *testing.T) {
+	certDir := t.TempDir()
+	writeTestCA(t, certDir)
+	grpctls.SetCertDir(certDir)
+	defer grpctls.SetCertDir("")
+	defer viper.Set(consts.ConfigGRPCTLSEnable, false)
+
+	userSuggestion := newFakeSuggestion()
+	userSuggestion.Namespace = "user-namespace"
+
+	tcs := []struct {
+		suggestion      *suggestionsv1beta1.Suggestion
+		grpcTLS         bool
+		expectedSecret  *corev1.Secret
+		testDescription string
+	}{
+		{
+			suggestion:      userSuggestion,
+			grpcTLS:         false,
+			testDescription: "Mutual TLS is disabled",
+		},
+		{
+			suggestion: userSuggestion,
+			grpcTLS:    true,
+			expectedSecret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      consts.GRPCNamespaceCertSecretName,
+					Namespace: "user-namespace",
+					Labels: map[string]string{
+						consts.LabelComponent: consts.LabelGRPCCertValue,
+					},
+				},
+				Type: corev1.SecretTypeOpaque,
+			},
+			testDescription: "Desired Secret valid run",
+		},
+	}
+
+	composer := &General{}
+	for _, tc := range tcs {
+		viper.Set(consts.ConfigGRPCTLSEnable, tc.grpcTLS)
+		actualSecret, err := composer.DesiredGRPCCertSecret(tc.suggestion)
+		if err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+			continue
+		}
+		if tc.expectedSecret == nil {
+			if actualSecret != nil {
+				t.Errorf("Case: %v failed. Expected nil Secret, got %v", tc.testDescription, actualSecret)
+			}
+			continue
+		}
+		// Certs are issued for the Suggestion namespace and the CA private key is not copied.
+		if !grpctls.NamespaceCertsValid(actualSecret.Data, certDir, tc.suggestion.Namespace, time.Now()) {
+			t.Errorf("Case: %v failed. Invalid namespace certs in Secret %v", tc.testDescription, actualSecret)
+		}
+		if _, ok := actualSecret.Data[grpctls.CAKeyFile]; ok {
+			t.Errorf("Case: %v failed. CA private key must not be in Secret", tc.testDescription)
+		}
+		actualSecret.Data = nil
+		if !equality.Semantic.DeepEqual(tc.expectedSecret, actualSecret) {
+			t.Errorf("Case: %v failed. \nExpected Secret %v\n Got %v", tc.testDescription, tc.expectedSecret, actualSecret)
+		}
+	}
+}
+
+// writeTestCA writes the self-signed CA certificate and its private key to dir.
+func writeTestCA(t *testing.T, dir string) {
+	t.Helper()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "katib-grpc-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caKeyDER, err := x509.MarshalECPrivateKey(caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string][]byte{
+		grpctls.CACertFile: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		grpctls.CAKeyFile:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: caKeyDER}),
+	}
+	for name, data := range files {
+		if err = os.WriteFile(filepath.Join(dir, name), data, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
 func metaEqual(expected, actual metav1.ObjectMeta) bool {
 	return expected.Name == actual.Name &&
 		expected.Namespace == actual.Namespace &&
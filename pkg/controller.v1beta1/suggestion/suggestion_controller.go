@@ -18,11 +18,13 @@ package suggestion
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/spf13/viper"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -113,6 +115,7 @@ type ReconcileSuggestion struct {
 // Automatically generate RBAC rules to allow the Controller to read and write Deployments
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apps,resources=deployments/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update
 // +kubebuilder:rbac:groups=katib.kubeflow.org,resources=suggestions,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=katib.kubeflow.org,resources=suggestions/status,verbs=get;update;patch
 func (r *ReconcileSuggestion) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
@@ -121,7 +124,7 @@ func (r *ReconcileSuggestion) Reconcile(ctx context.Context, request reconcile.R
 	oldS := &suggestionsv1beta1.Suggestion{}
 	err := r.Get(ctx, request.NamespacedName, oldS)
 	if err != nil {
-		if errors.IsNotFound(err) {
+		if apierrors.IsNotFound(err) {
 			// For additional cleanup logic use finalizers.
 			return reconcile.Result{}, nil
 		}
@@ -201,6 +204,13 @@ func (r *ReconcileSuggestion) ReconcileSuggestion(instance *suggestionsv1beta1.S
 		return err
 	}
 
+	// If mutual TLS is enabled, issue the namespace certs in the Suggestion namespace.
+	if viper.GetBool(consts.ConfigGRPCTLSEnable) {
+		if err = r.reconcileGRPCCertSecret(instance, suggestionNsName); err != nil {
+			return err
+		}
+	}
+
 	deploy, err := r.DesiredDeployment(instance)
 	if err != nil {
 		return err
@@ -226,7 +236,12 @@ func (r *ReconcileSuggestion) ReconcileSuggestion(instance *suggestionsv1beta1.S
 	if foundDeploy, err := r.reconcileDeployment(deploy, suggestionNsName); err != nil {
 		return err
 	} else {
-		if !r.checkDeploymentReady(foundDeploy) {
+		if instance.Spec.Suspend {
+			// Suggestion is suspended, assignments are not synced until it is resumed.
+			msg := "Deployment is scaled down because Suggestion is suspended"
+			instance.MarkSuggestionStatusDeploymentReady(corev1.ConditionFalse, SuggestionDeploymentSuspended, msg)
+			return nil
+		} else if !r.checkDeploymentReady(foundDeploy) {
 			// deployment is not ready yet
 			msg := "Deployment is not ready"
 			instance.MarkSuggestionStatusDeploymentReady(corev1.ConditionFalse, SuggestionDeploymentNotReady, msg)
@@ -275,6 +290,13 @@ func (r *ReconcileSuggestion) ReconcileSuggestion(instance *suggestionsv1beta1.S
 	logger.Info("Sync assignments", "Suggestion Requests", instance.Spec.Requests,
 		"Suggestion Count", instance.Status.SuggestionCount)
 	if err = r.SyncAssignments(instance, experiment, trials.Items); err != nil {
+		if errors.Is(err, suggestionclient.ErrInvalidEarlyStoppingRules) {
+			logger.Error(err, "Marking suggestion failed as early stopping rules validation failed")
+			msg := fmt.Sprintf("Validation failed: %v", err)
+			instance.MarkSuggestionStatusFailed(SuggestionFailedReason, msg)
+			// return nil since it is a terminal condition
+			return nil
+		}
 		return err
 	}
 
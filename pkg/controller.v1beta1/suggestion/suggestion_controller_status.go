@@ -24,11 +24,12 @@ import (
 )
 
 const (
-	SuggestionCreatedReason      = "SuggestionCreated"
-	SuggestionDeploymentReady    = "DeploymentReady"
-	SuggestionDeploymentNotReady = "DeploymentNotReady"
-	SuggestionRunningReason      = "SuggestionRunning"
-	SuggestionFailedReason       = "SuggestionFailed"
+	SuggestionCreatedReason       = "SuggestionCreated"
+	SuggestionDeploymentReady     = "DeploymentReady"
+	SuggestionDeploymentNotReady  = "DeploymentNotReady"
+	SuggestionDeploymentSuspended = "DeploymentSuspended"
+	SuggestionRunningReason       = "SuggestionRunning"
+	SuggestionFailedReason        = "SuggestionFailed"
 )
 
 func (r *ReconcileSuggestion) updateStatus(s *suggestionsv1beta1.Suggestion, oldS *suggestionsv1beta1.Suggestion) error {
@@ -18,6 +18,7 @@ package suggestion
 
 import (
 	"context"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -26,6 +27,8 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 )
 
 func (r *ReconcileSuggestion) reconcileDeployment(deploy *appsv1.Deployment, suggestionNsName types.NamespacedName) (*appsv1.Deployment, error) {
@@ -39,9 +42,25 @@ func (r *ReconcileSuggestion) reconcileDeployment(deploy *appsv1.Deployment, sug
 	} else if err != nil {
 		return nil, err
 	}
+	// Scale the Deployment when Suggestion is suspended or resumed.
+	if replicas := deploymentReplicas(deploy); replicas != deploymentReplicas(foundDeploy) {
+		logger.Info("Scaling Deployment", "name", deploy.Name, "replicas", replicas)
+		foundDeploy.Spec.Replicas = &replicas
+		if err = r.Update(context.TODO(), foundDeploy); err != nil {
+			return nil, err
+		}
+	}
 	return foundDeploy, nil
 }
 
+// deploymentReplicas returns the number of Deployment replicas, unset replicas means one replica.
+func deploymentReplicas(deploy *appsv1.Deployment) int32 {
+	if deploy.Spec.Replicas == nil {
+		return 1
+	}
+	return *deploy.Spec.Replicas
+}
+
 func (r *ReconcileSuggestion) reconcileService(service *corev1.Service, suggestionNsName types.NamespacedName) (*corev1.Service, error) {
 	logger := log.WithValues("Suggestion", suggestionNsName)
 	foundService := &corev1.Service{}
@@ -143,6 +162,31 @@ func (r *ReconcileSuggestion) reconcileRBAC(
 	return nil
 }
 
+func (r *ReconcileSuggestion) reconcileGRPCCertSecret(instance *v1beta1.Suggestion, suggestionNsName types.NamespacedName) error {
+	logger := log.WithValues("Suggestion", suggestionNsName)
+	foundSecret := &corev1.Secret{}
+	err := r.Get(context.TODO(), types.NamespacedName{Name: consts.GRPCNamespaceCertSecretName, Namespace: instance.Namespace}, foundSecret)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	secretFound := err == nil
+	// Certs are issued again only when they are close to expiration or the CA is rotated.
+	if secretFound && grpctls.NamespaceCertsValid(foundSecret.Data, grpctls.CertDir(), instance.Namespace, time.Now()) {
+		return nil
+	}
+	secret, err := r.DesiredGRPCCertSecret(instance)
+	if err != nil {
+		return err
+	}
+	if !secretFound {
+		logger.Info("Creating gRPC cert Secret", "name", secret.Name)
+		return r.Create(context.TODO(), secret)
+	}
+	logger.Info("Updating gRPC cert Secret", "name", secret.Name)
+	foundSecret.Data = secret.Data
+	return r.Update(context.TODO(), foundSecret)
+}
+
 func (r *ReconcileSuggestion) deleteDeployment(instance *v1beta1.Suggestion, suggestionNsName types.NamespacedName) error {
 	logger := log.WithValues("Suggestion", suggestionNsName)
 	deploy, err := r.DesiredDeployment(instance)
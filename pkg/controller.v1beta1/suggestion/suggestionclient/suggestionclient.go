@@ -18,13 +18,13 @@ package suggestionclient
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -39,6 +39,8 @@ import (
 	katibmanagerv1beta1 "github.com/kubeflow/katib/pkg/common/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
+	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 )
 
 var (
@@ -60,6 +62,10 @@ var (
 	}
 )
 
+// ErrInvalidEarlyStoppingRules is returned by SyncAssignments when the early stopping service responds with invalid rules.
+// The retry gets the same rules from the service, so the Suggestion is failed.
+var ErrInvalidEarlyStoppingRules = errors.New("the response contains invalid early stopping rules")
+
 // SuggestionClient is the interface to communicate with algorithm services.
 type SuggestionClient interface {
 	SyncAssignments(instance *suggestionsv1beta1.Suggestion, e *experimentsv1beta1.Experiment,
@@ -91,7 +97,11 @@ func (g *General) SyncAssignments(
 	}
 
 	endpoint := util.GetAlgorithmEndpoint(instance)
-	connSuggestion, err := grpc.Dial(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	credsOpt, err := grpctls.DialOption(grpctls.NamespaceServerName(instance.Namespace))
+	if err != nil {
+		return err
+	}
+	connSuggestion, err := grpc.Dial(endpoint, credsOpt)
 	if err != nil {
 		return err
 	}
@@ -131,7 +141,7 @@ func (g *General) SyncAssignments(
 	// If early stopping is set, call GetEarlyStoppingRules after GetSuggestions.
 	if instance.Spec.EarlyStopping != nil {
 		endpoint = util.GetEarlyStoppingEndpoint(instance)
-		connEarlyStopping, err := grpc.Dial(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		connEarlyStopping, err := grpc.Dial(endpoint, credsOpt)
 		if err != nil {
 			return err
 		}
@@ -163,9 +173,15 @@ func (g *General) SyncAssignments(
 					Value:      rule.Value,
 					Comparison: convertComparison(rule.Comparison),
 					StartStep:  int(rule.StartStep),
+					Type:       convertEarlyStoppingRuleType(rule.Type),
+					Patience:   int(rule.Patience),
+					Group:      rule.Group,
 				},
 			)
 		}
+		if err := mccommon.ValidateEarlyStoppingRules(earlyStoppingRules, e.Spec.Objective); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidEarlyStoppingRules, err)
+		}
 	}
 
 	trialAssignments := []suggestionsv1beta1.TrialAssignment{}
@@ -202,7 +218,11 @@ func (g *General) ValidateAlgorithmSettings(instance *suggestionsv1beta1.Suggest
 	logger := log.WithValues("Suggestion", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
 	endpoint := util.GetAlgorithmEndpoint(instance)
 
-	conn, err := grpc.Dial(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()),
+	credsOpt, err := grpctls.DialOption(grpctls.NamespaceServerName(instance.Namespace))
+	if err != nil {
+		return err
+	}
+	conn, err := grpc.Dial(endpoint, credsOpt,
 		grpc.WithStreamInterceptor(grpc_retry.StreamClientInterceptor(callValidatorOpts...)),
 		grpc.WithUnaryInterceptor(grpc_retry.UnaryClientInterceptor(callValidatorOpts...)),
 	)
@@ -250,7 +270,11 @@ func (g *General) ValidateEarlyStoppingSettings(instance *suggestionsv1beta1.Sug
 	logger := log.WithValues("EarlyStopping", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
 	endpoint := util.GetEarlyStoppingEndpoint(instance)
 
-	conn, err := grpc.Dial(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()),
+	credsOpt, err := grpctls.DialOption(grpctls.NamespaceServerName(instance.Namespace))
+	if err != nil {
+		return err
+	}
+	conn, err := grpc.Dial(endpoint, credsOpt,
 		grpc.WithStreamInterceptor(grpc_retry.StreamClientInterceptor(callValidatorOpts...)),
 		grpc.WithUnaryInterceptor(grpc_retry.UnaryClientInterceptor(callValidatorOpts...)),
 	)
@@ -306,6 +330,8 @@ func (g *General) ConvertExperiment(e *experimentsv1beta1.Experiment) *suggestio
 			Type:                  convertObjectiveType(e.Spec.Objective.Type),
 			ObjectiveMetricName:   e.Spec.Objective.ObjectiveMetricName,
 			AdditionalMetricNames: e.Spec.Objective.AdditionalMetricNames,
+			AdditionalObjectives:  convertAdditionalObjectives(e.Spec.Objective.AdditionalObjectives),
+			Constraints:           convertConstraints(e.Spec.Objective.Constraints),
 		},
 		ParameterSpecs: &suggestionapi.ExperimentSpec_ParameterSpecs{
 			Parameters: convertParameters(e.Spec.Parameters),
@@ -353,6 +379,8 @@ func (g *General) ConvertTrials(ts []trialsv1beta1.Trial) []*suggestionapi.Trial
 					Type:                  convertObjectiveType(t.Spec.Objective.Type),
 					ObjectiveMetricName:   t.Spec.Objective.ObjectiveMetricName,
 					AdditionalMetricNames: t.Spec.Objective.AdditionalMetricNames,
+					AdditionalObjectives:  convertAdditionalObjectives(t.Spec.Objective.AdditionalObjectives),
+					Constraints:           convertConstraints(t.Spec.Objective.Constraints),
 				},
 				ParameterAssignments: convertTrialParameterAssignments(
 					t.Spec.ParameterAssignments),
@@ -482,6 +510,36 @@ func convertObjectiveType(typ commonapiv1beta1.ObjectiveType) suggestionapi.Obje
 	}
 }
 
+func convertAdditionalObjectives(objectives []commonapiv1beta1.AdditionalObjective) []*suggestionapi.AdditionalObjective {
+	var res []*suggestionapi.AdditionalObjective
+	for _, o := range objectives {
+		res = append(res, &suggestionapi.AdditionalObjective{
+			ObjectiveMetricName: o.ObjectiveMetricName,
+			Type:                convertObjectiveType(o.Type),
+		})
+	}
+	return res
+}
+
+func convertConstraints(constraints []commonapiv1beta1.ObjectiveConstraint) []*suggestionapi.ObjectiveConstraint {
+	var res []*suggestionapi.ObjectiveConstraint
+	for _, c := range constraints {
+		constraint := &suggestionapi.ObjectiveConstraint{
+			MetricName: c.MetricName,
+			Comparison: suggestionapi.ComparisonType_UNKNOWN_COMPARISON,
+			Bound:      c.Bound,
+		}
+		switch c.Comparison {
+		case commonapiv1beta1.ComparisonTypeLess:
+			constraint.Comparison = suggestionapi.ComparisonType_LESS
+		case commonapiv1beta1.ComparisonTypeGreater:
+			constraint.Comparison = suggestionapi.ComparisonType_GREATER
+		}
+		res = append(res, constraint)
+	}
+	return res
+}
+
 func convertAlgorithmSettings(as []commonapiv1beta1.AlgorithmSetting) []*suggestionapi.AlgorithmSetting {
 	res := make([]*suggestionapi.AlgorithmSetting, 0)
 	for _, s := range as {
@@ -566,6 +624,17 @@ func convertDistribution(typ experimentsv1beta1.Distribution) suggestionapi.Dist
 	}
 }
 
+func convertEarlyStoppingRuleType(ruleType suggestionapi.EarlyStoppingRuleType) commonapiv1beta1.EarlyStoppingRuleType {
+	switch ruleType {
+	case suggestionapi.EarlyStoppingRuleType_PATIENCE:
+		return commonapiv1beta1.EarlyStoppingRuleTypePatience
+	case suggestionapi.EarlyStoppingRuleType_DIVERGENCE:
+		return commonapiv1beta1.EarlyStoppingRuleTypeDivergence
+	default:
+		return commonapiv1beta1.EarlyStoppingRuleTypeThreshold
+	}
+}
+
 func convertComparison(comparison suggestionapi.ComparisonType) commonapiv1beta1.ComparisonType {
 	switch comparison {
 	case suggestionapi.ComparisonType_EQUAL:
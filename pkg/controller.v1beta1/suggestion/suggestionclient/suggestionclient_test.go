@@ -149,16 +149,23 @@ func TestSyncAssignments(t *testing.T) {
 	getEarlyStoppingRulesReply := &suggestionapi.GetEarlyStoppingRulesReply{
 		EarlyStoppingRules: []*suggestionapi.EarlyStoppingRule{
 			{
-				Name:       "accuracy",
+				Name:       "metric1-name",
 				Value:      "0.7",
 				Comparison: suggestionapi.ComparisonType_LESS,
 				StartStep:  4,
 			},
 			{
-				Name:       "epoch",
+				Name:       "metric2-name",
 				Value:      "10",
 				Comparison: suggestionapi.ComparisonType_EQUAL,
 			},
+			{
+				Name:       "metric2-name",
+				Comparison: suggestionapi.ComparisonType_LESS,
+				Type:       suggestionapi.EarlyStoppingRuleType_PATIENCE,
+				Patience:   5,
+				Group:      "patience",
+			},
 		},
 	}
 
@@ -183,6 +190,18 @@ func TestSyncAssignments(t *testing.T) {
 	validRunGetSuggestions2 := rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), k8sMatcher{expectedRequestSuggestion}).Return(getSuggestionReply, nil)
 	getEarlyStopRulesFail := rpcClientEarlyStopping.EXPECT().GetEarlyStoppingRules(gomock.Any(), gomock.Any()).Return(nil, errors.New("Suggestion service connection error"))
 
+	validRunGetSuggestions3 := rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), k8sMatcher{expectedRequestSuggestion}).Return(getSuggestionReply, nil)
+	invalidEarlyStopRules := rpcClientEarlyStopping.EXPECT().GetEarlyStoppingRules(gomock.Any(), gomock.Any()).Return(
+		&suggestionapi.GetEarlyStoppingRulesReply{
+			EarlyStoppingRules: []*suggestionapi.EarlyStoppingRule{
+				{
+					Name:       "unknown-metric",
+					Value:      "0.7",
+					Comparison: suggestionapi.ComparisonType_LESS,
+				},
+			},
+		}, nil)
+
 	gomock.InOrder(
 		validRunGetSuggestions,
 		validRunGetEarlyStopRules,
@@ -190,6 +209,8 @@ func TestSyncAssignments(t *testing.T) {
 		invalidAssignmentsCount,
 		validRunGetSuggestions2,
 		getEarlyStopRulesFail,
+		validRunGetSuggestions3,
+		invalidEarlyStopRules,
 	)
 
 	tcs := []struct {
@@ -197,6 +218,7 @@ func TestSyncAssignments(t *testing.T) {
 		suggestion      *suggestionsv1beta1.Suggestion
 		trials          []trialsv1beta1.Trial
 		err             bool
+		wantErr         error
 		testDescription string
 	}{
 		// Experiment contains HP and NAS config just for the test purpose
@@ -242,6 +264,15 @@ func TestSyncAssignments(t *testing.T) {
 			err:             true,
 			testDescription: "Unable to execute GetEarlyStoppingRules",
 		},
+		// validRunGetSuggestions3 + invalidEarlyStopRules case
+		{
+			experiment:      newFakeExperiment(),
+			suggestion:      newFakeSuggestion(),
+			trials:          newFakeTrials(),
+			err:             true,
+			wantErr:         ErrInvalidEarlyStoppingRules,
+			testDescription: "GetEarlyStoppingRules returns invalid rules",
+		},
 	}
 	for _, tc := range tcs {
 		err := suggestionClient.SyncAssignments(tc.suggestion, tc.experiment, tc.trials)
@@ -249,6 +280,8 @@ func TestSyncAssignments(t *testing.T) {
 			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
 		} else if tc.err && err == nil {
 			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDescription)
+		} else if tc.wantErr != nil && !errors.Is(err, tc.wantErr) {
+			t.Errorf("Case: %v failed. Expected %v, got %v", tc.testDescription, tc.wantErr, err)
 		}
 	}
 }
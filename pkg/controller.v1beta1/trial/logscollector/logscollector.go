@@ -0,0 +1,464 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logscollector
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	common "github.com/kubeflow/katib/pkg/common/v1beta1"
+	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+	filemc "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/file-metricscollector"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
+)
+
+var log = logf.Log.WithName("trial-logs-collector")
+
+const (
+	// defaultPollInterval is the interval between the Trial job's pods lookups and the log stream reconnects.
+	defaultPollInterval = 5 * time.Second
+	// maxLogLineSize is the maximum size of the single log line.
+	maxLogLineSize = 1024 * 1024
+
+	// PodControllerUIDIndex is the field index of the pods by the UID of their controller,
+	// so the pods of the Trial job are listed from the cache.
+	PodControllerUIDIndex = "metadata.controllerUID"
+)
+
+// Collector collects the metrics of the Trials with the KubernetesLogs metrics collector.
+// The logs of the primary container are streamed from the Kubernetes pods/log API and parsed
+// with the StdOut metrics collector filters, so the Trial's pods don't need to be mutated.
+//
+// The collection always starts from the beginning of the logs. The metrics of every pod are reported
+// in batches which IDs are derived from the position in the pod logs, so the metrics which were reported
+// before, e.g. by the restarted controller, are registered by the DB manager only once.
+type Collector struct {
+	// clientset streams the pods logs.
+	clientset kubernetes.Interface
+	// reader gets the Trial job's pods from the manager's cache.
+	reader client.Reader
+	// newDBManagerClient is overridden in the tests.
+	newDBManagerClient func() (api_pb.DBManagerClient, io.Closer, error)
+	pollInterval       time.Duration
+
+	mu     sync.Mutex
+	trials map[types.NamespacedName]*trialCollection
+}
+
+// trialCollection is the metrics collection of the single Trial.
+type trialCollection struct {
+	trial    *trialsv1beta1.Trial
+	jobUID   types.UID
+	client   api_pb.DBManagerClient
+	conn     io.Closer
+	reporter *mccommon.MetricsReporter
+	// ctx is cancelled once the collection is stopped.
+	ctx context.Context
+	// cancel stops the log streams and the reporter.
+	cancel context.CancelFunc
+	// cancelPoll stops the lookup of the new pods once the Trial job is completed.
+	cancelPoll context.CancelFunc
+	// streams is the wait group of the pods log streams.
+	streams sync.WaitGroup
+
+	mu        sync.Mutex
+	pods      map[string]bool
+	finishing bool
+	// done is closed once the rest of the metrics are reported after the Trial job is completed.
+	done chan struct{}
+	err  error
+}
+
+// New creates a new Collector.
+// The reader must have the PodControllerUIDIndex index of the pods.
+func New(clientset kubernetes.Interface, reader client.Reader) *Collector {
+	return &Collector{
+		clientset:          clientset,
+		reader:             reader,
+		newDBManagerClient: newDBManagerClient,
+		pollInterval:       defaultPollInterval,
+		trials:             map[types.NamespacedName]*trialCollection{},
+	}
+}
+
+// IndexPodController returns the UID of the pod's controller for the PodControllerUIDIndex index.
+func IndexPodController(obj client.Object) []string {
+	owner := metav1.GetControllerOf(obj)
+	if owner == nil {
+		return nil
+	}
+	return []string{string(owner.UID)}
+}
+
+func newDBManagerClient() (api_pb.DBManagerClient, io.Closer, error) {
+	credsOpt, err := grpctls.DialOption(grpctls.ServerName)
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, err := grpc.Dial(common.GetDBManagerAddr(), credsOpt)
+	if err != nil {
+		return nil, nil, err
+	}
+	return api_pb.NewDBManagerClient(conn), conn, nil
+}
+
+// Collect starts the metrics collection from the logs of the pods controlled by the Trial job.
+// It's no-op if the metrics of the Trial are already collected.
+func (c *Collector) Collect(trial *trialsv1beta1.Trial, jobUID types.UID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := types.NamespacedName{Name: trial.Name, Namespace: trial.Namespace}
+	if tc, ok := c.trials[key]; ok {
+		if tc.jobUID == jobUID {
+			return nil
+		}
+		// Trial job was recreated.
+		tc.stop()
+		delete(c.trials, key)
+	}
+
+	client, conn, err := c.newDBManagerClient()
+	if err != nil {
+		return fmt.Errorf("Failed to connect to DB manager: %w", err)
+	}
+	opts := parserOptions(trial)
+	// The parser options are validated before the collection is started.
+	if _, err := filemc.NewLineParser(opts); err != nil {
+		conn.Close()
+		return err
+	}
+	reporter, err := mccommon.NewMetricsReporter(client, trial.Name, trial.Namespace, "", opts.Metrics, "")
+	if err != nil {
+		conn.Close()
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pollCtx, cancelPoll := context.WithCancel(ctx)
+	tc := &trialCollection{
+		trial:      trial.DeepCopy(),
+		jobUID:     jobUID,
+		client:     client,
+		conn:       conn,
+		reporter:   reporter,
+		ctx:        ctx,
+		cancel:     cancel,
+		cancelPoll: cancelPoll,
+		pods:       map[string]bool{},
+		done:       make(chan struct{}),
+	}
+	c.trials[key] = tc
+
+	tc.streams.Add(1)
+	go func() {
+		defer tc.streams.Done()
+		c.pollPods(pollCtx, tc)
+	}()
+	go reporter.Run(ctx, mccommon.DefaultFlushInterval)
+	log.Info("Metrics collection from the logs is started", "Trial", key, "Job UID", jobUID)
+	return nil
+}
+
+// Finish reports the rest of the metrics once the Trial job is succeeded.
+// It returns false if the logs of the Trial job's pods are still streamed.
+func (c *Collector) Finish(trial *trialsv1beta1.Trial) (bool, error) {
+	c.mu.Lock()
+	tc, ok := c.trials[types.NamespacedName{Name: trial.Name, Namespace: trial.Namespace}]
+	c.mu.Unlock()
+	if !ok {
+		return false, fmt.Errorf("Metrics collection of Trial %v/%v is not started", trial.Namespace, trial.Name)
+	}
+
+	tc.mu.Lock()
+	if !tc.finishing {
+		tc.finishing = true
+		tc.streams.Add(1)
+		go func() {
+			// Logs of the pods which were created after the last lookup are streamed as well.
+			c.syncPods(tc.ctx, tc)
+			tc.streams.Done()
+			tc.cancelPoll()
+			tc.streams.Wait()
+
+			ctx, cancel := context.WithTimeout(tc.ctx, mccommon.DefaultReportTimeout)
+			defer cancel()
+			err := tc.reporter.Close(ctx)
+			tc.mu.Lock()
+			tc.err = err
+			tc.mu.Unlock()
+			close(tc.done)
+		}()
+	}
+	tc.mu.Unlock()
+
+	select {
+	case <-tc.done:
+		tc.mu.Lock()
+		defer tc.mu.Unlock()
+		if tc.err != nil {
+			return false, fmt.Errorf("Failed to report metrics: %w", tc.err)
+		}
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// Stop stops the metrics collection of the Trial.
+// It's no-op if the metrics of the Trial are not collected.
+func (c *Collector) Stop(trial types.NamespacedName) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if tc, ok := c.trials[trial]; ok {
+		tc.stop()
+		delete(c.trials, trial)
+		log.Info("Metrics collection from the logs is stopped", "Trial", trial)
+	}
+}
+
+func (tc *trialCollection) stop() {
+	tc.cancel()
+	tc.conn.Close()
+}
+
+// pollPods streams the logs of the new Trial job's pods on every poll interval until the context is cancelled.
+func (c *Collector) pollPods(ctx context.Context, tc *trialCollection) {
+	for {
+		c.syncPods(ctx, tc)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(c.pollInterval):
+		}
+	}
+}
+
+// syncPods starts the log streams of the Trial job's pods which logs are not streamed yet.
+func (c *Collector) syncPods(ctx context.Context, tc *trialCollection) {
+	podList, err := ListJobPods(ctx, c.reader, tc.trial, tc.jobUID)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Error(err, "Failed to list Trial job's pods", "Trial", tc.trial.Name, "Namespace", tc.trial.Namespace)
+		}
+		return
+	}
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if tc.pods[pod.Name] {
+			continue
+		}
+		tc.pods[pod.Name] = true
+		tc.streams.Add(1)
+		go func(podName string) {
+			defer tc.streams.Done()
+			c.streamPodLogs(tc, podName)
+		}(pod.Name)
+	}
+}
+
+// ListJobPods returns the Trial job's pods with the Trial's primary pod labels.
+// The reader must have the PodControllerUIDIndex index of the pods.
+func ListJobPods(ctx context.Context, reader client.Reader, trial *trialsv1beta1.Trial, jobUID types.UID) (*corev1.PodList, error) {
+	podList := &corev1.PodList{}
+	listOptions := []client.ListOption{
+		client.InNamespace(trial.Namespace),
+		client.MatchingFields{PodControllerUIDIndex: string(jobUID)},
+	}
+	if trial.Spec.PrimaryPodLabels != nil {
+		listOptions = append(listOptions, client.MatchingLabels(trial.Spec.PrimaryPodLabels))
+	}
+	if err := reader.List(ctx, podList, listOptions...); err != nil {
+		return nil, err
+	}
+	return podList, nil
+}
+
+// streamPodLogs parses the logs of the pod's primary container until the pod is completed.
+// Stream is reconnected from the last log line once it's closed, e.g. when the container is restarted.
+func (c *Collector) streamPodLogs(tc *trialCollection, podName string) {
+	logger := log.WithValues("Trial", tc.trial.Name, "Namespace", tc.trial.Namespace, "Pod", podName)
+	parser, err := filemc.NewLineParser(parserOptions(tc.trial))
+	if err != nil {
+		logger.Error(err, "Failed to create metrics parser")
+		return
+	}
+	pos := &logPosition{}
+	for {
+		pod := &corev1.Pod{}
+		if err := c.reader.Get(tc.ctx, types.NamespacedName{Name: podName, Namespace: tc.trial.Namespace}, pod); err != nil {
+			if !apierrors.IsNotFound(err) && tc.ctx.Err() == nil {
+				logger.Error(err, "Failed to get pod")
+			}
+			return
+		}
+		completed := pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+		if status := getContainerStatus(pod, tc.trial.Spec.PrimaryContainerName); completed || isContainerStarted(status) {
+			// Logs of the restarted container are counted from the beginning.
+			if status != nil && int64(status.RestartCount) != pos.generation {
+				pos.generation = int64(status.RestartCount)
+				pos.lines = 0
+			}
+			// Metrics of the distributed training are reported with the worker which produced them.
+			worker := mccommon.GetWorkerName(pod.Labels)
+			if err := c.streamLogs(tc, parser, podName, worker, pos); err != nil && tc.ctx.Err() == nil {
+				logger.Info("Failed to stream pod logs", "err", err)
+			}
+		}
+		if completed {
+			return
+		}
+		select {
+		case <-tc.ctx.Done():
+			return
+		case <-time.After(c.pollInterval):
+		}
+	}
+}
+
+// logPosition is the position in the logs of the pod's primary container.
+type logPosition struct {
+	// generation is the restart count of the container which logs are parsed.
+	generation int64
+	// lines is the number of the parsed lines of the container.
+	lines int64
+	// last is the timestamp of the last parsed line.
+	last time.Time
+	// lastLines is the number of the parsed lines with the last timestamp.
+	lastLines int
+}
+
+// streamLogs parses the logs of the pod's primary container which are written after the position.
+func (c *Collector) streamLogs(tc *trialCollection, parser *filemc.LineParser, podName, worker string, pos *logPosition) error {
+	logOptions := &corev1.PodLogOptions{
+		Container:  tc.trial.Spec.PrimaryContainerName,
+		Follow:     true,
+		Timestamps: true,
+	}
+	if !pos.last.IsZero() {
+		// SinceTime has the second precision, so the lines which were already parsed are skipped by the timestamp.
+		logOptions.SinceTime = &metav1.Time{Time: pos.last}
+	}
+	stream, err := c.clientset.CoreV1().Pods(tc.trial.Namespace).GetLogs(podName, logOptions).Stream(tc.ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	return tc.parseLogs(stream, parser, podName, worker, pos)
+}
+
+// parseLogs adds the metrics from the logs which are written after the position to the reporter.
+// The lines are reported as the metrics source which is the pod name.
+// The metrics logs are reported with the worker name if it's not empty.
+// The position is updated to the last parsed log line.
+func (tc *trialCollection) parseLogs(logs io.Reader, parser *filemc.LineParser, podName, worker string, pos *logPosition) error {
+	scanner := bufio.NewScanner(logs)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLogLineSize)
+	// The reconnected stream starts before the last parsed line, so the lines are skipped until
+	// the lines with the last timestamp which were parsed before.
+	reconnected, skip := pos.last, pos.lastLines
+	for scanner.Scan() {
+		line := scanner.Text()
+		timestamp, message, ok := splitTimestamp(line)
+		if !ok {
+			continue
+		}
+		if timestamp.Before(reconnected) {
+			continue
+		}
+		if timestamp.Equal(reconnected) && skip > 0 {
+			skip--
+			continue
+		}
+		if timestamp.Equal(pos.last) {
+			pos.lastLines++
+		} else {
+			pos.last, pos.lastLines = timestamp, 1
+		}
+		pos.lines++
+		// The line is prefixed by the Kubernetes timestamp, which is used unless timestamp format is set.
+		if tc.trial.Spec.MetricsCollector.Source == nil || tc.trial.Spec.MetricsCollector.Source.Filter == nil ||
+			tc.trial.Spec.MetricsCollector.Source.Filter.TimestampFormat == "" {
+			message = line
+		}
+		mlogs, err := parser.Parse(message)
+		if err != nil {
+			continue
+		}
+		for _, mlog := range mlogs {
+			mlog.Worker = worker
+		}
+		tc.reporter.AddAt(podName, mccommon.Position{Generation: pos.generation, Offset: pos.lines}, mlogs...)
+	}
+	return scanner.Err()
+}
+
+// splitTimestamp splits the log line which is returned with the timestamps to the timestamp and the message.
+func splitTimestamp(line string) (time.Time, string, bool) {
+	rawTimestamp, message, _ := strings.Cut(line, " ")
+	timestamp, err := time.Parse(time.RFC3339Nano, rawTimestamp)
+	if err != nil {
+		return time.Time{}, "", false
+	}
+	return timestamp, message, true
+}
+
+// getContainerStatus returns the status of the pod's container or nil if the status is not reported yet.
+func getContainerStatus(pod *corev1.Pod, containerName string) *corev1.ContainerStatus {
+	for i := range pod.Status.ContainerStatuses {
+		if pod.Status.ContainerStatuses[i].Name == containerName {
+			return &pod.Status.ContainerStatuses[i]
+		}
+	}
+	return nil
+}
+
+// isContainerStarted returns true if the container is running or terminated.
+func isContainerStarted(status *corev1.ContainerStatus) bool {
+	return status != nil && (status.State.Running != nil || status.State.Terminated != nil)
+}
+
+// parserOptions returns the options of the metrics parser, which are equal to the StdOut metrics collector options.
+func parserOptions(trial *trialsv1beta1.Trial) filemc.ParserOptions {
+	opts := filemc.ParserOptions{
+		Metrics:    append([]string{trial.Spec.Objective.ObjectiveMetricName}, trial.Spec.Objective.AdditionalMetricNames...),
+		FileFormat: commonv1beta1.TextFormat,
+	}
+	if source := trial.Spec.MetricsCollector.Source; source != nil && source.Filter != nil {
+		opts.Filters = source.Filter.MetricsFormat
+		opts.TimestampFormat = source.Filter.TimestampFormat
+	}
+	return opts
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logscollector
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+	filemc "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/file-metricscollector"
+)
+
+type fakeDBManagerClient struct {
+	api_pb.DBManagerClient
+	mu      sync.Mutex
+	deleted bool
+	reports []string
+}
+
+func (c *fakeDBManagerClient) ReportObservationLog(ctx context.Context, in *api_pb.ReportObservationLogRequest, opts ...grpc.CallOption) (*api_pb.ReportObservationLogReply, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, mlog := range in.ObservationLog.MetricLogs {
+		report := mlog.TimeStamp + " " + mlog.Metric.Name + "=" + mlog.Metric.Value
+		if mlog.Worker != "" {
+			report += "@" + mlog.Worker
+		}
+		c.reports = append(c.reports, report)
+	}
+	return &api_pb.ReportObservationLogReply{}, nil
+}
+
+func (c *fakeDBManagerClient) DeleteObservationLog(ctx context.Context, in *api_pb.DeleteObservationLogRequest, opts ...grpc.CallOption) (*api_pb.DeleteObservationLogReply, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.deleted = true
+	return &api_pb.DeleteObservationLogReply{}, nil
+}
+
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
+
+func newTrial(filter *commonv1beta1.FilterSpec) *trialsv1beta1.Trial {
+	trial := &trialsv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-trial",
+			Namespace: "test",
+		},
+		Spec: trialsv1beta1.TrialSpec{
+			Objective: &commonv1beta1.ObjectiveSpec{
+				ObjectiveMetricName:   "loss",
+				AdditionalMetricNames: []string{"accuracy"},
+			},
+			MetricsCollector: commonv1beta1.MetricsCollectorSpec{
+				Collector: &commonv1beta1.CollectorSpec{
+					Kind: commonv1beta1.KubernetesLogsCollector,
+				},
+			},
+			PrimaryContainerName: "training-container",
+			PrimaryPodLabels:     map[string]string{"role": "master"},
+		},
+	}
+	if filter != nil {
+		trial.Spec.MetricsCollector.Source = &commonv1beta1.SourceSpec{Filter: filter}
+	}
+	return trial
+}
+
+func TestParseLogs(t *testing.T) {
+	testCases := map[string]struct {
+		filter      *commonv1beta1.FilterSpec
+		logs        string
+		worker      string
+		pos         logPosition
+		wantPos     logPosition
+		wantReports []string
+	}{
+		"Kubernetes timestamps": {
+			logs: strings.Join([]string{
+				"2024-01-01T00:00:01.000000001Z epoch 1 loss=0.5 accuracy=0.7",
+				"2024-01-01T00:00:01.000000001Z epoch 2 loss=0.4 accuracy=0.8",
+				"loss=0.1",
+				"2024-01-01T00:00:02Z training is running",
+				"2024-01-01T00:00:03Z loss=0.3",
+			}, "\n"),
+			wantPos: logPosition{lines: 4, last: time.Date(2024, 1, 1, 0, 0, 3, 0, time.UTC), lastLines: 1},
+			wantReports: []string{
+				"2024-01-01T00:00:01.000000001Z loss=0.5",
+				"2024-01-01T00:00:01.000000001Z accuracy=0.7",
+				"2024-01-01T00:00:01.000000001Z loss=0.4",
+				"2024-01-01T00:00:01.000000001Z accuracy=0.8",
+				"2024-01-01T00:00:03Z loss=0.3",
+			},
+		},
+		"Lines before reconnect position are skipped": {
+			logs: strings.Join([]string{
+				"2024-01-01T00:00:00Z loss=0.6",
+				"2024-01-01T00:00:01Z loss=0.5",
+				"2024-01-01T00:00:01Z loss=0.4",
+				"2024-01-01T00:00:02Z loss=0.3",
+			}, "\n"),
+			pos:     logPosition{lines: 2, last: time.Date(2024, 1, 1, 0, 0, 1, 0, time.UTC), lastLines: 1},
+			wantPos: logPosition{lines: 4, last: time.Date(2024, 1, 1, 0, 0, 2, 0, time.UTC), lastLines: 1},
+			wantReports: []string{
+				"2024-01-01T00:00:01Z loss=0.4",
+				"2024-01-01T00:00:02Z loss=0.3",
+			},
+		},
+		"Timestamp and metrics formats are set": {
+			filter: &commonv1beta1.FilterSpec{
+				MetricsFormat:   []string{`(\w+):\s*([\d.]+)`},
+				TimestampFormat: filemc.TimestampFormatPython,
+			},
+			logs:    "2024-01-01T00:00:01Z 2023-05-01 10:00:00,500 INFO loss: 0.3",
+			wantPos: logPosition{lines: 1, last: time.Date(2024, 1, 1, 0, 0, 1, 0, time.UTC), lastLines: 1},
+			wantReports: []string{
+				"2023-05-01T10:00:00.5Z loss=0.3",
+			},
+		},
+		"Metrics are reported with the worker": {
+			logs:    "2024-01-01T00:00:01Z loss=0.5",
+			worker:  "worker-1",
+			wantPos: logPosition{lines: 1, last: time.Date(2024, 1, 1, 0, 0, 1, 0, time.UTC), lastLines: 1},
+			wantReports: []string{
+				"2024-01-01T00:00:01Z loss=0.5@worker-1",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			trial := newTrial(tc.filter)
+			client := &fakeDBManagerClient{}
+			reporter, err := mccommon.NewMetricsReporter(client, trial.Name, trial.Namespace, "", []string{"loss", "accuracy"}, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			parser, err := filemc.NewLineParser(parserOptions(trial))
+			if err != nil {
+				t.Fatal(err)
+			}
+			collection := &trialCollection{trial: trial, reporter: reporter}
+			pos := tc.pos
+			if err = collection.parseLogs(strings.NewReader(tc.logs), parser, "test-pod", tc.worker, &pos); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantPos, pos, cmp.AllowUnexported(logPosition{})); diff != "" {
+				t.Errorf("Unexpected position (-want,+got):\n%s", diff)
+			}
+			if err := reporter.Flush(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.wantReports, client.reports); diff != "" {
+				t.Errorf("Unexpected reports (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCollector(t *testing.T) {
+	newPod := func(name string, ownerUID types.UID, podLabels map[string]string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test",
+				Labels:    podLabels,
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: "batch/v1",
+						Kind:       "Job",
+						Name:       "test-trial",
+						UID:        ownerUID,
+						Controller: &[]bool{true}[0],
+					},
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodSucceeded,
+			},
+		}
+	}
+	pods := []client.Object{
+		newPod("primary-pod", "job-uid", map[string]string{"role": "master"}),
+		newPod("worker-pod", "job-uid", map[string]string{"role": "worker"}),
+		newPod("other-pod", "other-uid", map[string]string{"role": "master"}),
+	}
+	reader := fakeclient.NewClientBuilder().
+		WithObjects(pods...).
+		WithIndex(&corev1.Pod{}, PodControllerUIDIndex, IndexPodController).
+		Build()
+	dbClient := &fakeDBManagerClient{}
+	c := New(fake.NewSimpleClientset(), reader)
+	c.pollInterval = 10 * time.Millisecond
+	c.newDBManagerClient = func() (api_pb.DBManagerClient, io.Closer, error) {
+		return dbClient, nopCloser{}, nil
+	}
+
+	trial := newTrial(nil)
+	if _, err := c.Finish(trial); err == nil {
+		t.Errorf("Expected error when collection is not started")
+	}
+	if err := c.Collect(trial, "job-uid"); err != nil {
+		t.Fatal(err)
+	}
+	var reported bool
+	for start := time.Now(); !reported && time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		var err error
+		if reported, err = c.Finish(trial); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !reported {
+		t.Fatalf("Metrics are not reported")
+	}
+
+	key := types.NamespacedName{Name: trial.Name, Namespace: trial.Namespace}
+	if diff := cmp.Diff(map[string]bool{"primary-pod": true}, c.trials[key].pods); diff != "" {
+		t.Errorf("Unexpected streamed pods (-want,+got):\n%s", diff)
+	}
+	// The observation logs which were reported before are kept.
+	if dbClient.deleted {
+		t.Errorf("Previous observation logs are deleted")
+	}
+	// Fake logs don't have metrics, so the unavailable objective metric is reported.
+	if diff := cmp.Diff([]string{"0001-01-01T00:00:00Z loss=unavailable"}, dbClient.reports); diff != "" {
+		t.Errorf("Unexpected reports (-want,+got):\n%s", diff)
+	}
+
+	c.Stop(key)
+	if _, ok := c.trials[key]; ok {
+		t.Errorf("Collection is not stopped")
+	}
+}
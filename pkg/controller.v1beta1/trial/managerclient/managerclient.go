@@ -17,6 +17,7 @@ limitations under the License.
 package managerclient
 
 import (
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	common "github.com/kubeflow/katib/pkg/common/v1beta1"
@@ -24,8 +25,8 @@ import (
 
 // ManagerClient is the interface for katib manager client in trial controller.
 type ManagerClient interface {
-	GetTrialObservationLog(
-		instance *trialsv1beta1.Trial) (*api_pb.GetObservationLogReply, error)
+	GetTrialObservationSummary(
+		instance *trialsv1beta1.Trial) (*api_pb.GetObservationSummaryReply, error)
 	DeleteTrialObservationLog(
 		instance *trialsv1beta1.Trial) (*api_pb.DeleteObservationLogReply, error)
 	ReportTrialObservationLog(
@@ -42,48 +43,40 @@ func New() ManagerClient {
 	return &DefaultClient{}
 }
 
-func (d *DefaultClient) GetTrialObservationLog(
-	instance *trialsv1beta1.Trial) (*api_pb.GetObservationLogReply, error) {
-	// read GetObservationLog call and update observation field
-	objectiveMetricName := instance.Spec.Objective.ObjectiveMetricName
-	request := &api_pb.GetObservationLogRequest{
-		TrialName:  instance.Name,
-		MetricName: objectiveMetricName,
+func (d *DefaultClient) GetTrialObservationSummary(
+	instance *trialsv1beta1.Trial) (*api_pb.GetObservationSummaryReply, error) {
+	// summarize objective metric and additional metrics if exist
+	metricNames := append([]string{instance.Spec.Objective.ObjectiveMetricName},
+		instance.Spec.Objective.AdditionalMetricNames...)
+	request := &api_pb.GetObservationSummaryRequest{
+		TrialName:         instance.Name,
+		Namespace:         instance.Namespace,
+		MetricNames:       metricNames,
+		WorkerAggregation: convertWorkerAggregation(instance.Spec.Objective.WorkerAggregation),
 	}
-	reply, err := common.GetObservationLog(request)
-	if err != nil {
-		return nil, err
-	}
-	if reply.ObservationLog == nil {
-		reply.ObservationLog = &api_pb.ObservationLog{}
-	}
-	if reply.ObservationLog.MetricLogs == nil {
-		reply.ObservationLog.MetricLogs = []*api_pb.MetricLog{}
-	}
-	// fetch additional metrics if exists
-	metricLogs := reply.ObservationLog.MetricLogs
-	for _, metricName := range instance.Spec.Objective.AdditionalMetricNames {
-		request := &api_pb.GetObservationLogRequest{
-			TrialName: instance.Name, MetricName: metricName,
-		}
-		reply, err := common.GetObservationLog(request)
-		if err != nil {
-			return nil, err
-		}
-		if reply.ObservationLog == nil || reply.ObservationLog.MetricLogs == nil {
-			continue
-		}
-		metricLogs = append(metricLogs, reply.ObservationLog.MetricLogs...)
-	}
-	reply.ObservationLog.MetricLogs = metricLogs
+	return common.GetObservationSummary(request)
+}
 
-	return reply, nil
+// convertWorkerAggregation converts the worker aggregation to the DB manager API type.
+// Metrics of the rank 0 worker are used if the aggregation is not set.
+func convertWorkerAggregation(aggregation commonv1beta1.WorkerAggregationType) api_pb.WorkerAggregation {
+	switch aggregation {
+	case commonv1beta1.WorkerAggregationMean:
+		return api_pb.WorkerAggregation_MEAN
+	case commonv1beta1.WorkerAggregationMin:
+		return api_pb.WorkerAggregation_MIN
+	case commonv1beta1.WorkerAggregationMax:
+		return api_pb.WorkerAggregation_MAX
+	default:
+		return api_pb.WorkerAggregation_RANK0
+	}
 }
 
 func (d *DefaultClient) DeleteTrialObservationLog(
 	instance *trialsv1beta1.Trial) (*api_pb.DeleteObservationLogReply, error) {
 	request := &api_pb.DeleteObservationLogRequest{
 		TrialName: instance.Name,
+		Namespace: instance.Namespace,
 	}
 	reply, err := common.DeleteObservationLog(request)
 	if err != nil {
@@ -97,6 +90,7 @@ func (d *DefaultClient) ReportTrialObservationLog(
 	observationLog *api_pb.ObservationLog) (*api_pb.ReportObservationLogReply, error) {
 	request := &api_pb.ReportObservationLogRequest{
 		TrialName:      instance.Name,
+		Namespace:      instance.Namespace,
 		ObservationLog: observationLog,
 	}
 	reply, err := common.ReportObservationLog(request)
@@ -32,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -46,6 +47,7 @@ import (
 	commonapiv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/trial/logscollector"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/trial/managerclient"
 	trialutil "github.com/kubeflow/katib/pkg/controller.v1beta1/trial/util"
 )
@@ -61,25 +63,36 @@ var (
 	errMetricsNotReported = fmt.Errorf("metrics are not reported yet")
 	// errReportMetricsFailed is the error when `unavailable` metrics value can't be inserted to the Katib DB.
 	errReportMetricsFailed = fmt.Errorf("failed to report unavailable metrics")
+	// errJobRetryPending is the error when the failed Trial job is deleted or waits for the retry backoff.
+	errJobRetryPending = fmt.Errorf("trial job retry is pending")
 )
 
 // Add creates a new Trial Controller and adds it to the Manager with default RBAC. The Manager will set fields on the Controller
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager) error {
-	return add(mgr, newReconciler(mgr))
+	r, err := newReconciler(mgr)
+	if err != nil {
+		return err
+	}
+	return add(mgr, r)
 }
 
 // newReconciler returns a new reconcile.Reconciler
-func newReconciler(mgr manager.Manager) reconcile.Reconciler {
+func newReconciler(mgr manager.Manager) (reconcile.Reconciler, error) {
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, err
+	}
 	r := &ReconcileTrial{
 		Client:        mgr.GetClient(),
 		scheme:        mgr.GetScheme(),
 		ManagerClient: managerclient.New(),
 		recorder:      mgr.GetEventRecorderFor(ControllerName),
 		collector:     trialutil.NewTrialsCollector(mgr.GetCache(), metrics.Registry),
+		logsCollector: logscollector.New(clientset, mgr.GetClient()),
 	}
 	r.updateStatusHandler = r.updateStatus
-	return r
+	return r, nil
 }
 
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
@@ -91,6 +104,12 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 		return err
 	}
 
+	// The Trial job's pods are listed from the cache by their controller.
+	if err = mgr.GetFieldIndexer().IndexField(context.Background(), &corev1.Pod{}, logscollector.PodControllerUIDIndex, logscollector.IndexPodController); err != nil {
+		log.Error(err, "Pod index error")
+		return err
+	}
+
 	// Watch for changes in Trial
 	if err = c.Watch(source.Kind(mgr.GetCache(), &trialsv1beta1.Trial{}), &handler.EnqueueRequestForObject{}); err != nil {
 		log.Error(err, "Trial watch error")
@@ -142,12 +161,16 @@ type ReconcileTrial struct {
 	updateStatusHandler updateStatusFunc
 	// collector is a wrapper for experiment metrics.
 	collector *trialutil.TrialsCollector
+	// logsCollector collects the metrics from the Trial's pods logs for the KubernetesLogs metrics collector.
+	logsCollector *logscollector.Collector
 }
 
 // Reconcile reads that state of the cluster for a Trial object and makes changes based on the state read
 // and what is in the Trial.Spec
 // +kubebuilder:rbac:groups=trials.kubeflow.org,resources=trials,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=trials.kubeflow.org,resources=trials/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=pods/log,verbs=get
 func (r *ReconcileTrial) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	// Fetch the Trial instance
 	logger := log.WithValues("Trial", request.NamespacedName)
@@ -157,6 +180,7 @@ func (r *ReconcileTrial) Reconcile(ctx context.Context, request reconcile.Reques
 		if apierrors.IsNotFound(err) {
 			// Object not found, return.  Created objects are automatically garbage collected.
 			// For additional cleanup logic use finalizers.
+			r.logsCollector.Stop(request.NamespacedName)
 			return reconcile.Result{}, nil
 		}
 		// Error reading the object - requeue the request.
@@ -188,6 +212,12 @@ func (r *ReconcileTrial) Reconcile(ctx context.Context, request reconcile.Reques
 					RequeueAfter: time.Second * 1,
 				}, nil
 			}
+			// Retried Job is created once the retry backoff is elapsed or the failed Job is deleted.
+			if errors.Is(err, errJobRetryPending) {
+				return reconcile.Result{
+					RequeueAfter: max(trialutil.GetRetryBackoff(instance, time.Now()), time.Second),
+				}, nil
+			}
 			logger.Error(err, "Reconcile trial error")
 			r.recorder.Eventf(instance,
 				corev1.EventTypeWarning, consts.ReconcileErrorReason,
@@ -222,10 +252,17 @@ func (r *ReconcileTrial) reconcileTrial(instance *trialsv1beta1.Trial) error {
 
 	deployedJob, err := r.reconcileJob(instance, desiredJob)
 	if err != nil {
-		logger.Error(err, "Reconcile job error")
+		if !errors.Is(err, errJobRetryPending) {
+			logger.Error(err, "Reconcile job error")
+		}
 		return err
 	}
 
+	// Metrics are not collected from the logs once the Trial is completed.
+	if instance.IsCompleted() {
+		r.logsCollector.Stop(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+	}
+
 	// Job already exists.
 	// If Trial is EarlyStopped we need to verify/update observation logs.
 	// In that case, Trial's job will be deleted even if metrics are not available.
@@ -235,11 +272,37 @@ func (r *ReconcileTrial) reconcileTrial(instance *trialsv1beta1.Trial) error {
 			logger.Error(err, "GetDeployedJobStatus error")
 		}
 
+		// For the KubernetesLogs metrics collector, metrics are collected by the controller.
+		// We need to requeue reconcile until the rest of the metrics are reported when the Trial job is succeeded.
+		if instance.Spec.MetricsCollector.Collector.Kind == commonapiv1beta1.KubernetesLogsCollector {
+			reported, err := r.collectLogsMetrics(instance, deployedJob, jobStatus)
+			if err != nil {
+				logger.Error(err, "Collect metrics from logs error")
+				return err
+			}
+			if !reported {
+				logger.Info("Trial job is succeeded but metrics are still collected from logs, reconcile requeued")
+				return errMetricsNotReported
+			}
+		}
+
 		// Not needed to update status if jobStatus is nil.
 		if jobStatus == nil {
 			return nil
 		}
 
+		// If Job is failed with the retryable failure, Job is deleted to be created again.
+		if jobStatus.Condition == trialutil.JobFailed && !instance.IsCompleted() {
+			retried, err := r.retryJob(instance, deployedJob, jobStatus)
+			if err != nil {
+				logger.Error(err, "Retry job error")
+				return err
+			}
+			if retried {
+				return nil
+			}
+		}
+
 		// If Job status is succeeded or Trial is early stopped, update Trial observation.
 		if jobStatus.Condition == trialutil.JobSucceeded || instance.IsEarlyStopped() {
 			if err = r.UpdateTrialStatusObservation(instance); err != nil {
@@ -282,6 +345,10 @@ func (r *ReconcileTrial) reconcileJob(instance *trialsv1beta1.Trial, desiredJob
 			if instance.IsCompleted() {
 				return nil, nil
 			}
+			// Retried Job is created after the retry backoff.
+			if trialutil.GetRetryBackoff(instance, time.Now()) > 0 {
+				return nil, errJobRetryPending
+			}
 
 			logger.Info("Creating Job", "kind", kind,
 				"name", desiredJob.GetName())
@@ -301,7 +368,18 @@ func (r *ReconcileTrial) reconcileJob(instance *trialsv1beta1.Trial, desiredJob
 			return nil, err
 		}
 	} else {
-		if instance.IsCompleted() && !instance.Spec.RetainRun {
+		// Retried Job must be deleted before it is created again.
+		if !instance.IsCompleted() && deployedJob.GetDeletionTimestamp() != nil {
+			return nil, errJobRetryPending
+		}
+		deleteJob := instance.IsCompleted() && !instance.Spec.RetainRun
+		if instance.IsCompleted() && instance.Spec.RetainRun {
+			if deleteJob, err = r.needDeleteEarlyStoppedJob(instance, deployedJob); err != nil {
+				logger.Error(err, "Check early stopped job error")
+				return nil, err
+			}
+		}
+		if deleteJob {
 			if err = r.Delete(context.TODO(), desiredJob, client.PropagationPolicy(metav1.DeletePropagationForeground)); err != nil {
 				logger.Error(err, "Delete job error")
 				return nil, err
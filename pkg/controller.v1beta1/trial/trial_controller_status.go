@@ -29,6 +29,7 @@ const (
 	TrialSucceededReason          = "TrialSucceeded"
 	TrialMetricsUnavailableReason = "MetricsUnavailable"
 	TrialFailedReason             = "TrialFailed"
+	TrialInfeasibleReason         = "TrialInfeasible"
 
 	// For Jobs
 	JobCreatedReason            = "JobCreated"
@@ -37,6 +38,7 @@ const (
 	JobMetricsUnavailableReason = "MetricsUnavailable"
 	JobFailedReason             = "JobFailed"
 	JobRunningReason            = "JobRunning"
+	JobRetryingReason           = "JobRetrying"
 )
 
 type updateStatusFunc func(instance *trialsv1beta1.Trial) error
@@ -29,18 +29,25 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	crfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/yaml"
 
+	configv1beta1 "github.com/kubeflow/katib/pkg/apis/config/v1beta1"
 	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/trial/logscollector"
 	trialutil "github.com/kubeflow/katib/pkg/controller.v1beta1/trial/util"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
 	managerclientmock "github.com/kubeflow/katib/pkg/mock/v1beta1/trial/managerclient"
@@ -54,37 +61,24 @@ const (
 )
 
 var (
-	batchJobKey             = types.NamespacedName{Name: batchJobName, Namespace: namespace}
-	observationLogAvailable = &api_pb.GetObservationLogReply{
-		ObservationLog: &api_pb.ObservationLog{
-			MetricLogs: []*api_pb.MetricLog{
-				{
-					TimeStamp: "2020-08-10T14:47:38+08:00",
-					Metric: &api_pb.Metric{
-						Name:  objectiveMetric,
-						Value: "0.99",
-					},
-				},
-				{
-					TimeStamp: "2020-08-10T14:50:38+08:00",
-					Metric: &api_pb.Metric{
-						Name:  objectiveMetric,
-						Value: "0.11",
-					},
-				},
+	batchJobKey                 = types.NamespacedName{Name: batchJobName, Namespace: namespace}
+	observationSummaryAvailable = &api_pb.GetObservationSummaryReply{
+		MetricSummaries: []*api_pb.MetricSummary{
+			{
+				Name:   objectiveMetric,
+				Min:    "0.11",
+				Max:    "0.99",
+				Latest: "0.11",
+				Count:  2,
 			},
 		},
 	}
-	observationLogUnavailable = &api_pb.GetObservationLogReply{
-		ObservationLog: &api_pb.ObservationLog{
-			MetricLogs: []*api_pb.MetricLog{
-				{
-					Metric: &api_pb.Metric{
-						Name:  objectiveMetric,
-						Value: consts.UnavailableMetricValue,
-					},
-					TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
-				},
+	observationSummaryUnavailable = &api_pb.GetObservationSummaryReply{
+		MetricSummaries: []*api_pb.MetricSummary{
+			{
+				Name:   objectiveMetric,
+				Latest: consts.UnavailableMetricValue,
+				Count:  1,
 			},
 		},
 	}
@@ -138,6 +132,7 @@ func TestReconcileBatchJob(t *testing.T) {
 		ManagerClient: mockManagerClient,
 		recorder:      mgr.GetEventRecorderFor(ControllerName),
 		collector:     trialutil.NewTrialsCollector(mgr.GetCache(), prometheus.NewRegistry()),
+		logsCollector: logscollector.New(fake.NewSimpleClientset(), mgr.GetClient()),
 	}
 
 	r.updateStatusHandler = func(instance *trialsv1beta1.Trial) error {
@@ -239,7 +234,7 @@ func TestReconcileBatchJob(t *testing.T) {
 	t.Run(`Trial with "Complete" BatchJob and Available metrics.`, func(t *testing.T) {
 		g := gomega.NewGomegaWithT(t)
 		gomock.InOrder(
-			mockManagerClient.EXPECT().GetTrialObservationLog(gomock.Any()).Return(observationLogAvailable, nil).MinTimes(1),
+			mockManagerClient.EXPECT().GetTrialObservationSummary(gomock.Any()).Return(observationSummaryAvailable, nil).MinTimes(1),
 			mockManagerClient.EXPECT().DeleteTrialObservationLog(gomock.Any()).Return(nil, nil),
 		)
 		batchJob := &batchv1.Job{}
@@ -265,7 +260,7 @@ func TestReconcileBatchJob(t *testing.T) {
 		g.Expect(c.Create(ctx, trial)).NotTo(gomega.HaveOccurred())
 
 		// Expect that Trial status is succeeded and metrics are properly populated
-		// Metrics available because GetTrialObservationLog returns values
+		// Metrics available because GetTrialObservationSummary returns values
 		start := time.Now()
 		g.Eventually(func() bool {
 			if err = c.Get(ctx, trialKey, trial); err != nil {
@@ -291,7 +286,7 @@ func TestReconcileBatchJob(t *testing.T) {
 	t.Run(`Trial with "Complete" BatchJob and Unavailable metrics(StdOut MC).`, func(t *testing.T) {
 		g := gomega.NewGomegaWithT(t)
 		gomock.InOrder(
-			mockManagerClient.EXPECT().GetTrialObservationLog(gomock.Any()).Return(observationLogUnavailable, nil).MinTimes(1),
+			mockManagerClient.EXPECT().GetTrialObservationSummary(gomock.Any()).Return(observationSummaryUnavailable, nil).MinTimes(1),
 			mockManagerClient.EXPECT().DeleteTrialObservationLog(gomock.Any()).Return(nil, nil),
 		)
 		// Create the Trial with StdOut MC
@@ -300,7 +295,7 @@ func TestReconcileBatchJob(t *testing.T) {
 		g.Expect(c.Create(ctx, trial)).NotTo(gomega.HaveOccurred())
 
 		// Expect that Trial status is succeeded with "false" status and "metrics unavailable" reason.
-		// Metrics unavailable because GetTrialObservationLog returns "unavailable".
+		// Metrics unavailable because GetTrialObservationSummary returns "unavailable".
 		g.Eventually(func(g gomega.Gomega) {
 			g.Expect(c.Get(ctx, trialKey, trial)).Should(gomega.Succeed())
 			g.Expect(trial.IsMetricsUnavailable()).Should(gomega.BeTrue())
@@ -326,13 +321,13 @@ func TestReconcileBatchJob(t *testing.T) {
 		mockCtrl.Finish()
 		g := gomega.NewGomegaWithT(t)
 		gomock.InOrder(
-			mockManagerClient.EXPECT().GetTrialObservationLog(gomock.Any()).Return(observationLogUnavailable, nil),
+			mockManagerClient.EXPECT().GetTrialObservationSummary(gomock.Any()).Return(observationSummaryUnavailable, nil),
 			mockManagerClient.EXPECT().ReportTrialObservationLog(gomock.Any(), gomock.Any()).Return(nil, errReportMetricsFailed),
-			mockManagerClient.EXPECT().GetTrialObservationLog(gomock.Any()).Return(observationLogUnavailable, nil),
+			mockManagerClient.EXPECT().GetTrialObservationSummary(gomock.Any()).Return(observationSummaryUnavailable, nil),
 			mockManagerClient.EXPECT().ReportTrialObservationLog(gomock.Any(), gomock.Any()).Return(nil, nil),
 			mockManagerClient.EXPECT().DeleteTrialObservationLog(gomock.Any()).Return(nil, nil),
 		)
-		mockManagerClient.EXPECT().GetTrialObservationLog(gomock.Any()).Return(observationLogUnavailable, nil).AnyTimes()
+		mockManagerClient.EXPECT().GetTrialObservationSummary(gomock.Any()).Return(observationSummaryUnavailable, nil).AnyTimes()
 		mockManagerClient.EXPECT().ReportTrialObservationLog(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 
 		// Create the Trial with Push MC
@@ -341,7 +336,7 @@ func TestReconcileBatchJob(t *testing.T) {
 		g.Expect(c.Create(ctx, trial)).NotTo(gomega.HaveOccurred())
 
 		// Expect that Trial status is succeeded with "false" status and "metrics unavailable" reason.
-		// Metrics unavailable because GetTrialObservationLog returns "unavailable".
+		// Metrics unavailable because GetTrialObservationSummary returns "unavailable".
 		g.Eventually(func(g gomega.Gomega) {
 			g.Expect(c.Get(ctx, trialKey, trial)).Should(gomega.Succeed())
 			g.Expect(trial.IsMetricsUnavailable()).Should(gomega.BeTrue())
@@ -369,62 +364,197 @@ func TestReconcileBatchJob(t *testing.T) {
 	})
 }
 
-func TestGetObjectiveMetricValue(t *testing.T) {
+func TestGetMetrics(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
-	metricLogs := []*api_pb.MetricLog{
-		{TimeStamp: "2020-04-13T14:47:38+08:00", Metric: &api_pb.Metric{Name: "error", Value: "0.03"}},
-		{TimeStamp: "2020-04-13T14:47:39+08:00", Metric: &api_pb.Metric{Name: "error", Value: "0.02"}},
-		{TimeStamp: "2020-04-13T14:47:40+08:00", Metric: &api_pb.Metric{Name: "error", Value: "0.01"}},
-		{TimeStamp: "2020-04-13T14:47:41+08:00", Metric: &api_pb.Metric{Name: "error", Value: "0.05"}},
-		{TimeStamp: "2020-04-13T14:47:41+08:00", Metric: &api_pb.Metric{Name: "error", Value: "0.06"}},
-		{TimeStamp: "2020-04-13T14:47:41+08:00", Metric: &api_pb.Metric{Name: "error", Value: "0.07"}},
-		{TimeStamp: "2020-04-12T14:47:42+08:00", Metric: &api_pb.Metric{Name: "error", Value: "0.1"}},
-		{TimeStamp: "2020-04-13T14:47:38+08:00", Metric: &api_pb.Metric{Name: objectiveMetric, Value: "0.7"}},
-		{TimeStamp: "2020-04-13T14:47:39+08:00", Metric: &api_pb.Metric{Name: objectiveMetric, Value: "0.71"}},
-		{TimeStamp: "2020-04-13T14:47:40+08:00", Metric: &api_pb.Metric{Name: objectiveMetric, Value: "0.72"}},
-		{TimeStamp: "2020-04-13T14:47:41+08:00", Metric: &api_pb.Metric{Name: objectiveMetric, Value: "0.68"}},
-		{TimeStamp: "2020-04-13T14:47:41+08:00", Metric: &api_pb.Metric{Name: objectiveMetric, Value: "0.69"}},
-		{TimeStamp: "2020-04-13T14:47:41+08:00", Metric: &api_pb.Metric{Name: objectiveMetric, Value: "0.67"}},
-		{TimeStamp: "2020-04-12T14:47:42+08:00", Metric: &api_pb.Metric{Name: objectiveMetric, Value: "0.6"}},
+	metricSummaries := []*api_pb.MetricSummary{
+		{Name: "error", Min: "0.01", Max: "0.1", Latest: "0.07", Count: 7},
+		{Name: objectiveMetric, Latest: consts.UnavailableMetricValue, Count: 1},
+		{Name: "not-accuracy", Min: "1.15", Max: "1.15", Latest: "1.15", Count: 1},
+	}
+	metricStrategies := []commonv1beta1.MetricStrategy{
+		{Name: "error", Value: commonv1beta1.ExtractByMin},
+		{Name: objectiveMetric, Value: commonv1beta1.ExtractByMax},
+		{Name: "loss", Value: commonv1beta1.ExtractByMin},
 	}
 
-	getMetricsFromLogs := func(strategies []commonv1beta1.MetricStrategy) (*commonv1beta1.Metric, *commonv1beta1.Metric, error) {
-		observation, err := getMetrics(metricLogs, strategies)
-		if err != nil {
-			return nil, nil, err
+	observation := getMetrics(metricSummaries, metricStrategies)
+	g.Expect(observation.Metrics).To(gomega.Equal([]commonv1beta1.Metric{
+		{
+			Name:   "error",
+			Min:    "0.01",
+			Max:    "0.1",
+			Latest: "0.07",
+		},
+		{
+			Name:   objectiveMetric,
+			Min:    consts.UnavailableMetricValue,
+			Max:    consts.UnavailableMetricValue,
+			Latest: consts.UnavailableMetricValue,
+		},
+		{
+			Name:   "loss",
+			Min:    consts.UnavailableMetricValue,
+			Max:    consts.UnavailableMetricValue,
+			Latest: consts.UnavailableMetricValue,
+		},
+	}))
+}
+
+func TestUpdateTrialStatusObservation(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockManagerClient := managerclientmock.NewMockManagerClient(mockCtrl)
+
+	newSummary := func(latency string) *api_pb.GetObservationSummaryReply {
+		return &api_pb.GetObservationSummaryReply{
+			MetricSummaries: []*api_pb.MetricSummary{
+				{Name: objectiveMetric, Min: "0.5", Max: "0.9", Latest: "0.9", Count: 2},
+				{Name: "latency", Min: latency, Max: latency, Latest: latency, Count: 2},
+			},
 		}
-		var errMetric, accMetric *commonv1beta1.Metric
-		for index, metric := range observation.Metrics {
-			if metric.Name == "error" {
-				errMetric = &observation.Metrics[index]
-			} else if metric.Name == objectiveMetric {
-				accMetric = &observation.Metrics[index]
+	}
+	cases := map[string]struct {
+		trialConditionType trialsv1beta1.TrialConditionType
+		summary            *api_pb.GetObservationSummaryReply
+		wantInfeasible     bool
+	}{
+		"Early stopped Trial violates constraints": {
+			trialConditionType: trialsv1beta1.TrialEarlyStopped,
+			summary:            newSummary("30"),
+			wantInfeasible:     true,
+		},
+		"Early stopped Trial satisfies constraints": {
+			trialConditionType: trialsv1beta1.TrialEarlyStopped,
+			summary:            newSummary("10"),
+		},
+		"Running Trial violates constraints": {
+			trialConditionType: trialsv1beta1.TrialRunning,
+			summary:            newSummary("30"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mockManagerClient.EXPECT().GetTrialObservationSummary(gomock.Any()).Return(tc.summary, nil)
+			recorder := record.NewFakeRecorder(1)
+			r := &ReconcileTrial{
+				ManagerClient: mockManagerClient,
+				recorder:      recorder,
 			}
-		}
-		return errMetric, accMetric, nil
+			trial := newFakeTrialBatchJob(commonv1beta1.StdOutCollector, "test-constraints")
+			trial.Spec.Objective.MetricStrategies = append(trial.Spec.Objective.MetricStrategies,
+				commonv1beta1.MetricStrategy{Name: "latency", Value: commonv1beta1.ExtractByLatest})
+			trial.Spec.Objective.Constraints = []commonv1beta1.ObjectiveConstraint{
+				{MetricName: "latency", Comparison: commonv1beta1.ComparisonTypeLess, Bound: 20},
+			}
+			trial.Status.Conditions = []trialsv1beta1.TrialCondition{
+				{Type: tc.trialConditionType, Status: corev1.ConditionTrue},
+			}
+
+			if err := r.UpdateTrialStatusObservation(trial); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if trial.IsInfeasible() != tc.wantInfeasible {
+				t.Errorf("Unexpected Trial infeasible status, want: %v, got: %v", tc.wantInfeasible, trial.IsInfeasible())
+			}
+			if gotEvent := len(recorder.Events) != 0; gotEvent != tc.wantInfeasible {
+				t.Errorf("Unexpected %s event, want: %v, got: %v", TrialInfeasibleReason, tc.wantInfeasible, gotEvent)
+			}
+		})
 	}
+}
 
-	metricStrategies := []commonv1beta1.MetricStrategy{
-		{Name: "error", Value: commonv1beta1.ExtractByMin},
-		{Name: objectiveMetric, Value: commonv1beta1.ExtractByMax},
+func TestNeedDeleteEarlyStoppedJob(t *testing.T) {
+	runningJob := &unstructured.Unstructured{}
+	runningJob.SetName(batchJobName)
+	succeededJob, _ := util.ConvertObjectToUnstructured(&batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: batchJobName},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+			},
+		},
+	})
+
+	cases := map[string]struct {
+		trialConditionType trialsv1beta1.TrialConditionType
+		mcKind             commonv1beta1.CollectorKind
+		injectionMode      configv1beta1.InjectionMode
+		deployedJob        *unstructured.Unstructured
+		want               bool
+	}{
+		"Running job of early stopped Trial with native sidecar": {
+			trialConditionType: trialsv1beta1.TrialEarlyStopped,
+			mcKind:             commonv1beta1.FileCollector,
+			injectionMode:      configv1beta1.NativeSidecarInjectionMode,
+			deployedJob:        runningJob,
+			want:               true,
+		},
+		"Running job of early stopped Trial with sidecar container": {
+			trialConditionType: trialsv1beta1.TrialEarlyStopped,
+			mcKind:             commonv1beta1.FileCollector,
+			injectionMode:      configv1beta1.ContainerInjectionMode,
+			deployedJob:        runningJob,
+			want:               false,
+		},
+		"Succeeded job of early stopped Trial with native sidecar": {
+			trialConditionType: trialsv1beta1.TrialEarlyStopped,
+			mcKind:             commonv1beta1.FileCollector,
+			injectionMode:      configv1beta1.NativeSidecarInjectionMode,
+			deployedJob:        succeededJob,
+			want:               false,
+		},
+		"Running job of succeeded Trial with native sidecar": {
+			trialConditionType: trialsv1beta1.TrialSucceeded,
+			mcKind:             commonv1beta1.FileCollector,
+			injectionMode:      configv1beta1.NativeSidecarInjectionMode,
+			deployedJob:        runningJob,
+			want:               false,
+		},
+		"Running job of early stopped Trial with Push MC": {
+			trialConditionType: trialsv1beta1.TrialEarlyStopped,
+			mcKind:             commonv1beta1.PushCollector,
+			deployedJob:        runningJob,
+			want:               false,
+		},
 	}
-	errMetric, accMetric, err := getMetricsFromLogs(metricStrategies)
-	g.Expect(err).ShouldNot(gomega.HaveOccurred())
-	g.Expect(errMetric.Latest).To(gomega.Equal("0.07"))
-	g.Expect(errMetric.Max).To(gomega.Equal("0.1"))
-	g.Expect(errMetric.Min).To(gomega.Equal("0.01"))
-	g.Expect(accMetric.Latest).To(gomega.Equal("0.67"))
-	g.Expect(accMetric.Max).To(gomega.Equal("0.72"))
-	g.Expect(accMetric.Min).To(gomega.Equal("0.6"))
-
-	invalidLogs := []*api_pb.MetricLog{
-		// Add one other metric to test correct parsing
-		{TimeStamp: "2020-08-10T14:47:42+08:00", Metric: &api_pb.Metric{Name: "not-accuracy", Value: "1.15"}},
-		// Add metric with invalid timestamp
-		{TimeStamp: "2020-08-10T14:47:42", Metric: &api_pb.Metric{Name: objectiveMetric, Value: "0.77"}},
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			katibConfig, err := yaml.Marshal(&configv1beta1.KatibConfig{
+				RuntimeConfig: configv1beta1.RuntimeConfig{
+					MetricsCollectorConfigs: []configv1beta1.MetricsCollectorConfig{
+						{
+							CollectorKind: string(commonv1beta1.FileCollector),
+							Image:         "metrics-collector-image",
+							InjectionMode: tc.injectionMode,
+						},
+					},
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := &ReconcileTrial{
+				Client: crfake.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      consts.KatibConfigMapName,
+						Namespace: consts.DefaultKatibNamespace,
+					},
+					Data: map[string]string{consts.LabelKatibConfigTag: string(katibConfig)},
+				}).Build(),
+			}
+			trial := newFakeTrialBatchJob(tc.mcKind, "test-early-stopped")
+			trial.Status.Conditions = []trialsv1beta1.TrialCondition{
+				{Type: tc.trialConditionType, Status: corev1.ConditionTrue},
+			}
+			got, err := r.needDeleteEarlyStoppedJob(trial, tc.deployedJob)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("Unexpected needDeleteEarlyStoppedJob, want: %v, got: %v", tc.want, got)
+			}
+		})
 	}
-	_, err = getMetrics(invalidLogs, metricStrategies)
-	g.Expect(err).To(gomega.HaveOccurred())
 }
 
 func newFakeTrialBatchJob(mcType commonv1beta1.CollectorKind, trialName string) *trialsv1beta1.Trial {
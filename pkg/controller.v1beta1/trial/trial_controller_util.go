@@ -19,19 +19,26 @@ package trial
 import (
 	"context"
 	"fmt"
-	"strconv"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	configv1beta1 "github.com/kubeflow/katib/pkg/apis/config/v1beta1"
 	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/trial/logscollector"
 	trialutil "github.com/kubeflow/katib/pkg/controller.v1beta1/trial/util"
+	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
 )
 
 const (
@@ -58,6 +65,8 @@ func (r *ReconcileTrial) UpdateTrialStatusCondition(instance *trialsv1beta1.Tria
 					reason = fmt.Sprintf("%v. Job reason: %v", reason, jobStatus.Reason)
 				}
 
+				r.markTrialInfeasible(instance)
+
 				logger.Info("Trial status changed to Succeeded")
 				instance.MarkTrialStatusSucceeded(corev1.ConditionTrue, reason, msg)
 				instance.Status.CompletionTime = &timeNow
@@ -132,28 +141,42 @@ func (r *ReconcileTrial) UpdateTrialStatusCondition(instance *trialsv1beta1.Tria
 }
 
 func (r *ReconcileTrial) UpdateTrialStatusObservation(instance *trialsv1beta1.Trial) error {
-	reply, err := r.GetTrialObservationLog(instance)
+	reply, err := r.GetTrialObservationSummary(instance)
 	if err != nil {
-		log.Error(err, "Get trial observation log error")
+		log.Error(err, "Get trial observation summary error")
 		return err
 	}
 	metricStrategies := instance.Spec.Objective.MetricStrategies
-	if len(reply.ObservationLog.MetricLogs) != 0 {
-		observation, err := getMetrics(reply.ObservationLog.MetricLogs, metricStrategies)
-		if err != nil {
-			log.Error(err, "Get metrics from logs error")
-			return err
-		}
-		instance.Status.Observation = observation
+	if len(reply.MetricSummaries) != 0 {
+		instance.Status.Observation = getMetrics(reply.MetricSummaries, metricStrategies)
+	}
+	// Observation of the early stopped Trial is final, since the metrics are reported before the Trial is early stopped.
+	if instance.IsEarlyStopped() && instance.IsObservationAvailable() {
+		r.markTrialInfeasible(instance)
 	}
 	return nil
 }
 
+// markTrialInfeasible marks the Trial as infeasible if its final observation violates the objective constraints.
+func (r *ReconcileTrial) markTrialInfeasible(instance *trialsv1beta1.Trial) {
+	violated := instance.GetViolatedConstraints()
+	if len(violated) == 0 || instance.IsInfeasible() {
+		return
+	}
+	instance.Status.Infeasible = true
+	eventMsg := fmt.Sprintf("Trial violates the objective constraints: %v", formatConstraints(violated))
+	r.recorder.Eventf(instance, corev1.EventTypeWarning, TrialInfeasibleReason, eventMsg)
+}
+
 func (r *ReconcileTrial) updateFinalizers(instance *trialsv1beta1.Trial, finalizers []string) (reconcile.Result, error) {
 	isDelete := true
 	if !instance.ObjectMeta.DeletionTimestamp.IsZero() {
-		if _, err := r.DeleteTrialObservationLog(instance); err != nil {
-			return reconcile.Result{}, err
+		r.logsCollector.Stop(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+		// Logs of the Trial killed by the Experiment suspend are kept for the Trial created again on resume.
+		if instance.GetAnnotations()[consts.AnnotationKeepObservationLogs] != "true" {
+			if _, err := r.DeleteTrialObservationLog(instance); err != nil {
+				return reconcile.Result{}, err
+			}
 		}
 	} else {
 		isDelete = false
@@ -172,6 +195,101 @@ func (r *ReconcileTrial) updateFinalizers(instance *trialsv1beta1.Trial, finaliz
 	}
 }
 
+// collectLogsMetrics collects the metrics from the logs of the Trial job's pods.
+// It returns false if the Trial job is succeeded but the rest of the metrics are not reported yet.
+func (r *ReconcileTrial) collectLogsMetrics(instance *trialsv1beta1.Trial, deployedJob *unstructured.Unstructured, jobStatus *trialutil.TrialJobStatus) (bool, error) {
+	if err := r.logsCollector.Collect(instance, deployedJob.GetUID()); err != nil {
+		return false, err
+	}
+	if jobStatus == nil || jobStatus.Condition != trialutil.JobSucceeded {
+		return true, nil
+	}
+	return r.logsCollector.Finish(instance)
+}
+
+// retryJob deletes the failed Trial job if the failure is retryable according to the Trial retry policy.
+// The Job is created again with the same parameter assignments once the retry backoff is elapsed.
+func (r *ReconcileTrial) retryJob(instance *trialsv1beta1.Trial, deployedJob *unstructured.Unstructured, jobStatus *trialutil.TrialJobStatus) (bool, error) {
+	logger := log.WithValues("Trial", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+	policy := instance.Spec.RetryPolicy
+	if policy == nil {
+		return false, nil
+	}
+
+	// The attempt is saved before the Job and its metrics are deleted, so the retry is counted only once
+	// even if the deletion fails. The saved attempt of the Job has the Job creation time.
+	startTime := deployedJob.GetCreationTimestamp()
+	attempts := instance.Status.Attempts
+	if len(attempts) == 0 || attempts[len(attempts)-1].StartTime == nil || !attempts[len(attempts)-1].StartTime.Equal(&startTime) {
+		if int32(len(attempts)) >= policy.MaxRetries {
+			return false, nil
+		}
+		pods, err := logscollector.ListJobPods(context.TODO(), r.Client, instance, deployedJob.GetUID())
+		if err != nil {
+			return false, err
+		}
+		reasons := append([]string{jobStatus.Reason}, trialutil.GetPodFailureReasons(pods.Items)...)
+		reason, retryable := trialutil.GetRetryableReason(policy, reasons)
+		if !retryable {
+			return false, nil
+		}
+
+		now := metav1.Now()
+		instance.Status.Attempts = append(instance.Status.Attempts, trialsv1beta1.TrialAttempt{
+			StartTime:      &startTime,
+			CompletionTime: &now,
+			Reason:         reason,
+			Message:        jobStatus.Message,
+		})
+		if err = r.updateStatusHandler(instance); err != nil {
+			return false, err
+		}
+
+		eventMsg := fmt.Sprintf("Job %v has failed with reason %q, retry %v of %v",
+			deployedJob.GetName(), reason, len(instance.Status.Attempts), policy.MaxRetries)
+		r.recorder.Eventf(instance, corev1.EventTypeWarning, JobRetryingReason, eventMsg)
+		logger.Info("Trial job is retried", "reason", reason, "retry", len(instance.Status.Attempts))
+	}
+
+	if err := r.Delete(context.TODO(), deployedJob, client.PropagationPolicy(metav1.DeletePropagationForeground)); err != nil && !apierrors.IsNotFound(err) {
+		return false, err
+	}
+	// Metrics of the failed attempt must not be used for the Trial observation.
+	r.logsCollector.Stop(types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+	if _, err := r.DeleteTrialObservationLog(instance); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// needDeleteEarlyStoppedJob returns true if the early stopped Trial's job is still running
+// and the metrics collector is injected as the native sidecar.
+// Native sidecar can't terminate the training process, so the job is deleted even if the Trial retains the run.
+func (r *ReconcileTrial) needDeleteEarlyStoppedJob(instance *trialsv1beta1.Trial, deployedJob *unstructured.Unstructured) (bool, error) {
+	if !instance.IsEarlyStopped() {
+		return false, nil
+	}
+	jobStatus, err := trialutil.GetDeployedJobStatus(instance, deployedJob)
+	if err != nil {
+		return false, err
+	}
+	if jobStatus != nil && jobStatus.Condition != trialutil.JobRunning {
+		return false, nil
+	}
+	mcKind := instance.Spec.MetricsCollector.Collector.Kind
+	for _, mc := range mccommon.AutoInjectMetricsCollectorList {
+		if mcKind != mc {
+			continue
+		}
+		metricsCollectorConfigData, err := katibconfig.GetMetricsCollectorConfigData(mcKind, r.Client)
+		if err != nil {
+			return false, err
+		}
+		return metricsCollectorConfigData.InjectionMode == configv1beta1.NativeSidecarInjectionMode, nil
+	}
+	return false, nil
+}
+
 func (r *ReconcileTrial) reportUnavailableMetrics(instance *trialsv1beta1.Trial) error {
 	observationLog := &api_pb.ObservationLog{
 		MetricLogs: []*api_pb.MetricLog{
@@ -189,58 +307,42 @@ func (r *ReconcileTrial) reportUnavailableMetrics(instance *trialsv1beta1.Trial)
 	return err
 }
 
-func getMetrics(metricLogs []*api_pb.MetricLog, strategies []commonv1beta1.MetricStrategy) (*commonv1beta1.Observation, error) {
-	metrics := make(map[string]*commonv1beta1.Metric)
-	timestamps := make(map[string]*time.Time)
+func getMetrics(metricSummaries []*api_pb.MetricSummary, strategies []commonv1beta1.MetricStrategy) *commonv1beta1.Observation {
+	summaries := make(map[string]*api_pb.MetricSummary)
+	for _, summary := range metricSummaries {
+		summaries[summary.Name] = summary
+	}
+
+	observation := &commonv1beta1.Observation{}
 	for _, strategy := range strategies {
-		timestamps[strategy.Name] = nil
-		metrics[strategy.Name] = &commonv1beta1.Metric{
+		metric := commonv1beta1.Metric{
 			Name:   strategy.Name,
 			Min:    consts.UnavailableMetricValue,
 			Max:    consts.UnavailableMetricValue,
 			Latest: consts.UnavailableMetricValue,
 		}
-	}
-
-	for _, metricLog := range metricLogs {
-		metric, ok := metrics[metricLog.Metric.Name]
-		if !ok {
-			continue
-		}
-		strValue := metricLog.Metric.Value
-		floatValue, err := strconv.ParseFloat(strValue, 64)
-		if err == nil {
-			if metric.Min == consts.UnavailableMetricValue {
-				metric.Min = strValue
-				metric.Max = strValue
-			} else {
-				// We can't get error here, because we parsed this value before
-				minMetric, _ := strconv.ParseFloat(metric.Min, 64)
-				maxMetric, _ := strconv.ParseFloat(metric.Max, 64)
-				if floatValue < minMetric {
-					metric.Min = strValue
-				} else if floatValue > maxMetric {
-					metric.Max = strValue
-				}
+		if summary, ok := summaries[strategy.Name]; ok {
+			// Min and max are empty if the metric doesn't have numeric values.
+			if summary.Min != "" {
+				metric.Min = summary.Min
 			}
+			if summary.Max != "" {
+				metric.Max = summary.Max
+			}
+			metric.Latest = summary.Latest
 		}
-		currentTime, err := time.Parse(time.RFC3339Nano, metricLog.TimeStamp)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse timestamps %s: %e", metricLog.TimeStamp, err)
-		}
-		timestamp := timestamps[metricLog.Metric.Name]
-		if timestamp == nil || !timestamp.After(currentTime) {
-			timestamps[metricLog.Metric.Name] = &currentTime
-			metric.Latest = strValue
-		}
+		observation.Metrics = append(observation.Metrics, metric)
 	}
 
-	observation := &commonv1beta1.Observation{}
-	for _, metric := range metrics {
-		observation.Metrics = append(observation.Metrics, *metric)
-	}
+	return observation
+}
 
-	return observation, nil
+func formatConstraints(constraints []commonv1beta1.ObjectiveConstraint) string {
+	formatted := make([]string, 0, len(constraints))
+	for _, c := range constraints {
+		formatted = append(formatted, fmt.Sprintf("%v %v than %v", c.MetricName, c.Comparison, c.Bound))
+	}
+	return strings.Join(formatted, ", ")
 }
 
 func needUpdateFinalizers(trial *trialsv1beta1.Trial) (bool, []string) {
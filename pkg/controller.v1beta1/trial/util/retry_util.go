@@ -0,0 +1,107 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+// GetPodFailureReasons returns the failure reasons from the status of the Trial job's pods,
+// e.g. Evicted, OOMKilled, ErrImagePull or PreemptionByScheduler.
+func GetPodFailureReasons(pods []corev1.Pod) []string {
+	var reasons []string
+	seen := map[string]bool{}
+	add := func(reason string) {
+		if reason != "" && !seen[reason] {
+			seen[reason] = true
+			reasons = append(reasons, reason)
+		}
+	}
+
+	for _, pod := range pods {
+		add(pod.Status.Reason)
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == corev1.DisruptionTarget && cond.Status == corev1.ConditionTrue {
+				add(cond.Reason)
+			}
+		}
+		statuses := append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...)
+		statuses = append(statuses, pod.Status.ContainerStatuses...)
+		for _, status := range statuses {
+			for _, state := range []corev1.ContainerState{status.State, status.LastTerminationState} {
+				if state.Terminated != nil && state.Terminated.ExitCode != 0 {
+					add(state.Terminated.Reason)
+				}
+			}
+			if status.State.Waiting != nil {
+				add(status.State.Waiting.Reason)
+			}
+		}
+	}
+	return reasons
+}
+
+// GetRetryableReason returns the first failure reason which is retryable by the retry policy.
+// If the retry policy doesn't define retryable reasons, all failures are retryable.
+func GetRetryableReason(policy *commonv1beta1.RetryPolicy, reasons []string) (string, bool) {
+	if policy == nil {
+		return "", false
+	}
+	if len(policy.RetryableReasons) == 0 {
+		for _, reason := range reasons {
+			if reason != "" {
+				return reason, true
+			}
+		}
+		return "", true
+	}
+	for _, reason := range reasons {
+		for _, retryable := range policy.RetryableReasons {
+			if reason == retryable {
+				return reason, true
+			}
+		}
+	}
+	return "", false
+}
+
+// GetRetryBackoff returns the remaining time before the retried Trial job can be created.
+func GetRetryBackoff(trial *trialsv1beta1.Trial, now time.Time) time.Duration {
+	attempts := len(trial.Status.Attempts)
+	if trial.Spec.RetryPolicy == nil || trial.Spec.RetryPolicy.BackoffSeconds <= 0 || attempts == 0 {
+		return 0
+	}
+	last := trial.Status.Attempts[attempts-1]
+	if last.CompletionTime == nil {
+		return 0
+	}
+	// The doubled delay is limited by MaxRetryBackoff unless the retry backoff is longer.
+	backoff := time.Duration(trial.Spec.RetryPolicy.BackoffSeconds) * time.Second
+	for i := 1; i < attempts && backoff < consts.MaxRetryBackoff; i++ {
+		backoff = min(backoff*2, consts.MaxRetryBackoff)
+	}
+	if wait := last.CompletionTime.Add(backoff).Sub(now); wait > 0 {
+		return wait
+	}
+	return 0
+}
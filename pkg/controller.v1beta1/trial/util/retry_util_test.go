@@ -0,0 +1,213 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+func TestGetPodFailureReasons(t *testing.T) {
+	cases := map[string]struct {
+		pods        []corev1.Pod
+		wantReasons []string
+	}{
+		"Pod is evicted": {
+			pods: []corev1.Pod{
+				{
+					Status: corev1.PodStatus{
+						Reason: "Evicted",
+						Conditions: []corev1.PodCondition{
+							{
+								Type:   corev1.DisruptionTarget,
+								Status: corev1.ConditionTrue,
+								Reason: "TerminationByKubelet",
+							},
+						},
+					},
+				},
+			},
+			wantReasons: []string{"Evicted", "TerminationByKubelet"},
+		},
+		"Containers are OOM killed and image pull is failed": {
+			pods: []corev1.Pod{
+				{
+					Status: corev1.PodStatus{
+						ContainerStatuses: []corev1.ContainerStatus{
+							{
+								State: corev1.ContainerState{
+									Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"},
+								},
+							},
+						},
+					},
+				},
+				{
+					Status: corev1.PodStatus{
+						InitContainerStatuses: []corev1.ContainerStatus{
+							{
+								State: corev1.ContainerState{
+									Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull"},
+								},
+								LastTerminationState: corev1.ContainerState{
+									Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantReasons: []string{"OOMKilled", "ErrImagePull"},
+		},
+		"Completed containers are ignored": {
+			pods: []corev1.Pod{
+				{
+					Status: corev1.PodStatus{
+						ContainerStatuses: []corev1.ContainerStatus{
+							{
+								State: corev1.ContainerState{
+									Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"},
+								},
+							},
+						},
+						Conditions: []corev1.PodCondition{
+							{
+								Type:   corev1.DisruptionTarget,
+								Status: corev1.ConditionFalse,
+								Reason: "PreemptionByScheduler",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetPodFailureReasons(tc.pods)
+			if diff := cmp.Diff(tc.wantReasons, got); len(diff) != 0 {
+				t.Errorf("Unexpected reasons from GetPodFailureReasons() (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetRetryableReason(t *testing.T) {
+	cases := map[string]struct {
+		policy        *commonv1beta1.RetryPolicy
+		reasons       []string
+		wantReason    string
+		wantRetryable bool
+	}{
+		"Retry policy is not set": {
+			reasons: []string{"OOMKilled"},
+		},
+		"All failures are retryable": {
+			policy:        &commonv1beta1.RetryPolicy{MaxRetries: 1},
+			reasons:       []string{"", "Error"},
+			wantReason:    "Error",
+			wantRetryable: true,
+		},
+		"Failure reason is retryable": {
+			policy: &commonv1beta1.RetryPolicy{
+				MaxRetries:       1,
+				RetryableReasons: []string{"Evicted", "OOMKilled"},
+			},
+			reasons:       []string{"BackoffLimitExceeded", "OOMKilled"},
+			wantReason:    "OOMKilled",
+			wantRetryable: true,
+		},
+		"Failure reason is not retryable": {
+			policy: &commonv1beta1.RetryPolicy{
+				MaxRetries:       1,
+				RetryableReasons: []string{"Evicted"},
+			},
+			reasons: []string{"BackoffLimitExceeded", "Error"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			reason, retryable := GetRetryableReason(tc.policy, tc.reasons)
+			if reason != tc.wantReason || retryable != tc.wantRetryable {
+				t.Errorf("Unexpected result from GetRetryableReason(), want: (%v, %v), got: (%v, %v)",
+					tc.wantReason, tc.wantRetryable, reason, retryable)
+			}
+		})
+	}
+}
+
+func TestGetRetryBackoff(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newTrial := func(backoffSeconds int32, failedAgo ...time.Duration) *trialsv1beta1.Trial {
+		trial := &trialsv1beta1.Trial{
+			Spec: trialsv1beta1.TrialSpec{
+				RetryPolicy: &commonv1beta1.RetryPolicy{MaxRetries: 3, BackoffSeconds: backoffSeconds},
+			},
+		}
+		for _, ago := range failedAgo {
+			completionTime := metav1.NewTime(now.Add(-ago))
+			trial.Status.Attempts = append(trial.Status.Attempts, trialsv1beta1.TrialAttempt{CompletionTime: &completionTime})
+		}
+		return trial
+	}
+
+	cases := map[string]struct {
+		trial       *trialsv1beta1.Trial
+		wantBackoff time.Duration
+	}{
+		"Trial job is not retried yet": {
+			trial: newTrial(10),
+		},
+		"Backoff is not set": {
+			trial: newTrial(0, time.Second),
+		},
+		"First retry is delayed": {
+			trial:       newTrial(10, 4*time.Second),
+			wantBackoff: 6 * time.Second,
+		},
+		"Delay is doubled for the second retry": {
+			trial:       newTrial(10, time.Minute, 4*time.Second),
+			wantBackoff: 16 * time.Second,
+		},
+		"Backoff is elapsed": {
+			trial: newTrial(10, time.Minute),
+		},
+		"Doubled delay is limited": {
+			trial:       newTrial(3600, make([]time.Duration, 100)...),
+			wantBackoff: consts.MaxRetryBackoff,
+		},
+		"Backoff longer than the limit is not doubled": {
+			trial:       newTrial(7200, 0, 0, 0),
+			wantBackoff: 2 * time.Hour,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := GetRetryBackoff(tc.trial, now); got != tc.wantBackoff {
+				t.Errorf("Unexpected backoff from GetRetryBackoff(), want: %v, got: %v", tc.wantBackoff, got)
+			}
+		})
+	}
+}
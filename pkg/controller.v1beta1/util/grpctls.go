@@ -0,0 +1,55 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
+)
+
+// GRPCCertVolume returns the volume with the certs for the Katib gRPC services.
+func GRPCCertVolume(secretName string) corev1.Volume {
+	return corev1.Volume{
+		Name: consts.GRPCCertVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: secretName,
+			},
+		},
+	}
+}
+
+// AddGRPCCertToContainer mounts the certs for the Katib gRPC services to the container
+// and sets the env variable which enables mutual TLS in Katib gRPC servers and clients.
+func AddGRPCCertToContainer(c *corev1.Container) {
+	for _, vm := range c.VolumeMounts {
+		if vm.Name == consts.GRPCCertVolumeName {
+			return
+		}
+	}
+	c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{
+		Name:      consts.GRPCCertVolumeName,
+		MountPath: consts.GRPCCertDir,
+		ReadOnly:  true,
+	})
+	c.Env = append(c.Env, corev1.EnvVar{
+		Name:  grpctls.CertDirEnvName,
+		Value: consts.GRPCCertDir,
+	})
+}
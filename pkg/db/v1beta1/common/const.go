@@ -49,5 +49,19 @@ const (
 	DefaultPostgreSQLPort     = "5432"
 	DefaultPostgreSSLMode     = "disable"
 
+	SQLiteDBNameEnvValue = "sqlite"
+
+	SQLiteDBPathEnvName = "KATIB_SQLITE_DB_PATH"
+
+	DefaultSQLiteDBPath = "/var/lib/katib/katib.db"
+
+	// SkipDbInitializationEnvName disables the schema migrations when the DB manager starts.
+	// The DB manager still checks the schema version and exits with an error if any migration
+	// is pending, so `katib-db-manager migrate up` must be run before the DB manager is upgraded.
 	SkipDbInitializationEnvName = "SKIP_DB_INITIALIZATION"
+
+	MigrationLockName = "katib_db_migration"
+
+	// NumericValueRegexp matches the metric values which are used to compute min and max.
+	NumericValueRegexp = `^[-+]?([0-9]+(\.[0-9]*)?|\.[0-9]+)([eE][-+]?[0-9]+)?$`
 )
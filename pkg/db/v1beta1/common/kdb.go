@@ -17,14 +17,47 @@ limitations under the License.
 package common
 
 import (
+	"errors"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
 	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 )
 
+// ErrObservationLogBatchExists is returned by RegisterObservationLog when the batch is already registered.
+var ErrObservationLogBatchExists = errors.New("observation log batch is already registered")
+
 type KatibDBInterface interface {
 	DBInit()
 	SelectOne() error
 
-	RegisterObservationLog(trialName string, observationLog *v1beta1.ObservationLog) error
-	GetObservationLog(trialName string, metricName string, startTime string, endTime string) (*v1beta1.ObservationLog, error)
-	DeleteObservationLog(trialName string) error
+	MigrateUp() error
+	MigrateDown(steps int) error
+	MigrationStatus() (*MigrationStatus, error)
+
+	// RegisterObservationLog stores the logs. If batchID is set, the logs of the batch are stored only once
+	// and ErrObservationLogBatchExists is returned when the batch is registered again.
+	RegisterObservationLog(namespace string, trialName string, batchID string, observationLog *v1beta1.ObservationLog) error
+	// GetObservationLog returns the logs matching the request and the token of the next page.
+	GetObservationLog(request *v1beta1.GetObservationLogRequest) (*v1beta1.ObservationLog, string, error)
+	DeleteObservationLog(namespace string, trialName string) error
+	GetObservationSummary(namespace string, trialName string, metricNames []string) ([]*v1beta1.MetricSummary, error)
+
+	// DownsampleObservationLog keeps the minimum, maximum and latest log of every metric in each bucket
+	// for the logs older than before, and returns the number of deleted logs.
+	// At most limit logs are deleted, so that a single statement doesn't lock the table for long.
+	DownsampleObservationLog(before time.Time, bucket time.Duration, limit int) (int64, error)
+	// ExpireObservationLog deletes the logs of the trials which haven't reported any log since before,
+	// and returns the number of deleted logs.
+	ExpireObservationLog(before time.Time) (int64, error)
+	// ListObservationLogTrials returns the trials which have logs.
+	ListObservationLogTrials() ([]types.NamespacedName, error)
+
+	// ListLegacyObservationLogTrials returns the names of the trials which have logs stored
+	// before the logs were scoped by namespace. These logs are not returned until their namespace is backfilled.
+	ListLegacyObservationLogTrials() ([]string, error)
+	// BackfillObservationLogNamespace sets the namespace of the legacy logs of the trial,
+	// and returns the number of updated logs.
+	BackfillObservationLogNamespace(namespace string, trialName string) (int64, error)
 }
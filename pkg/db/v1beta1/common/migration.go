@@ -0,0 +1,210 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"k8s.io/klog"
+)
+
+// Migration is a single versioned change of the Katib DB schema.
+// Up and Down run in the same transaction that records the schema version.
+// MySQL commits DDL statements implicitly, so a migration can be applied without its version
+// being recorded. Up and Down must be idempotent to be run again in this case.
+type Migration struct {
+	Version     int
+	Description string
+	Up          func(tx *sql.Tx) error
+	Down        func(tx *sql.Tx) error
+}
+
+// MigrationDialect contains the statements which differ between DB backends.
+type MigrationDialect struct {
+	// VersionTableExists counts the schema_version tables visible to the connection.
+	VersionTableExists string
+	CreateVersionTable string
+	// InsertVersion and DeleteVersion take the version as first parameter,
+	// InsertVersion takes the description as second parameter.
+	InsertVersion string
+	DeleteVersion string
+	// Lock and Unlock guard migrations against concurrent DB manager replicas.
+	// They are called on the connection used to run migrations and can be nil.
+	Lock   func(ctx context.Context, conn *sql.Conn) error
+	Unlock func(ctx context.Context, conn *sql.Conn) error
+}
+
+// MigrationStatus describes the schema version of the Katib DB.
+type MigrationStatus struct {
+	CurrentVersion int
+	LatestVersion  int
+	Pending        []Migration
+}
+
+// Migrator applies an ordered list of migrations to the Katib DB.
+type Migrator struct {
+	db         *sql.DB
+	dialect    MigrationDialect
+	migrations []Migration
+}
+
+// NewMigrator returns a Migrator for the migrations, which must be sorted by version.
+func NewMigrator(db *sql.DB, dialect MigrationDialect, migrations []Migration) *Migrator {
+	return &Migrator{
+		db:         db,
+		dialect:    dialect,
+		migrations: migrations,
+	}
+}
+
+// Up applies all pending migrations.
+func (m *Migrator) Up() error {
+	return m.withLock(func(ctx context.Context, conn *sql.Conn) error {
+		if _, err := conn.ExecContext(ctx, m.dialect.CreateVersionTable); err != nil {
+			return fmt.Errorf("Error creating schema_version table: %v", err)
+		}
+		current, err := m.currentVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			if migration.Version <= current {
+				continue
+			}
+			klog.Infof("Applying DB migration %d: %s", migration.Version, migration.Description)
+			if err = m.run(ctx, conn, migration.Up, m.dialect.InsertVersion, migration.Version, migration.Description); err != nil {
+				return fmt.Errorf("Error applying DB migration %d: %v", migration.Version, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Down reverts the given number of most recently applied migrations.
+func (m *Migrator) Down(steps int) error {
+	return m.withLock(func(ctx context.Context, conn *sql.Conn) error {
+		current, err := m.currentVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && steps > 0; i-- {
+			migration := m.migrations[i]
+			if migration.Version > current {
+				continue
+			}
+			klog.Infof("Reverting DB migration %d: %s", migration.Version, migration.Description)
+			if err = m.run(ctx, conn, migration.Down, m.dialect.DeleteVersion, migration.Version); err != nil {
+				return fmt.Errorf("Error reverting DB migration %d: %v", migration.Version, err)
+			}
+			steps--
+		}
+		return nil
+	})
+}
+
+// Status returns the current schema version and the pending migrations.
+// It doesn't modify the DB.
+func (m *Migrator) Status() (*MigrationStatus, error) {
+	ctx := context.Background()
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting DB connection: %v", err)
+	}
+	defer conn.Close()
+
+	current, err := m.currentVersion(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	status := &MigrationStatus{
+		CurrentVersion: current,
+	}
+	for _, migration := range m.migrations {
+		status.LatestVersion = migration.Version
+		if migration.Version > current {
+			status.Pending = append(status.Pending, migration)
+		}
+	}
+	return status, nil
+}
+
+func (m *Migrator) withLock(fn func(ctx context.Context, conn *sql.Conn) error) error {
+	ctx := context.Background()
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("Error getting DB connection: %v", err)
+	}
+	defer conn.Close()
+
+	if m.dialect.Lock != nil {
+		if err = m.dialect.Lock(ctx, conn); err != nil {
+			return fmt.Errorf("Error acquiring DB migration lock: %v", err)
+		}
+		defer func() {
+			if err := m.dialect.Unlock(ctx, conn); err != nil {
+				klog.Errorf("Error releasing DB migration lock: %v", err)
+			}
+		}()
+	}
+	return fn(ctx, conn)
+}
+
+// currentVersion returns 0 if the schema_version table doesn't exist yet.
+func (m *Migrator) currentVersion(ctx context.Context, conn *sql.Conn) (int, error) {
+	var tables int
+	if err := conn.QueryRowContext(ctx, m.dialect.VersionTableExists).Scan(&tables); err != nil {
+		return 0, fmt.Errorf("Error checking schema_version table: %v", err)
+	}
+	if tables == 0 {
+		return 0, nil
+	}
+	var version sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT MAX(version) FROM schema_version").Scan(&version); err != nil {
+		return 0, fmt.Errorf("Error getting schema version: %v", err)
+	}
+	return int(version.Int64), nil
+}
+
+func (m *Migrator) run(ctx context.Context, conn *sql.Conn, change func(tx *sql.Tx) error, versionStmt string, versionArgs ...interface{}) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err = change(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err = tx.ExecContext(ctx, versionStmt, versionArgs...); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// ExecMigration returns a migration step which executes the statements in order.
+func ExecMigration(statements ...string) func(tx *sql.Tx) error {
+	return func(tx *sql.Tx) error {
+		for _, statement := range statements {
+			if _, err := tx.Exec(statement); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EncodePageToken returns the token of the observation logs page which starts
+// after the log with the given time and id.
+func EncodePageToken(lastTime time.Time, lastID int64) string {
+	token := lastTime.UTC().Format(time.RFC3339Nano) + "," + strconv.FormatInt(lastID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(token))
+}
+
+// DecodePageToken returns the time and id of the last log of the previous page.
+func DecodePageToken(pageToken string) (time.Time, int64, error) {
+	token, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf("Invalid page token %s: %v", pageToken, err)
+	}
+	timeStr, idStr, found := strings.Cut(string(token), ",")
+	if !found {
+		return time.Time{}, 0, fmt.Errorf("Invalid page token %s", pageToken)
+	}
+	lastTime, err := time.Parse(time.RFC3339Nano, timeStr)
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf("Invalid page token %s: %v", pageToken, err)
+	}
+	lastID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf("Invalid page token %s: %v", pageToken, err)
+	}
+	return lastTime, lastID, nil
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPageToken(t *testing.T) {
+	lastTime := time.Date(2016, 12, 31, 20, 2, 5, 123456000, time.UTC)
+	token := EncodePageToken(lastTime, 42)
+
+	gotTime, gotID, err := DecodePageToken(token)
+	if err != nil {
+		t.Fatalf("DecodePageToken failed: %v", err)
+	}
+	if !gotTime.Equal(lastTime) || gotID != 42 {
+		t.Errorf("DecodePageToken returned %v, %d, want %v, 42", gotTime, gotID, lastTime)
+	}
+
+	for _, invalidToken := range []string{"not base64!", "bm8tY29tbWE", "MjAxNi0xMi0zMSx4"} {
+		if _, _, err := DecodePageToken(invalidToken); err == nil {
+			t.Errorf("Expected error for page token %q", invalidToken)
+		}
+	}
+}
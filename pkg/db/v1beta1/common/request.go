@@ -0,0 +1,74 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+// ObservationLogTrialNames returns the names of the Trials whose logs are requested.
+// It returns trial_name alone if trial_names is empty, so the request matches no logs without a Trial name.
+func ObservationLogTrialNames(request *v1beta1.GetObservationLogRequest) []string {
+	if len(request.TrialNames) == 0 {
+		return []string{request.TrialName}
+	}
+	return uniqueNames(request.TrialName, request.TrialNames)
+}
+
+// ObservationLogMetricNames returns the names of the requested metrics, or nil if all metrics are requested.
+func ObservationLogMetricNames(request *v1beta1.GetObservationLogRequest) []string {
+	if request.MetricName == "" && len(request.MetricNames) == 0 {
+		return nil
+	}
+	return uniqueNames(request.MetricName, request.MetricNames)
+}
+
+func uniqueNames(name string, names []string) []string {
+	result := make([]string, 0, len(names)+1)
+	seen := map[string]bool{}
+	for _, n := range append([]string{name}, names...) {
+		if n == "" || seen[n] {
+			continue
+		}
+		seen[n] = true
+		result = append(result, n)
+	}
+	return result
+}
+
+// MetricWorker is a worker which reported logs of a Trial metric.
+type MetricWorker struct {
+	TrialName  string
+	MetricName string
+	Worker     string
+}
+
+// Rank0Workers returns the rank 0 worker of every Trial metric, i.e. the first worker in the rank order which reported it.
+func Rank0Workers(workers []MetricWorker) []MetricWorker {
+	result := []MetricWorker{}
+	index := map[[2]string]int{}
+	for _, w := range workers {
+		key := [2]string{w.TrialName, w.MetricName}
+		if i, ok := index[key]; !ok {
+			index[key] = len(result)
+			result = append(result, w)
+		} else if lessWorker(w.Worker, result[i].Worker) {
+			result[i] = w
+		}
+	}
+	return result
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+func TestObservationLogNames(t *testing.T) {
+	cases := map[string]struct {
+		request         *v1beta1.GetObservationLogRequest
+		wantTrialNames  []string
+		wantMetricNames []string
+	}{
+		"single trial and all metrics": {
+			request:        &v1beta1.GetObservationLogRequest{TrialName: "trial1"},
+			wantTrialNames: []string{"trial1"},
+		},
+		"no trial": {
+			request:         &v1beta1.GetObservationLogRequest{MetricName: "loss"},
+			wantTrialNames:  []string{""},
+			wantMetricNames: []string{"loss"},
+		},
+		"trial and metric lists": {
+			request: &v1beta1.GetObservationLogRequest{
+				TrialName:   "trial1",
+				TrialNames:  []string{"trial2", "trial1", "trial3"},
+				MetricNames: []string{"loss", "accuracy", "loss"},
+			},
+			wantTrialNames:  []string{"trial1", "trial2", "trial3"},
+			wantMetricNames: []string{"loss", "accuracy"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.wantTrialNames, ObservationLogTrialNames(tc.request)); diff != "" {
+				t.Errorf("Unexpected trial names (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantMetricNames, ObservationLogMetricNames(tc.request)); diff != "" {
+				t.Errorf("Unexpected metric names (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRank0Workers(t *testing.T) {
+	workers := []MetricWorker{
+		{TrialName: "trial1", MetricName: "loss", Worker: ""},
+		{TrialName: "trial1", MetricName: "loss", Worker: "worker-1"},
+		{TrialName: "trial1", MetricName: "loss", Worker: "master-0"},
+		{TrialName: "trial1", MetricName: "accuracy", Worker: "worker-1"},
+		{TrialName: "trial2", MetricName: "loss", Worker: ""},
+	}
+	want := []MetricWorker{
+		{TrialName: "trial1", MetricName: "loss", Worker: "master-0"},
+		{TrialName: "trial1", MetricName: "accuracy", Worker: "worker-1"},
+		{TrialName: "trial2", MetricName: "loss", Worker: ""},
+	}
+	if diff := cmp.Diff(want, Rank0Workers(workers)); diff != "" {
+		t.Errorf("Unexpected rank 0 workers (-want,+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"database/sql"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+// primaryReplicaTypes are the replica types of the distributed training jobs in the rank order.
+// The replicas of the other types are ranked after them in the alphabetical order.
+var primaryReplicaTypes = []string{"master", "chief", "launcher", "worker"}
+
+var numericValue = regexp.MustCompile(NumericValueRegexp)
+
+// NewMetricSummary converts a summary row to MetricSummary.
+// Min and max are NULL if the metric has no numeric values.
+func NewMetricSummary(name string, worker string, latest string, count int64, min sql.NullFloat64, max sql.NullFloat64) *v1beta1.MetricSummary {
+	summary := &v1beta1.MetricSummary{
+		Name:   name,
+		Latest: latest,
+		Count:  count,
+		Worker: worker,
+	}
+	if min.Valid {
+		summary.Min = strconv.FormatFloat(min.Float64, 'f', -1, 64)
+	}
+	if max.Valid {
+		summary.Max = strconv.FormatFloat(max.Float64, 'f', -1, 64)
+	}
+	return summary
+}
+
+// AggregateMetricSummaries aggregates the worker summaries of every metric.
+// The summary of the single worker is not aggregated.
+// For the mean, min and max aggregations the non-numeric values are skipped,
+// and the latest value of the rank 0 worker is used if no worker has the numeric latest value.
+func AggregateMetricSummaries(summaries []*v1beta1.MetricSummary, aggregation v1beta1.WorkerAggregation) []*v1beta1.MetricSummary {
+	metricNames := []string{}
+	workerSummaries := map[string][]*v1beta1.MetricSummary{}
+	for _, summary := range summaries {
+		if _, ok := workerSummaries[summary.Name]; !ok {
+			metricNames = append(metricNames, summary.Name)
+		}
+		workerSummaries[summary.Name] = append(workerSummaries[summary.Name], summary)
+	}
+
+	result := make([]*v1beta1.MetricSummary, 0, len(metricNames))
+	for _, name := range metricNames {
+		metricSummaries := workerSummaries[name]
+		sort.SliceStable(metricSummaries, func(i, j int) bool {
+			return lessWorker(metricSummaries[i].Worker, metricSummaries[j].Worker)
+		})
+		if len(metricSummaries) == 1 || aggregation == v1beta1.WorkerAggregation_RANK0 {
+			result = append(result, metricSummaries[0])
+			continue
+		}
+		summary := &v1beta1.MetricSummary{
+			Name:   name,
+			Latest: aggregateValues(metricSummaries, aggregation, func(s *v1beta1.MetricSummary) string { return s.Latest }),
+			Min:    aggregateValues(metricSummaries, aggregation, func(s *v1beta1.MetricSummary) string { return s.Min }),
+			Max:    aggregateValues(metricSummaries, aggregation, func(s *v1beta1.MetricSummary) string { return s.Max }),
+		}
+		if summary.Latest == "" {
+			summary.Latest = metricSummaries[0].Latest
+		}
+		for _, s := range metricSummaries {
+			summary.Count += s.Count
+		}
+		result = append(result, summary)
+	}
+	return result
+}
+
+// aggregateValues returns the mean, min or max of the numeric values of the summaries.
+// It returns the empty value if no summary has the numeric value.
+func aggregateValues(summaries []*v1beta1.MetricSummary, aggregation v1beta1.WorkerAggregation, value func(*v1beta1.MetricSummary) string) string {
+	values := []float64{}
+	for _, s := range summaries {
+		v := value(s)
+		if !numericValue.MatchString(v) {
+			continue
+		}
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			values = append(values, f)
+		}
+	}
+	if len(values) == 0 {
+		return ""
+	}
+	result := values[0]
+	for _, v := range values[1:] {
+		switch aggregation {
+		case v1beta1.WorkerAggregation_MIN:
+			result = min(result, v)
+		case v1beta1.WorkerAggregation_MAX:
+			result = max(result, v)
+		default:
+			result += v
+		}
+	}
+	if aggregation == v1beta1.WorkerAggregation_MEAN {
+		result /= float64(len(values))
+	}
+	return strconv.FormatFloat(result, 'f', -1, 64)
+}
+
+// lessWorker reports whether worker a is ranked before worker b.
+// Workers are named <replica-type>-<replica-index>. The empty worker is ranked last, since the logs without a worker
+// of the distributed training, e.g. reported before the worker was recorded, must not take the place of rank 0.
+func lessWorker(a, b string) bool {
+	aType, aIndex, aName := workerRank(a)
+	bType, bIndex, bName := workerRank(b)
+	if aType != bType {
+		return aType < bType
+	}
+	if aIndex != bIndex {
+		return aIndex < bIndex
+	}
+	return aName < bName
+}
+
+// workerRank returns the rank of the worker replica type, the replica index and the replica type name.
+func workerRank(worker string) (int, int, string) {
+	if worker == "" {
+		return len(primaryReplicaTypes) + 1, 0, ""
+	}
+	replicaType, replicaIndex := worker, 0
+	if i := strings.LastIndex(worker, "-"); i != -1 {
+		if index, err := strconv.Atoi(worker[i+1:]); err == nil {
+			replicaType, replicaIndex = worker[:i], index
+		}
+	}
+	for rank, primaryType := range primaryReplicaTypes {
+		if replicaType == primaryType {
+			return rank, replicaIndex, replicaType
+		}
+	}
+	return len(primaryReplicaTypes), replicaIndex, replicaType
+}
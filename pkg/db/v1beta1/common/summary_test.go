@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+func TestAggregateMetricSummaries(t *testing.T) {
+	summaries := []*v1beta1.MetricSummary{
+		{Name: "accuracy", Min: "0.5", Max: "0.75", Latest: "0.8", Count: 3, Worker: "worker-1"},
+		{Name: "accuracy", Min: "0.4", Max: "1", Latest: "0.7", Count: 4, Worker: "master-0"},
+		{Name: "accuracy", Min: "0.6", Max: "0.5", Latest: "unavailable", Count: 2, Worker: "worker-0"},
+		{Name: "loss", Latest: "unavailable", Count: 1, Worker: "worker-0"},
+		{Name: "loss", Latest: "unavailable", Count: 1, Worker: "worker-1"},
+	}
+	cases := map[string]struct {
+		summaries   []*v1beta1.MetricSummary
+		aggregation v1beta1.WorkerAggregation
+		want        []*v1beta1.MetricSummary
+	}{
+		"single worker": {
+			summaries: []*v1beta1.MetricSummary{
+				{Name: "accuracy", Min: "0.5", Max: "0.8", Latest: "0.8", Count: 3},
+			},
+			aggregation: v1beta1.WorkerAggregation_MEAN,
+			want: []*v1beta1.MetricSummary{
+				{Name: "accuracy", Min: "0.5", Max: "0.8", Latest: "0.8", Count: 3},
+			},
+		},
+		"rank 0": {
+			summaries:   summaries,
+			aggregation: v1beta1.WorkerAggregation_RANK0,
+			want: []*v1beta1.MetricSummary{
+				{Name: "accuracy", Min: "0.4", Max: "1", Latest: "0.7", Count: 4, Worker: "master-0"},
+				{Name: "loss", Latest: "unavailable", Count: 1, Worker: "worker-0"},
+			},
+		},
+		"rank 0 with logs without worker": {
+			summaries: []*v1beta1.MetricSummary{
+				{Name: "accuracy", Min: "0.5", Max: "0.8", Latest: "0.8", Count: 3},
+				{Name: "accuracy", Min: "0.4", Max: "0.7", Latest: "0.7", Count: 2, Worker: "worker-0"},
+			},
+			aggregation: v1beta1.WorkerAggregation_RANK0,
+			want: []*v1beta1.MetricSummary{
+				{Name: "accuracy", Min: "0.4", Max: "0.7", Latest: "0.7", Count: 2, Worker: "worker-0"},
+			},
+		},
+		"mean": {
+			summaries:   summaries,
+			aggregation: v1beta1.WorkerAggregation_MEAN,
+			want: []*v1beta1.MetricSummary{
+				{Name: "accuracy", Min: "0.5", Max: "0.75", Latest: "0.75", Count: 9},
+				{Name: "loss", Latest: "unavailable", Count: 2},
+			},
+		},
+		"min": {
+			summaries:   summaries,
+			aggregation: v1beta1.WorkerAggregation_MIN,
+			want: []*v1beta1.MetricSummary{
+				{Name: "accuracy", Min: "0.4", Max: "0.5", Latest: "0.7", Count: 9},
+				{Name: "loss", Latest: "unavailable", Count: 2},
+			},
+		},
+		"max": {
+			summaries:   summaries,
+			aggregation: v1beta1.WorkerAggregation_MAX,
+			want: []*v1beta1.MetricSummary{
+				{Name: "accuracy", Min: "0.6", Max: "1", Latest: "0.8", Count: 9},
+				{Name: "loss", Latest: "unavailable", Count: 2},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := AggregateMetricSummaries(tc.summaries, tc.aggregation)
+			if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("Unexpected summaries (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLessWorker(t *testing.T) {
+	workers := []string{"master-0", "chief-0", "launcher", "worker-0", "worker-2", "worker-10", "evaluator-0", "ps-0", ""}
+	for i := range workers {
+		for j := range workers {
+			if got, want := lessWorker(workers[i], workers[j]), i < j; got != want {
+				t.Errorf("lessWorker(%q, %q) = %v, want %v", workers[i], workers[j], got, want)
+			}
+		}
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
 	"github.com/kubeflow/katib/pkg/db/v1beta1/mysql"
 	"github.com/kubeflow/katib/pkg/db/v1beta1/postgres"
+	"github.com/kubeflow/katib/pkg/db/v1beta1/sqlite"
 	"k8s.io/klog"
 )
 
@@ -34,6 +35,9 @@ func NewKatibDBInterface(dbName string, connectTimeout time.Duration) (common.Ka
 	} else if dbName == common.PostgresSQLDBNameEnvValue {
 		klog.Info("Using Postgres")
 		return postgres.NewDBInterface(connectTimeout)
+	} else if dbName == common.SQLiteDBNameEnvValue {
+		klog.Info("Using SQLite")
+		return sqlite.NewDBInterface(connectTimeout)
 	}
 	return nil, errors.New("Invalid DB Name")
 }
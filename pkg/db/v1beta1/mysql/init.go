@@ -26,31 +26,44 @@ import (
 )
 
 func (d *dbConn) DBInit() {
-	db := d.db
 	skipDbInitialization := env.GetEnvOrDefault(common.SkipDbInitializationEnvName, "false")
 
 	if skipDbInitialization == "false" {
 		klog.Info("Initializing v1beta1 DB schema")
 
-		_, err := db.Exec(`CREATE TABLE IF NOT EXISTS observation_logs
-		(trial_name VARCHAR(255) NOT NULL,
-		id INT AUTO_INCREMENT PRIMARY KEY,
-		time DATETIME(6),
-		metric_name VARCHAR(255) NOT NULL,
-		value TEXT NOT NULL)`)
-		if err != nil {
-			klog.Fatalf("Error creating observation_logs table: %v", err)
+		if err := d.MigrateUp(); err != nil {
+			klog.Fatalf("Error migrating v1beta1 DB schema: %v", err)
 		}
 	} else {
 		klog.Info("Skipping v1beta1 DB schema initialization.")
 
-		_, err := db.Query(`SELECT trial_name, id, time, metric_name, value FROM observation_logs LIMIT 1`)
+		status, err := d.MigrationStatus()
 		if err != nil {
-			klog.Fatalf("Error validating observation_logs table: %v", err)
+			klog.Fatalf("Error validating v1beta1 DB schema: %v", err)
+		}
+		if status.CurrentVersion < status.LatestVersion {
+			klog.Fatalf("DB schema version %d is older than %d, run `katib-db-manager migrate up` to upgrade it",
+				status.CurrentVersion, status.LatestVersion)
 		}
 	}
 }
 
+func (d *dbConn) migrator() *common.Migrator {
+	return common.NewMigrator(d.db, migrationDialect, migrations)
+}
+
+func (d *dbConn) MigrateUp() error {
+	return d.migrator().Up()
+}
+
+func (d *dbConn) MigrateDown(steps int) error {
+	return d.migrator().Down(steps)
+}
+
+func (d *dbConn) MigrationStatus() (*common.MigrationStatus, error) {
+	return d.migrator().Status()
+}
+
 func (d *dbConn) SelectOne() error {
 	db := d.db
 	_, err := db.Exec(`SELECT 1`)
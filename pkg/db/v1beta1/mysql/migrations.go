@@ -0,0 +1,177 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"k8s.io/klog"
+
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
+)
+
+// migrationLockTimeout is the number of seconds to wait for the migration lock.
+const migrationLockTimeout = 300
+
+var migrationDialect = common.MigrationDialect{
+	VersionTableExists: `SELECT COUNT(*) FROM information_schema.TABLES
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'schema_version'`,
+	CreateVersionTable: `CREATE TABLE IF NOT EXISTS schema_version
+		(version INT PRIMARY KEY,
+		description VARCHAR(255) NOT NULL,
+		applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP)`,
+	InsertVersion: "INSERT INTO schema_version (version, description) VALUES (?, ?)",
+	DeleteVersion: "DELETE FROM schema_version WHERE version = ?",
+	Lock: func(ctx context.Context, conn *sql.Conn) error {
+		var locked sql.NullInt64
+		err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", common.MigrationLockName, migrationLockTimeout).Scan(&locked)
+		if err != nil {
+			return err
+		}
+		if !locked.Valid || locked.Int64 != 1 {
+			return fmt.Errorf("timeout waiting for lock %s", common.MigrationLockName)
+		}
+		return nil
+	},
+	Unlock: func(ctx context.Context, conn *sql.Conn) error {
+		_, err := conn.ExecContext(ctx, "SELECT RELEASE_LOCK(?)", common.MigrationLockName)
+		return err
+	},
+}
+
+// migrations must be sorted by version and released migrations must never be changed.
+var migrations = []common.Migration{
+	{
+		Version:     1,
+		Description: "Create observation_logs table",
+		Up: common.ExecMigration(`CREATE TABLE IF NOT EXISTS observation_logs
+		(trial_name VARCHAR(255) NOT NULL,
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		time DATETIME(6),
+		metric_name VARCHAR(255) NOT NULL,
+		value TEXT NOT NULL)`),
+		Down: common.ExecMigration("DROP TABLE IF EXISTS observation_logs"),
+	},
+	{
+		Version:     2,
+		Description: "Add namespace column to observation_logs table",
+		// Tables created by older Katib versions without migrations may already have the column.
+		// Existing rows keep an empty namespace until `katib-db-manager migrate backfill-namespace` is run.
+		Up:   addColumn("observation_logs", "namespace", "VARCHAR(255) NOT NULL DEFAULT ''"),
+		Down: dropColumn("observation_logs", "namespace"),
+	},
+	{
+		Version:     3,
+		Description: "Add trial_name and time index to observation_logs table",
+		Up:          createIndex("observation_logs", "observation_logs_trial_name_time", "trial_name, time"),
+		Down:        dropIndex("observation_logs", "observation_logs_trial_name_time"),
+	},
+	{
+		Version:     4,
+		Description: "Add step column to observation_logs table",
+		Up:          addColumn("observation_logs", "step", "BIGINT NULL"),
+		Down:        dropColumn("observation_logs", "step"),
+	},
+	{
+		Version:     5,
+		Description: "Create observation_log_batches table",
+		Up: common.ExecMigration(`CREATE TABLE IF NOT EXISTS observation_log_batches
+		(namespace VARCHAR(255) NOT NULL,
+		trial_name VARCHAR(255) NOT NULL,
+		batch_id VARCHAR(255) NOT NULL,
+		created_at DATETIME(6) NOT NULL,
+		PRIMARY KEY (namespace, trial_name, batch_id))`),
+		Down: common.ExecMigration("DROP TABLE IF EXISTS observation_log_batches"),
+	},
+	{
+		Version:     6,
+		Description: "Add worker column to observation_logs table",
+		// Existing rows keep an empty worker.
+		Up:   addColumn("observation_logs", "worker", "VARCHAR(255) NOT NULL DEFAULT ''"),
+		Down: dropColumn("observation_logs", "worker"),
+	},
+}
+
+// MySQL doesn't support IF [NOT] EXISTS for columns and indexes, and it commits DDL statements
+// implicitly. The following steps check information_schema first, so they can be run again
+// if the schema version was not recorded after the change.
+
+func addColumn(table, column, definition string) func(tx *sql.Tx) error {
+	return func(tx *sql.Tx) error {
+		exists, err := columnExists(tx, table, column)
+		if err != nil || exists {
+			if exists {
+				klog.Infof("%s table already has %s column", table, column)
+			}
+			return err
+		}
+		_, err = tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition))
+		return err
+	}
+}
+
+func dropColumn(table, column string) func(tx *sql.Tx) error {
+	return func(tx *sql.Tx) error {
+		exists, err := columnExists(tx, table, column)
+		if err != nil || !exists {
+			return err
+		}
+		_, err = tx.Exec(fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", table, column))
+		return err
+	}
+}
+
+func createIndex(table, index, columns string) func(tx *sql.Tx) error {
+	return func(tx *sql.Tx) error {
+		exists, err := indexExists(tx, table, index)
+		if err != nil || exists {
+			if exists {
+				klog.Infof("%s table already has %s index", table, index)
+			}
+			return err
+		}
+		_, err = tx.Exec(fmt.Sprintf("CREATE INDEX %s ON %s (%s)", index, table, columns))
+		return err
+	}
+}
+
+func dropIndex(table, index string) func(tx *sql.Tx) error {
+	return func(tx *sql.Tx) error {
+		exists, err := indexExists(tx, table, index)
+		if err != nil || !exists {
+			return err
+		}
+		_, err = tx.Exec(fmt.Sprintf("DROP INDEX %s ON %s", index, table))
+		return err
+	}
+}
+
+func columnExists(tx *sql.Tx, table, column string) (bool, error) {
+	var columns int
+	err := tx.QueryRow(`SELECT COUNT(*) FROM information_schema.COLUMNS
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND COLUMN_NAME = ?`, table, column).Scan(&columns)
+	return columns != 0, err
+}
+
+func indexExists(tx *sql.Tx, table, index string) (bool, error) {
+	var indexes int
+	err := tx.QueryRow(`SELECT COUNT(*) FROM information_schema.STATISTICS
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND INDEX_NAME = ?`, table, index).Scan(&indexes)
+	return indexes != 0, err
+}
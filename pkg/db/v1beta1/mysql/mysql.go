@@ -20,9 +20,11 @@ import (
 	"database/sql"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog"
 
 	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
@@ -64,8 +66,8 @@ func NewDBInterface(connectTimeout time.Duration) (common.KatibDBInterface, erro
 	return &dbConn{db: db}, nil
 }
 
-func (d *dbConn) RegisterObservationLog(trialName string, observationLog *v1beta1.ObservationLog) error {
-	sqlQuery := "INSERT INTO observation_logs (trial_name, time, metric_name, value) VALUES "
+func (d *dbConn) RegisterObservationLog(namespace string, trialName string, batchID string, observationLog *v1beta1.ObservationLog) error {
+	sqlQuery := "INSERT INTO observation_logs (namespace, trial_name, time, metric_name, value, step, worker) VALUES "
 	values := []interface{}{}
 
 	for _, mlog := range observationLog.MetricLogs {
@@ -78,72 +80,175 @@ func (d *dbConn) RegisterObservationLog(trialName string, observationLog *v1beta
 		}
 		sqlTimeStr := t.UTC().Format(mysqlTimeFmt)
 
-		sqlQuery += "(?, ?, ?, ?),"
-		values = append(values, trialName, sqlTimeStr, mlog.Metric.Name, mlog.Metric.Value)
+		sqlQuery += "(?, ?, ?, ?, ?, ?, ?),"
+		values = append(values, namespace, trialName, sqlTimeStr, mlog.Metric.Name, mlog.Metric.Value, mlog.Step, mlog.Worker)
 	}
 	sqlQuery = sqlQuery[0 : len(sqlQuery)-1]
 
-	// Prepare the statement
-	stmt, err := d.db.Prepare(sqlQuery)
+	tx, err := d.db.Begin()
 	if err != nil {
-		return fmt.Errorf("Prepare SQL statement failed: %v", err)
+		return fmt.Errorf("Begin SQL transaction failed: %v", err)
 	}
+	defer tx.Rollback()
 
-	// Close the statement
-	defer stmt.Close()
+	// The batch row is locked until the transaction is committed, so the concurrent retries of the batch wait for it.
+	if batchID != "" {
+		result, err := tx.Exec(`INSERT INTO observation_log_batches (namespace, trial_name, batch_id, created_at) VALUES (?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE batch_id = batch_id`, namespace, trialName, batchID, time.Now().UTC().Format(mysqlTimeFmt))
+		if err != nil {
+			return fmt.Errorf("Execute SQL INSERT failed: %v", err)
+		}
+		if inserted, err := result.RowsAffected(); err != nil {
+			return fmt.Errorf("Execute SQL INSERT failed: %v", err)
+		} else if inserted == 0 {
+			return common.ErrObservationLogBatchExists
+		}
+	}
 
-	// Execute INSERT
-	_, err = stmt.Exec(values...)
-	if err != nil {
-		return fmt.Errorf("Execute SQL INSERT failed: %v", err)
+	if len(values) != 0 {
+		// Prepare the statement
+		stmt, err := tx.Prepare(sqlQuery)
+		if err != nil {
+			return fmt.Errorf("Prepare SQL statement failed: %v", err)
+		}
+
+		// Close the statement
+		defer stmt.Close()
+
+		// Execute INSERT
+		_, err = stmt.Exec(values...)
+		if err != nil {
+			return fmt.Errorf("Execute SQL INSERT failed: %v", err)
+		}
 	}
 
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("Commit SQL transaction failed: %v", err)
+	}
 	return nil
 }
 
-func (d *dbConn) DeleteObservationLog(trialName string) error {
-	_, err := d.db.Exec("DELETE FROM observation_logs WHERE trial_name = ?", trialName)
+func (d *dbConn) DeleteObservationLog(namespace string, trialName string) error {
+	_, err := d.db.Exec("DELETE FROM observation_logs WHERE trial_name = ? AND namespace = ?", trialName, namespace)
+	if err != nil {
+		return err
+	}
+	_, err = d.db.Exec("DELETE FROM observation_log_batches WHERE trial_name = ? AND namespace = ?", trialName, namespace)
 	return err
 }
 
-func (d *dbConn) GetObservationLog(trialName string, metricName string, startTime string, endTime string) (*v1beta1.ObservationLog, error) {
-	qfield := []interface{}{trialName}
+func (d *dbConn) BackfillObservationLogNamespace(namespace string, trialName string) (int64, error) {
+	result, err := d.db.Exec("UPDATE observation_logs SET namespace = ? WHERE trial_name = ? AND namespace = ''", namespace, trialName)
+	if err != nil {
+		return 0, fmt.Errorf("Failed to backfill ObservationLog namespace %v", err)
+	}
+	return result.RowsAffected()
+}
+
+// Logs are ordered by time and id, so the page token refers to the last log of the previous page.
+// All logs are returned if the page size is 0.
+func (d *dbConn) GetObservationLog(request *v1beta1.GetObservationLogRequest) (*v1beta1.ObservationLog, string, error) {
+	trialNames := common.ObservationLogTrialNames(request)
+	qfield := []interface{}{}
+	for _, trialName := range trialNames {
+		qfield = append(qfield, trialName)
+	}
+	qfield = append(qfield, request.Namespace)
 	qstr := ""
-	if metricName != "" {
-		qstr += " AND metric_name = ?"
-		qfield = append(qfield, metricName)
+	metricNames := common.ObservationLogMetricNames(request)
+	if len(metricNames) != 0 {
+		qstr += " AND metric_name IN (?" + strings.Repeat(", ?", len(metricNames)-1) + ")"
+		for _, metricName := range metricNames {
+			qfield = append(qfield, metricName)
+		}
 	}
-	if startTime != "" {
+	if request.Rank0Worker {
+		workers, err := d.getRank0Workers(trialNames, request.Namespace, metricNames)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(workers) == 0 {
+			return &v1beta1.ObservationLog{MetricLogs: []*v1beta1.MetricLog{}}, "", nil
+		}
+		qstr += " AND ((trial_name = ? AND metric_name = ? AND worker = ?)" + strings.Repeat(" OR (trial_name = ? AND metric_name = ? AND worker = ?)", len(workers)-1) + ")"
+		for _, w := range workers {
+			qfield = append(qfield, w.TrialName, w.MetricName, w.Worker)
+		}
+	}
+	if startTime := request.StartTime; startTime != "" {
 		s_time, err := time.Parse(time.RFC3339Nano, startTime)
 		if err != nil {
-			return nil, fmt.Errorf("Error parsing start time %s: %v", startTime, err)
+			return nil, "", fmt.Errorf("Error parsing start time %s: %v", startTime, err)
 		}
 		formattedStartTime := s_time.UTC().Format(mysqlTimeFmt)
 		qstr += " AND time >= ?"
 		qfield = append(qfield, formattedStartTime)
 	}
-	if endTime != "" {
+	if endTime := request.EndTime; endTime != "" {
 		e_time, err := time.Parse(time.RFC3339Nano, endTime)
 		if err != nil {
-			return nil, fmt.Errorf("Error parsing completion time %s: %v", endTime, err)
+			return nil, "", fmt.Errorf("Error parsing completion time %s: %v", endTime, err)
 		}
 		formattedEndTime := e_time.UTC().Format(mysqlTimeFmt)
 		qstr += " AND time <= ?"
 		qfield = append(qfield, formattedEndTime)
 	}
-	rows, err := d.db.Query("SELECT time, metric_name, value FROM observation_logs WHERE trial_name = ?"+qstr+" ORDER BY time",
-		qfield...)
+	if request.StartStep != nil {
+		qstr += " AND step >= ?"
+		qfield = append(qfield, *request.StartStep)
+	}
+	if request.EndStep != nil {
+		qstr += " AND step <= ?"
+		qfield = append(qfield, *request.EndStep)
+	}
+	stmt := "SELECT id, time, metric_name, value, step, trial_name, worker FROM observation_logs WHERE trial_name IN (?" + strings.Repeat(", ?", len(trialNames)-1) + ") AND namespace = ?" + qstr
+	if request.LogsPerTrial > 0 {
+		// The first logs of each Trial are selected before the page.
+		stmt = `SELECT id, time, metric_name, value, step, trial_name, worker FROM
+			(SELECT id, time, metric_name, value, step, trial_name, worker,
+			ROW_NUMBER() OVER (PARTITION BY trial_name ORDER BY time, id) AS trial_row
+			FROM observation_logs WHERE trial_name IN (?` + strings.Repeat(", ?", len(trialNames)-1) + ") AND namespace = ?" + qstr + `) trial_logs
+			WHERE trial_row <= ?`
+		qfield = append(qfield, request.LogsPerTrial)
+	}
+	qstr = ""
+	pageSize := request.PageSize
+	if request.PageToken != "" {
+		lastTime, lastID, err := common.DecodePageToken(request.PageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		formattedLastTime := lastTime.UTC().Format(mysqlTimeFmt)
+		qstr += " AND (time > ? OR (time = ? AND id > ?))"
+		qfield = append(qfield, formattedLastTime, formattedLastTime, lastID)
+	}
+	qstr += " ORDER BY time, id"
+	if pageSize > 0 {
+		// Fetch one more log to know if there is a next page.
+		qstr += " LIMIT ?"
+		qfield = append(qfield, pageSize+1)
+	}
+	rows, err := d.db.Query(stmt+qstr, qfield...)
 	if err != nil {
-		return nil, fmt.Errorf("Failed to get ObservationLogs %v", err)
+		return nil, "", fmt.Errorf("Failed to get ObservationLogs %v", err)
 	}
 	// Close the rows
 	defer rows.Close()
 	result := &v1beta1.ObservationLog{
 		MetricLogs: []*v1beta1.MetricLog{},
 	}
-	for rows.Next() {
-		var mname, mvalue, sqlTimeStr string
-		err := rows.Scan(&sqlTimeStr, &mname, &mvalue)
+	var lastTime time.Time
+	var lastID int64
+	nextPageToken := ""
+	for count := int32(1); rows.Next(); count++ {
+		if pageSize > 0 && count > pageSize {
+			nextPageToken = common.EncodePageToken(lastTime, lastID)
+			break
+		}
+		var id int64
+		var mname, mvalue, sqlTimeStr, trialName, worker string
+		var step sql.NullInt64
+		err := rows.Scan(&id, &sqlTimeStr, &mname, &mvalue, &step, &trialName, &worker)
 		if err != nil {
 			klog.Errorf("Error scanning log: %v", err)
 			continue
@@ -153,14 +258,168 @@ func (d *dbConn) GetObservationLog(trialName string, metricName string, startTim
 			klog.Errorf("Error parsing time %s: %v", sqlTimeStr, err)
 			continue
 		}
+		lastTime, lastID = ptime, id
 		timeStamp := ptime.UTC().Format(time.RFC3339Nano)
-		result.MetricLogs = append(result.MetricLogs, &v1beta1.MetricLog{
+		mlog := &v1beta1.MetricLog{
 			TimeStamp: timeStamp,
 			Metric: &v1beta1.Metric{
 				Name:  mname,
 				Value: mvalue,
 			},
-		})
+			Worker: worker,
+		}
+		if step.Valid {
+			mlog.Step = &step.Int64
+		}
+		if len(request.TrialNames) != 0 {
+			mlog.TrialName = trialName
+		}
+		result.MetricLogs = append(result.MetricLogs, mlog)
+	}
+	return result, nextPageToken, nil
+}
+
+// getRank0Workers returns the rank 0 worker of every metric of the Trials.
+func (d *dbConn) getRank0Workers(trialNames []string, namespace string, metricNames []string) ([]common.MetricWorker, error) {
+	qfield := []interface{}{}
+	for _, trialName := range trialNames {
+		qfield = append(qfield, trialName)
+	}
+	qfield = append(qfield, namespace)
+	qstr := ""
+	if len(metricNames) != 0 {
+		qstr += " AND metric_name IN (?" + strings.Repeat(", ?", len(metricNames)-1) + ")"
+		for _, metricName := range metricNames {
+			qfield = append(qfield, metricName)
+		}
+	}
+	rows, err := d.db.Query("SELECT DISTINCT trial_name, metric_name, worker FROM observation_logs WHERE trial_name IN (?"+strings.Repeat(", ?", len(trialNames)-1)+") AND namespace = ?"+qstr,
+		qfield...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get workers of ObservationLogs %v", err)
+	}
+	defer rows.Close()
+	workers := []common.MetricWorker{}
+	for rows.Next() {
+		var w common.MetricWorker
+		if err := rows.Scan(&w.TrialName, &w.MetricName, &w.Worker); err != nil {
+			return nil, err
+		}
+		workers = append(workers, w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return common.Rank0Workers(workers), nil
+}
+
+func (d *dbConn) GetObservationSummary(namespace string, trialName string, metricNames []string) ([]*v1beta1.MetricSummary, error) {
+	numericValue := "CASE WHEN value REGEXP ? THEN CAST(value AS DOUBLE) END"
+	qfield := []interface{}{common.NumericValueRegexp, common.NumericValueRegexp, trialName, namespace}
+	qstr := ""
+	if len(metricNames) != 0 {
+		qstr += " AND metric_name IN (?" + strings.Repeat(", ?", len(metricNames)-1) + ")"
+		for _, metricName := range metricNames {
+			qfield = append(qfield, metricName)
+		}
+	}
+	rows, err := d.db.Query(`SELECT metric_name, worker, value, metric_count, min_value, max_value FROM
+		(SELECT metric_name, worker, value,
+		COUNT(*) OVER (PARTITION BY metric_name, worker) AS metric_count,
+		MIN(`+numericValue+`) OVER (PARTITION BY metric_name, worker) AS min_value,
+		MAX(`+numericValue+`) OVER (PARTITION BY metric_name, worker) AS max_value,
+		ROW_NUMBER() OVER (PARTITION BY metric_name, worker ORDER BY time DESC, id DESC) AS row_num
+		FROM observation_logs WHERE trial_name = ? AND namespace = ?`+qstr+`) summary
+		WHERE row_num = 1 ORDER BY metric_name, worker`,
+		qfield...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get ObservationSummary %v", err)
+	}
+	defer rows.Close()
+	result := []*v1beta1.MetricSummary{}
+	for rows.Next() {
+		var mname, worker, latest string
+		var count int64
+		var minValue, maxValue sql.NullFloat64
+		if err := rows.Scan(&mname, &worker, &latest, &count, &minValue, &maxValue); err != nil {
+			return nil, fmt.Errorf("Error scanning summary: %v", err)
+		}
+		result = append(result, common.NewMetricSummary(mname, worker, latest, count, minValue, maxValue))
+	}
+	return result, rows.Err()
+}
+
+// DownsampleObservationLog keeps the minimum, maximum and latest log of every metric in each bucket
+// for the logs older than before and deletes at most limit of the rest.
+func (d *dbConn) DownsampleObservationLog(before time.Time, bucket time.Duration, limit int) (int64, error) {
+	numericValue := "CASE WHEN value REGEXP ? THEN CAST(value AS DOUBLE) END"
+	bucketPartition := "PARTITION BY namespace, trial_name, metric_name, worker, bucket"
+	// MySQL doesn't support LIMIT in IN subqueries, so the batch is selected from a derived table.
+	result, err := d.db.Exec(`DELETE FROM observation_logs WHERE id IN (SELECT id FROM (SELECT id FROM
+		(SELECT id,
+		ROW_NUMBER() OVER (`+bucketPartition+` ORDER BY time DESC, id DESC) AS latest_num,
+		ROW_NUMBER() OVER (`+bucketPartition+` ORDER BY numeric_value IS NULL, numeric_value, id) AS min_num,
+		ROW_NUMBER() OVER (`+bucketPartition+` ORDER BY numeric_value IS NULL, numeric_value DESC, id) AS max_num
+		FROM (SELECT id, namespace, trial_name, metric_name, worker, time, `+numericValue+` AS numeric_value,
+		FLOOR(TIMESTAMPDIFF(SECOND, '1970-01-01 00:00:00', time) / ?) AS bucket
+		FROM observation_logs WHERE time < ?) logs) ranked
+		WHERE latest_num > 1 AND min_num > 1 AND max_num > 1 LIMIT ?) batch)`,
+		common.NumericValueRegexp, int64(bucket.Seconds()), before.UTC().Format(mysqlTimeFmt), limit)
+	if err != nil {
+		return 0, fmt.Errorf("Failed to downsample ObservationLogs %v", err)
+	}
+	return result.RowsAffected()
+}
+
+// ExpireObservationLog deletes the logs of the trials which haven't reported any log since before.
+func (d *dbConn) ExpireObservationLog(before time.Time) (int64, error) {
+	result, err := d.db.Exec(`DELETE FROM observation_logs WHERE (namespace, trial_name) IN
+		(SELECT namespace, trial_name FROM
+		(SELECT namespace, trial_name FROM observation_logs GROUP BY namespace, trial_name HAVING MAX(time) < ?) expired)`,
+		before.UTC().Format(mysqlTimeFmt))
+	if err != nil {
+		return 0, fmt.Errorf("Failed to expire ObservationLogs %v", err)
+	}
+	// Batches are only kept to drop the retried reports, so they expire with the logs.
+	if _, err = d.db.Exec("DELETE FROM observation_log_batches WHERE created_at < ?", before.UTC().Format(mysqlTimeFmt)); err != nil {
+		return 0, fmt.Errorf("Failed to expire ObservationLog batches %v", err)
+	}
+	return result.RowsAffected()
+}
+
+// ListObservationLogTrials returns the trials which have logs.
+// Rows stored before the namespace column was added are skipped until their namespace is backfilled.
+func (d *dbConn) ListObservationLogTrials() ([]types.NamespacedName, error) {
+	rows, err := d.db.Query("SELECT DISTINCT namespace, trial_name FROM observation_logs WHERE namespace <> ''")
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list ObservationLog trials %v", err)
+	}
+	defer rows.Close()
+	result := []types.NamespacedName{}
+	for rows.Next() {
+		var trial types.NamespacedName
+		if err := rows.Scan(&trial.Namespace, &trial.Name); err != nil {
+			return nil, fmt.Errorf("Error scanning trial: %v", err)
+		}
+		result = append(result, trial)
+	}
+	return result, rows.Err()
+}
+
+// ListLegacyObservationLogTrials returns the names of the trials which have logs without namespace.
+func (d *dbConn) ListLegacyObservationLogTrials() ([]string, error) {
+	rows, err := d.db.Query("SELECT DISTINCT trial_name FROM observation_logs WHERE namespace = '' ORDER BY trial_name")
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list legacy ObservationLog trials %v", err)
+	}
+	defer rows.Close()
+	result := []string{}
+	for rows.Next() {
+		var trialName string
+		if err := rows.Scan(&trialName); err != nil {
+			return nil, fmt.Errorf("Error scanning trial: %v", err)
+		}
+		result = append(result, trialName)
 	}
-	return result, nil
+	return result, rows.Err()
 }
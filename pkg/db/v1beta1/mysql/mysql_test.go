@@ -17,12 +17,17 @@ limitations under the License.
 package mysql
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	_ "github.com/go-sql-driver/mysql"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"k8s.io/apimachinery/pkg/types"
 
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
@@ -39,8 +44,44 @@ func TestMain(m *testing.M) {
 		os.Exit(1)
 	}
 	dbInterface = &dbConn{db: db}
+	mock.ExpectQuery("SELECT GET_LOCK").WithArgs(common.MigrationLockName, migrationLockTimeout).WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(1))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS schema_version").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT COUNT.* FROM information_schema.TABLES").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery("SELECT MAX.*version.* FROM schema_version").WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(nil))
+	mock.ExpectBegin()
 	mock.ExpectExec("CREATE TABLE IF NOT EXISTS observation_logs").WithArgs().WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO schema_version").WithArgs(1, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT COUNT.* FROM information_schema.COLUMNS").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectExec("ALTER TABLE observation_logs ADD COLUMN namespace").WithArgs().WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO schema_version").WithArgs(2, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT COUNT.* FROM information_schema.STATISTICS").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectExec("CREATE INDEX observation_logs_trial_name_time").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO schema_version").WithArgs(3, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT COUNT.* FROM information_schema.COLUMNS").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectExec("ALTER TABLE observation_logs ADD COLUMN step").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO schema_version").WithArgs(4, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS observation_log_batches").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO schema_version").WithArgs(5, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT COUNT.* FROM information_schema.COLUMNS").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectExec("ALTER TABLE observation_logs ADD COLUMN worker").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO schema_version").WithArgs(6, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectExec("SELECT RELEASE_LOCK").WithArgs(common.MigrationLockName).WillReturnResult(sqlmock.NewResult(0, 0))
 	dbInterface.DBInit()
+	if err = mock.ExpectationsWereMet(); err != nil {
+		fmt.Printf("DB schema initialization failed: %v\n", err)
+		os.Exit(1)
+	}
 	err = dbInterface.SelectOne()
 	if err != nil {
 		fmt.Printf("error `SELECT 1` probing: %v\n", err)
@@ -67,66 +108,499 @@ func TestRegisterObservationLog(t *testing.T) {
 			},
 		},
 	}
+	mock.ExpectBegin()
 	mock.ExpectPrepare("INSERT")
 	mock.ExpectExec(
 		"INSERT",
 	).WithArgs(
+		"test-namespace",
 		"test1_trial1",
 		"2016-12-31 20:02:05.123456",
 		"f1_score",
 		"88.95",
+		nil,
+		"",
+		"test-namespace",
 		"test1_trial1",
 		"2016-12-31 20:02:05.123456",
 		"loss",
 		"0.5",
+		nil,
+		"",
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
-	err := dbInterface.RegisterObservationLog("test1_trial1", obsLog)
+	mock.ExpectCommit()
+
+	err := dbInterface.RegisterObservationLog("test-namespace", "test1_trial1", "", obsLog)
 	if err != nil {
 		t.Errorf("RegisterExperiment failed: %v", err)
 	}
 
 }
 
+func TestRegisterObservationLogBatch(t *testing.T) {
+	obsLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			{
+				TimeStamp: "2016-12-31T20:02:05.123456Z",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: "0.5",
+				},
+			},
+		},
+	}
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO observation_log_batches").WithArgs(
+		"test-namespace", "test1_trial1", "batch-1", sqlmock.AnyArg(),
+	).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectPrepare("INSERT INTO observation_logs")
+	mock.ExpectExec("INSERT INTO observation_logs").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	if err := dbInterface.RegisterObservationLog("test-namespace", "test1_trial1", "batch-1", obsLog); err != nil {
+		t.Errorf("RegisterObservationLog failed: %v", err)
+	}
+
+	// The retried batch is dropped.
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO observation_log_batches").WithArgs(
+		"test-namespace", "test1_trial1", "batch-1", sqlmock.AnyArg(),
+	).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err := dbInterface.RegisterObservationLog("test-namespace", "test1_trial1", "batch-1", obsLog)
+	if !errors.Is(err, common.ErrObservationLogBatchExists) {
+		t.Errorf("Expected ErrObservationLogBatchExists, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unfulfilled expectations: %v", err)
+	}
+}
+
 func TestGetObservationLog(t *testing.T) {
 	mock.ExpectQuery("SELECT").WillReturnRows(
-		sqlmock.NewRows([]string{"time", "metric_name", "value"}).AddRow(
+		sqlmock.NewRows([]string{"id", "time", "metric_name", "value", "step", "trial_name", "worker"}).AddRow(
+			1,
 			"2016-12-31 21:02:05.123456",
 			"loss",
 			"0.9",
+			nil,
+			"test1_trial1",
+			"",
 		).AddRow(
+			2,
 			"2016-12-31 22:02:05.123456",
 			"loss",
 			"0.9",
+			nil,
+			"test1_trial1",
+			"",
 		),
 	)
-	obsLog, err := dbInterface.GetObservationLog(
-		"test1_trial1",
-		"loss",
-		"2016-12-31T21:01:05.123456Z",
-		"2016-12-31T22:10:20.123456Z",
-	)
+	obsLog, nextPageToken, err := dbInterface.GetObservationLog(&api_pb.GetObservationLogRequest{
+		TrialName:  "test1_trial1",
+		Namespace:  "test-namespace",
+		MetricName: "loss",
+		StartTime:  "2016-12-31T21:01:05.123456Z",
+		EndTime:    "2016-12-31T22:10:20.123456Z",
+	})
 	if err != nil {
 		t.Errorf("GetObservationLog failed %v", err)
-	} else if len(obsLog.MetricLogs) != 2 {
+	} else if len(obsLog.MetricLogs) != 2 || nextPageToken != "" {
+		t.Errorf("GetObservationLog incorrect return %v, %v", obsLog, nextPageToken)
+	}
+
+}
+
+func TestGetObservationLogPage(t *testing.T) {
+	mock.ExpectQuery("SELECT id, time, metric_name, value, step, trial_name, worker FROM observation_logs .* ORDER BY time, id LIMIT").WithArgs(
+		"test1_trial1", "test-namespace", "loss",
+		"2016-12-31 20:02:05.123456", "2016-12-31 20:02:05.123456", 5,
+		int32(2),
+	).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "time", "metric_name", "value", "step", "trial_name", "worker"}).AddRow(
+			6,
+			"2016-12-31 21:02:05.123456",
+			"loss",
+			"0.9",
+			nil,
+			"test1_trial1",
+			"",
+		).AddRow(
+			7,
+			"2016-12-31 22:02:05.123456",
+			"loss",
+			"0.8",
+			nil,
+			"test1_trial1",
+			"",
+		),
+	)
+	pageToken := common.EncodePageToken(time.Date(2016, 12, 31, 20, 2, 5, 123456000, time.UTC), 5)
+	obsLog, nextPageToken, err := dbInterface.GetObservationLog(&api_pb.GetObservationLogRequest{
+		TrialName:  "test1_trial1",
+		Namespace:  "test-namespace",
+		MetricName: "loss",
+		PageSize:   1,
+		PageToken:  pageToken,
+	})
+	if err != nil {
+		t.Fatalf("GetObservationLog failed %v", err)
+	}
+	if len(obsLog.MetricLogs) != 1 || obsLog.MetricLogs[0].Metric.Value != "0.9" {
+		t.Errorf("GetObservationLog incorrect return %v", obsLog)
+	}
+	lastTime, lastID, err := common.DecodePageToken(nextPageToken)
+	if err != nil {
+		t.Fatalf("Invalid next page token %v", err)
+	}
+	if !lastTime.Equal(time.Date(2016, 12, 31, 21, 2, 5, 123456000, time.UTC)) || lastID != 6 {
+		t.Errorf("GetObservationLog incorrect next page token %v, %v", lastTime, lastID)
+	}
+}
+
+func TestGetObservationLogStepRange(t *testing.T) {
+	mock.ExpectQuery("SELECT id, time, metric_name, value, step, trial_name, worker FROM observation_logs .* AND step >= \\? AND step <= \\? ORDER BY time, id").WithArgs(
+		"test1_trial1", "test-namespace", "loss", int64(10), int64(20),
+	).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "time", "metric_name", "value", "step", "trial_name", "worker"}).AddRow(
+			1,
+			"2016-12-31 21:02:05.123456",
+			"loss",
+			"0.9",
+			10,
+			"test1_trial1",
+			"",
+		).AddRow(
+			2,
+			"2016-12-31 22:02:05.123456",
+			"loss",
+			"0.8",
+			20,
+			"test1_trial1",
+			"",
+		),
+	)
+	startStep, endStep := int64(10), int64(20)
+	obsLog, _, err := dbInterface.GetObservationLog(&api_pb.GetObservationLogRequest{
+		TrialName:  "test1_trial1",
+		Namespace:  "test-namespace",
+		MetricName: "loss",
+		StartStep:  &startStep,
+		EndStep:    &endStep,
+	})
+	if err != nil {
+		t.Fatalf("GetObservationLog failed %v", err)
+	}
+	if len(obsLog.MetricLogs) != 2 || obsLog.MetricLogs[0].GetStep() != 10 || obsLog.MetricLogs[1].GetStep() != 20 {
+		t.Errorf("GetObservationLog incorrect return %v", obsLog)
+	}
+}
+
+func TestGetObservationLogTrials(t *testing.T) {
+	mock.ExpectQuery("SELECT id, time, metric_name, value, step, trial_name, worker FROM observation_logs WHERE trial_name IN \\(\\?, \\?\\) AND namespace = \\? AND metric_name IN \\(\\?, \\?\\) ORDER BY time, id").WithArgs(
+		"test1_trial1", "test1_trial2", "test-namespace", "loss", "f1_score",
+	).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "time", "metric_name", "value", "step", "trial_name", "worker"}).AddRow(
+			1,
+			"2016-12-31 21:02:05.123456",
+			"loss",
+			"0.9",
+			nil,
+			"test1_trial1",
+			"",
+		).AddRow(
+			2,
+			"2016-12-31 22:02:05.123456",
+			"f1_score",
+			"88.95",
+			nil,
+			"test1_trial2",
+			"",
+		),
+	)
+	obsLog, _, err := dbInterface.GetObservationLog(&api_pb.GetObservationLogRequest{
+		TrialName:   "test1_trial1",
+		Namespace:   "test-namespace",
+		MetricName:  "loss",
+		TrialNames:  []string{"test1_trial1", "test1_trial2"},
+		MetricNames: []string{"loss", "f1_score"},
+	})
+	if err != nil {
+		t.Fatalf("GetObservationLog failed %v", err)
+	}
+	if len(obsLog.MetricLogs) != 2 || obsLog.MetricLogs[0].TrialName != "test1_trial1" || obsLog.MetricLogs[1].TrialName != "test1_trial2" {
+		t.Errorf("GetObservationLog incorrect return %v", obsLog)
+	}
+}
+
+func TestGetObservationLogPerTrial(t *testing.T) {
+	pageToken := common.EncodePageToken(time.Date(2016, 12, 31, 20, 2, 5, 123456000, time.UTC), 1)
+	mock.ExpectQuery("ROW_NUMBER\\(\\) OVER \\(PARTITION BY trial_name ORDER BY time, id\\) AS trial_row\\s+FROM observation_logs WHERE trial_name IN \\(\\?, \\?\\) AND namespace = \\?\\) trial_logs\\s+WHERE trial_row <= \\? AND \\(time > \\? OR \\(time = \\? AND id > \\?\\)\\) ORDER BY time, id LIMIT \\?").WithArgs(
+		"test1_trial1", "test1_trial2", "test-namespace", int32(4),
+		"2016-12-31 20:02:05.123456", "2016-12-31 20:02:05.123456", 1,
+		int32(3),
+	).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "time", "metric_name", "value", "step", "trial_name", "worker"}).AddRow(
+			2,
+			"2016-12-31 21:02:05.123456",
+			"loss",
+			"0.9",
+			nil,
+			"test1_trial2",
+			"",
+		),
+	)
+	obsLog, nextPageToken, err := dbInterface.GetObservationLog(&api_pb.GetObservationLogRequest{
+		Namespace:    "test-namespace",
+		TrialNames:   []string{"test1_trial1", "test1_trial2"},
+		LogsPerTrial: 4,
+		PageSize:     2,
+		PageToken:    pageToken,
+	})
+	if err != nil {
+		t.Fatalf("GetObservationLog failed %v", err)
+	}
+	if len(obsLog.MetricLogs) != 1 || obsLog.MetricLogs[0].TrialName != "test1_trial2" || nextPageToken != "" {
+		t.Errorf("GetObservationLog incorrect return %v, next page token %q", obsLog, nextPageToken)
+	}
+}
+
+func TestGetObservationLogRank0Worker(t *testing.T) {
+	mock.ExpectQuery("SELECT DISTINCT trial_name, metric_name, worker FROM observation_logs WHERE trial_name IN \\(\\?\\) AND namespace = \\?").WithArgs(
+		"test1_trial1", "test-namespace",
+	).WillReturnRows(
+		sqlmock.NewRows([]string{"trial_name", "metric_name", "worker"}).
+			AddRow("test1_trial1", "loss", "worker-1").
+			AddRow("test1_trial1", "loss", "master-0").
+			AddRow("test1_trial1", "loss", ""),
+	)
+	mock.ExpectQuery("SELECT id, time, metric_name, value, step, trial_name, worker FROM observation_logs WHERE trial_name IN \\(\\?\\) AND namespace = \\? AND \\(\\(trial_name = \\? AND metric_name = \\? AND worker = \\?\\)\\) ORDER BY time, id").WithArgs(
+		"test1_trial1", "test-namespace", "test1_trial1", "loss", "master-0",
+	).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "time", "metric_name", "value", "step", "trial_name", "worker"}).
+			AddRow(1, "2016-12-31 21:02:05.123456", "loss", "0.9", nil, "test1_trial1", "master-0"),
+	)
+	obsLog, _, err := dbInterface.GetObservationLog(&api_pb.GetObservationLogRequest{
+		TrialName:   "test1_trial1",
+		Namespace:   "test-namespace",
+		Rank0Worker: true,
+	})
+	if err != nil {
+		t.Fatalf("GetObservationLog failed %v", err)
+	}
+	if len(obsLog.MetricLogs) != 1 || obsLog.MetricLogs[0].Worker != "master-0" {
 		t.Errorf("GetObservationLog incorrect return %v", obsLog)
 	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetObservationSummary(t *testing.T) {
+	mock.ExpectQuery("SELECT metric_name, worker, value, metric_count, min_value, max_value FROM").WithArgs(
+		common.NumericValueRegexp, common.NumericValueRegexp, "test1_trial1", "test-namespace", "f1_score", "loss",
+	).WillReturnRows(
+		sqlmock.NewRows([]string{"metric_name", "worker", "value", "metric_count", "min_value", "max_value"}).AddRow(
+			"f1_score",
+			"",
+			"unavailable",
+			3,
+			88.95,
+			89.5,
+		).AddRow(
+			"loss",
+			"",
+			"unavailable",
+			1,
+			nil,
+			nil,
+		),
+	)
+	summaries, err := dbInterface.GetObservationSummary(
+		"test-namespace",
+		"test1_trial1",
+		[]string{"f1_score", "loss"},
+	)
+	if err != nil {
+		t.Fatalf("GetObservationSummary failed %v", err)
+	}
+	expected := []*api_pb.MetricSummary{
+		{Name: "f1_score", Min: "88.95", Max: "89.5", Latest: "unavailable", Count: 3},
+		{Name: "loss", Latest: "unavailable", Count: 1},
+	}
+	if !cmp.Equal(expected, summaries, protocmp.Transform()) {
+		t.Errorf("GetObservationSummary incorrect return %v", summaries)
+	}
+}
+
+func TestDownsampleObservationLog(t *testing.T) {
+	mock.ExpectExec("DELETE FROM observation_logs WHERE id IN").WithArgs(
+		common.NumericValueRegexp, int64(60), "2016-12-31 20:02:00", 100,
+	).WillReturnResult(sqlmock.NewResult(0, 2))
+
+	deleted, err := dbInterface.DownsampleObservationLog(time.Date(2016, 12, 31, 20, 2, 0, 0, time.UTC), time.Minute, 100)
+	if err != nil {
+		t.Fatalf("DownsampleObservationLog failed: %v", err)
+	}
+	if deleted != 2 {
+		t.Errorf("Expected 2 downsampled logs, got %d", deleted)
+	}
+}
+
+func TestExpireObservationLog(t *testing.T) {
+	mock.ExpectExec("DELETE FROM observation_logs WHERE \\(namespace, trial_name\\) IN").WithArgs(
+		"2016-12-31 20:02:00",
+	).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec("DELETE FROM observation_log_batches WHERE created_at").WithArgs(
+		sqlmock.AnyArg(),
+	).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	deleted, err := dbInterface.ExpireObservationLog(time.Date(2016, 12, 31, 20, 2, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("ExpireObservationLog failed: %v", err)
+	}
+	if deleted != 3 {
+		t.Errorf("Expected 3 expired logs, got %d", deleted)
+	}
+}
+
+func TestListObservationLogTrials(t *testing.T) {
+	mock.ExpectQuery("SELECT DISTINCT namespace, trial_name FROM observation_logs").WillReturnRows(
+		sqlmock.NewRows([]string{"namespace", "trial_name"}).AddRow(
+			"test-namespace",
+			"test1_trial1",
+		).AddRow(
+			"test-namespace",
+			"test1_trial2",
+		),
+	)
+	trials, err := dbInterface.ListObservationLogTrials()
+	if err != nil {
+		t.Fatalf("ListObservationLogTrials failed: %v", err)
+	}
+	expected := []types.NamespacedName{
+		{Namespace: "test-namespace", Name: "test1_trial1"},
+		{Namespace: "test-namespace", Name: "test1_trial2"},
+	}
+	if !cmp.Equal(expected, trials) {
+		t.Errorf("ListObservationLogTrials incorrect return %v", trials)
+	}
+}
 
+func TestListLegacyObservationLogTrials(t *testing.T) {
+	mock.ExpectQuery("SELECT DISTINCT trial_name FROM observation_logs WHERE namespace = ''").WillReturnRows(
+		sqlmock.NewRows([]string{"trial_name"}).AddRow("test1_trial1").AddRow("test1_trial2"),
+	)
+	trials, err := dbInterface.ListLegacyObservationLogTrials()
+	if err != nil {
+		t.Fatalf("ListLegacyObservationLogTrials failed: %v", err)
+	}
+	if expected := []string{"test1_trial1", "test1_trial2"}; !cmp.Equal(expected, trials) {
+		t.Errorf("ListLegacyObservationLogTrials incorrect return %v", trials)
+	}
 }
 
 func TestDeleteObservationLog(t *testing.T) {
+	namespace := "test-namespace"
 	trialName := "test1_trial1"
 
 	mock.ExpectExec(
 		"DELETE FROM observation_logs",
-	).WithArgs(trialName).WillReturnResult(sqlmock.NewResult(1, 1))
+	).WithArgs(trialName, namespace).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(
+		"DELETE FROM observation_log_batches",
+	).WithArgs(trialName, namespace).WillReturnResult(sqlmock.NewResult(0, 1))
 
-	err := dbInterface.DeleteObservationLog(trialName)
+	err := dbInterface.DeleteObservationLog(namespace, trialName)
 	if err != nil {
 		t.Errorf("DeleteObservationLog failed: %v", err)
 	}
 }
 
+func TestBackfillObservationLogNamespace(t *testing.T) {
+	namespace := "test-namespace"
+	trialName := "test1_trial1"
+
+	mock.ExpectExec(
+		"UPDATE observation_logs SET namespace = \\? WHERE trial_name = \\? AND namespace = ''",
+	).WithArgs(namespace, trialName).WillReturnResult(sqlmock.NewResult(0, 3))
+
+	updated, err := dbInterface.BackfillObservationLogNamespace(namespace, trialName)
+	if err != nil {
+		t.Errorf("BackfillObservationLogNamespace failed: %v", err)
+	}
+	if updated != 3 {
+		t.Errorf("Expected 3 backfilled logs, got %d", updated)
+	}
+}
+
+func TestMigrateDown(t *testing.T) {
+	mock.ExpectQuery("SELECT GET_LOCK").WithArgs(common.MigrationLockName, migrationLockTimeout).WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(1))
+	mock.ExpectQuery("SELECT COUNT.* FROM information_schema.TABLES").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery("SELECT MAX.*version.* FROM schema_version").WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(6))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT COUNT.* FROM information_schema.COLUMNS").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectExec("ALTER TABLE observation_logs DROP COLUMN worker").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DELETE FROM schema_version").WithArgs(6).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectExec("SELECT RELEASE_LOCK").WithArgs(common.MigrationLockName).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := dbInterface.MigrateDown(1); err != nil {
+		t.Errorf("MigrateDown failed: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unfulfilled expectations: %v", err)
+	}
+}
+
+func TestMigrateUpAfterImplicitCommit(t *testing.T) {
+	// DDL of migration 3 was committed, but its version was not recorded.
+	mock.ExpectQuery("SELECT GET_LOCK").WithArgs(common.MigrationLockName, migrationLockTimeout).WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(1))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS schema_version").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT COUNT.* FROM information_schema.TABLES").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery("SELECT MAX.*version.* FROM schema_version").WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT COUNT.* FROM information_schema.STATISTICS").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectExec("INSERT INTO schema_version").WithArgs(3, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT COUNT.* FROM information_schema.COLUMNS").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectExec("INSERT INTO schema_version").WithArgs(4, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS observation_log_batches").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO schema_version").WithArgs(5, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT COUNT.* FROM information_schema.COLUMNS").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectExec("ALTER TABLE observation_logs ADD COLUMN worker").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO schema_version").WithArgs(6, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectExec("SELECT RELEASE_LOCK").WithArgs(common.MigrationLockName).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := dbInterface.MigrateUp(); err != nil {
+		t.Errorf("MigrateUp failed: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unfulfilled expectations: %v", err)
+	}
+}
+
+func TestMigrationStatus(t *testing.T) {
+	mock.ExpectQuery("SELECT COUNT.* FROM information_schema.TABLES").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery("SELECT MAX.*version.* FROM schema_version").WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(3))
+
+	status, err := dbInterface.MigrationStatus()
+	if err != nil {
+		t.Fatalf("MigrationStatus failed: %v", err)
+	}
+	if status.CurrentVersion != 3 || status.LatestVersion != 6 || len(status.Pending) != 3 {
+		t.Errorf("Unexpected migration status: %+v", status)
+	}
+}
+
 func TestGetDbName(t *testing.T) {
 	dbName := "root:@tcp(katib-mysql:3306)/katib?timeout=5s"
 
@@ -0,0 +1,119 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
+)
+
+const (
+	// migrationLockID is the key of the advisory lock held while running migrations.
+	migrationLockID = 6789
+	// migrationLockTimeout is the time to wait for the migration lock.
+	migrationLockTimeout = 5 * time.Minute
+	// migrationLockRetryInterval is the interval between attempts to acquire the migration lock.
+	migrationLockRetryInterval = time.Second
+)
+
+var migrationDialect = common.MigrationDialect{
+	VersionTableExists: `SELECT COUNT(*) FROM information_schema.tables
+		WHERE table_schema = current_schema() AND table_name = 'schema_version'`,
+	CreateVersionTable: `CREATE TABLE IF NOT EXISTS schema_version
+		(version INTEGER PRIMARY KEY,
+		description VARCHAR(255) NOT NULL,
+		applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP)`,
+	InsertVersion: "INSERT INTO schema_version (version, description) VALUES ($1, $2)",
+	DeleteVersion: "DELETE FROM schema_version WHERE version = $1",
+	Lock: func(ctx context.Context, conn *sql.Conn) error {
+		// pg_advisory_lock waits without a timeout, so the lock is polled until migrationLockTimeout.
+		deadline := time.Now().Add(migrationLockTimeout)
+		for {
+			var locked bool
+			if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", migrationLockID).Scan(&locked); err != nil {
+				return err
+			}
+			if locked {
+				return nil
+			}
+			if time.Now().After(deadline) {
+				return fmt.Errorf("timeout waiting for advisory lock %d", migrationLockID)
+			}
+			time.Sleep(migrationLockRetryInterval)
+		}
+	},
+	Unlock: func(ctx context.Context, conn *sql.Conn) error {
+		_, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", migrationLockID)
+		return err
+	},
+}
+
+// migrations must be sorted by version and released migrations must never be changed.
+var migrations = []common.Migration{
+	{
+		Version:     1,
+		Description: "Create observation_logs table",
+		Up: common.ExecMigration(`CREATE TABLE IF NOT EXISTS observation_logs
+		(trial_name VARCHAR(255) NOT NULL,
+		id serial PRIMARY KEY,
+		time TIMESTAMP(6),
+		metric_name VARCHAR(255) NOT NULL,
+		value TEXT NOT NULL)`),
+		Down: common.ExecMigration("DROP TABLE IF EXISTS observation_logs"),
+	},
+	{
+		Version:     2,
+		Description: "Add namespace column to observation_logs table",
+		// Existing rows keep an empty namespace until `katib-db-manager migrate backfill-namespace` is run.
+		Up:   common.ExecMigration("ALTER TABLE observation_logs ADD COLUMN IF NOT EXISTS namespace VARCHAR(255) NOT NULL DEFAULT ''"),
+		Down: common.ExecMigration("ALTER TABLE observation_logs DROP COLUMN IF EXISTS namespace"),
+	},
+	{
+		Version:     3,
+		Description: "Add trial_name and time index to observation_logs table",
+		Up:          common.ExecMigration("CREATE INDEX IF NOT EXISTS observation_logs_trial_name_time ON observation_logs (trial_name, time)"),
+		Down:        common.ExecMigration("DROP INDEX IF EXISTS observation_logs_trial_name_time"),
+	},
+	{
+		Version:     4,
+		Description: "Add step column to observation_logs table",
+		Up:          common.ExecMigration("ALTER TABLE observation_logs ADD COLUMN IF NOT EXISTS step BIGINT"),
+		Down:        common.ExecMigration("ALTER TABLE observation_logs DROP COLUMN IF EXISTS step"),
+	},
+	{
+		Version:     5,
+		Description: "Create observation_log_batches table",
+		Up: common.ExecMigration(`CREATE TABLE IF NOT EXISTS observation_log_batches
+		(namespace VARCHAR(255) NOT NULL,
+		trial_name VARCHAR(255) NOT NULL,
+		batch_id VARCHAR(255) NOT NULL,
+		created_at TIMESTAMP(6) NOT NULL,
+		PRIMARY KEY (namespace, trial_name, batch_id))`),
+		Down: common.ExecMigration("DROP TABLE IF EXISTS observation_log_batches"),
+	},
+	{
+		Version:     6,
+		Description: "Add worker column to observation_logs table",
+		// Existing rows keep an empty worker.
+		Up:   common.ExecMigration("ALTER TABLE observation_logs ADD COLUMN IF NOT EXISTS worker VARCHAR(255) NOT NULL DEFAULT ''"),
+		Down: common.ExecMigration("ALTER TABLE observation_logs DROP COLUMN IF EXISTS worker"),
+	},
+}
@@ -20,6 +20,7 @@ import (
 	"database/sql"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	_ "github.com/lib/pq"
@@ -27,6 +28,7 @@ import (
 	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
 	"github.com/kubeflow/katib/pkg/util/v1beta1/env"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog"
 )
 
@@ -58,6 +60,15 @@ func getDbName() string {
 	return psqlInfo
 }
 
+// paramList returns n comma separated parameters starting from $start.
+func paramList(start int, n int) string {
+	params := make([]string, 0, n)
+	for i := start; i < start+n; i++ {
+		params = append(params, fmt.Sprintf("$%d", i))
+	}
+	return strings.Join(params, ", ")
+}
+
 func NewDBInterface(connectTimeout time.Duration) (common.KatibDBInterface, error) {
 	db, err := common.OpenSQLConn(dbDriver, getDbName(), common.ConnectInterval, connectTimeout)
 	if err != nil {
@@ -66,8 +77,8 @@ func NewDBInterface(connectTimeout time.Duration) (common.KatibDBInterface, erro
 	return &dbConn{db: db}, nil
 }
 
-func (d *dbConn) RegisterObservationLog(trialName string, observationLog *v1beta1.ObservationLog) error {
-	statement := "INSERT INTO observation_logs (trial_name, time, metric_name, value) VALUES "
+func (d *dbConn) RegisterObservationLog(namespace string, trialName string, batchID string, observationLog *v1beta1.ObservationLog) error {
+	statement := "INSERT INTO observation_logs (namespace, trial_name, time, metric_name, value, step, worker) VALUES "
 	values := []interface{}{}
 
 	index_of_qparam := 1
@@ -81,71 +92,163 @@ func (d *dbConn) RegisterObservationLog(trialName string, observationLog *v1beta
 		}
 		sqlTimeStr := t.UTC().Format(time.RFC3339Nano)
 
-		statement += fmt.Sprintf("($%d, $%d, $%d, $%d),",
-			index_of_qparam, index_of_qparam+1, index_of_qparam+2, index_of_qparam+3,
+		statement += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d),",
+			index_of_qparam, index_of_qparam+1, index_of_qparam+2, index_of_qparam+3, index_of_qparam+4, index_of_qparam+5, index_of_qparam+6,
 		)
-		values = append(values, trialName, sqlTimeStr, mlog.Metric.Name, mlog.Metric.Value)
-		index_of_qparam += 4
+		values = append(values, namespace, trialName, sqlTimeStr, mlog.Metric.Name, mlog.Metric.Value, mlog.Step, mlog.Worker)
+		index_of_qparam += 7
 	}
 
 	statement = statement[:len(statement)-1]
 
-	// Prepare the statement
-	stmt, err := d.db.Prepare(statement)
+	tx, err := d.db.Begin()
 	if err != nil {
-		return fmt.Errorf("Prepare SQL statement failed: %v", err)
+		return fmt.Errorf("Begin SQL transaction failed: %v", err)
+	}
+	defer tx.Rollback()
+
+	// The batch row is locked until the transaction is committed, so the concurrent retries of the batch wait for it.
+	if batchID != "" {
+		result, err := tx.Exec(`INSERT INTO observation_log_batches (namespace, trial_name, batch_id, created_at) VALUES ($1, $2, $3, $4)
+			ON CONFLICT DO NOTHING`, namespace, trialName, batchID, time.Now().UTC().Format(time.RFC3339Nano))
+		if err != nil {
+			return fmt.Errorf("Execute SQL INSERT failed: %v", err)
+		}
+		if inserted, err := result.RowsAffected(); err != nil {
+			return fmt.Errorf("Execute SQL INSERT failed: %v", err)
+		} else if inserted == 0 {
+			return common.ErrObservationLogBatchExists
+		}
 	}
 
-	// Defer Close the statement
-	defer stmt.Close()
+	if len(values) != 0 {
+		// Prepare the statement
+		stmt, err := tx.Prepare(statement)
+		if err != nil {
+			return fmt.Errorf("Prepare SQL statement failed: %v", err)
+		}
+
+		// Defer Close the statement
+		defer stmt.Close()
 
-	// Execute INSERT
-	_, err = stmt.Exec(values...)
-	if err != nil {
-		return fmt.Errorf("Execute SQL INSERT failed: %v", err)
+		// Execute INSERT
+		_, err = stmt.Exec(values...)
+		if err != nil {
+			return fmt.Errorf("Execute SQL INSERT failed: %v", err)
+		}
 	}
 
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("Commit SQL transaction failed: %v", err)
+	}
 	return nil
 }
 
-func (d *dbConn) GetObservationLog(trialName string, metricName string, startTime string, endTime string) (*v1beta1.ObservationLog, error) {
-	qfield := []interface{}{trialName}
+// Logs are ordered by time and id, so the page token refers to the last log of the previous page.
+// All logs are returned if the page size is 0.
+func (d *dbConn) GetObservationLog(request *v1beta1.GetObservationLogRequest) (*v1beta1.ObservationLog, string, error) {
+	qfield := []interface{}{}
 	qstr := ""
 	index_of_qparam := 1
 
-	base_stmt := fmt.Sprintf("SELECT time, metric_name, value FROM observation_logs WHERE trial_name = $%d", index_of_qparam)
-	index_of_qparam += 1
-
-	if metricName != "" {
-		qstr += fmt.Sprintf(" AND metric_name = $%d", index_of_qparam)
-		qfield = append(qfield, metricName)
-		index_of_qparam += 1
+	trialNames := common.ObservationLogTrialNames(request)
+	for _, trialName := range trialNames {
+		qfield = append(qfield, trialName)
+	}
+	qfield = append(qfield, request.Namespace)
+	base_stmt := fmt.Sprintf("SELECT id, time, metric_name, value, step, trial_name, worker FROM observation_logs WHERE trial_name IN (%s) AND namespace = $%d",
+		paramList(index_of_qparam, len(trialNames)), index_of_qparam+len(trialNames))
+	index_of_qparam += len(trialNames) + 1
+
+	metricNames := common.ObservationLogMetricNames(request)
+	if len(metricNames) != 0 {
+		qstr += fmt.Sprintf(" AND metric_name IN (%s)", paramList(index_of_qparam, len(metricNames)))
+		for _, metricName := range metricNames {
+			qfield = append(qfield, metricName)
+		}
+		index_of_qparam += len(metricNames)
+	}
+	if request.Rank0Worker {
+		workers, err := d.getRank0Workers(trialNames, request.Namespace, metricNames)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(workers) == 0 {
+			return &v1beta1.ObservationLog{MetricLogs: []*v1beta1.MetricLog{}}, "", nil
+		}
+		conditions := make([]string, 0, len(workers))
+		for _, w := range workers {
+			conditions = append(conditions, fmt.Sprintf("(trial_name = $%d AND metric_name = $%d AND worker = $%d)",
+				index_of_qparam, index_of_qparam+1, index_of_qparam+2))
+			qfield = append(qfield, w.TrialName, w.MetricName, w.Worker)
+			index_of_qparam += 3
+		}
+		qstr += " AND (" + strings.Join(conditions, " OR ") + ")"
 	}
 
-	if startTime != "" {
+	if startTime := request.StartTime; startTime != "" {
 		s_time, err := time.Parse(time.RFC3339Nano, startTime)
 		if err != nil {
-			return nil, fmt.Errorf("Error parsing start time %s: %v", startTime, err)
+			return nil, "", fmt.Errorf("Error parsing start time %s: %v", startTime, err)
 		}
 		formattedStartTime := s_time.UTC().Format(time.RFC3339Nano)
 		qstr += fmt.Sprintf(" AND time >= $%d", index_of_qparam)
 		qfield = append(qfield, formattedStartTime)
 		index_of_qparam += 1
 	}
-	if endTime != "" {
+	if endTime := request.EndTime; endTime != "" {
 		e_time, err := time.Parse(time.RFC3339Nano, endTime)
 		if err != nil {
-			return nil, fmt.Errorf("Error parsing completion time %s: %v", endTime, err)
+			return nil, "", fmt.Errorf("Error parsing completion time %s: %v", endTime, err)
 		}
 		formattedEndTime := e_time.UTC().Format(time.RFC3339Nano)
 		qstr += fmt.Sprintf(" AND time <= $%d", index_of_qparam)
 		qfield = append(qfield, formattedEndTime)
-		// index_of_qparam += 1  // if any other filters are added, this should be incremented
+		index_of_qparam += 1
+	}
+	if request.StartStep != nil {
+		qstr += fmt.Sprintf(" AND step >= $%d", index_of_qparam)
+		qfield = append(qfield, *request.StartStep)
+		index_of_qparam += 1
+	}
+	if request.EndStep != nil {
+		qstr += fmt.Sprintf(" AND step <= $%d", index_of_qparam)
+		qfield = append(qfield, *request.EndStep)
+		index_of_qparam += 1
+	}
+	if request.LogsPerTrial > 0 {
+		// The first logs of each Trial are selected before the page.
+		base_stmt = fmt.Sprintf(`SELECT id, time, metric_name, value, step, trial_name, worker FROM
+			(SELECT id, time, metric_name, value, step, trial_name, worker,
+			ROW_NUMBER() OVER (PARTITION BY trial_name ORDER BY time, id) AS trial_row
+			FROM observation_logs WHERE trial_name IN (%s) AND namespace = $%d%s) trial_logs
+			WHERE trial_row <= $%d`,
+			paramList(1, len(trialNames)), len(trialNames)+1, qstr, index_of_qparam)
+		qstr = ""
+		qfield = append(qfield, request.LogsPerTrial)
+		index_of_qparam += 1
+	}
+	pageSize := request.PageSize
+	if request.PageToken != "" {
+		lastTime, lastID, err := common.DecodePageToken(request.PageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		qstr += fmt.Sprintf(" AND (time > $%d OR (time = $%d AND id > $%d))",
+			index_of_qparam, index_of_qparam, index_of_qparam+1)
+		qfield = append(qfield, lastTime.UTC().Format(time.RFC3339Nano), lastID)
+		index_of_qparam += 2
+	}
+	qstr += " ORDER BY time, id"
+	if pageSize > 0 {
+		// Fetch one more log to know if there is a next page.
+		qstr += fmt.Sprintf(" LIMIT $%d", index_of_qparam)
+		qfield = append(qfield, pageSize+1)
 	}
 
-	rows, err := d.db.Query(base_stmt+qstr+" ORDER BY time", qfield...)
+	rows, err := d.db.Query(base_stmt+qstr, qfield...)
 	if err != nil {
-		return nil, fmt.Errorf("Failed to get ObservationLogs %v", err)
+		return nil, "", fmt.Errorf("Failed to get ObservationLogs %v", err)
 	}
 
 	// Defer Close the rows
@@ -154,9 +257,18 @@ func (d *dbConn) GetObservationLog(trialName string, metricName string, startTim
 	result := &v1beta1.ObservationLog{
 		MetricLogs: []*v1beta1.MetricLog{},
 	}
-	for rows.Next() {
-		var mname, mvalue, sqlTimeStr string
-		err := rows.Scan(&sqlTimeStr, &mname, &mvalue)
+	var lastTime time.Time
+	var lastID int64
+	nextPageToken := ""
+	for count := int32(1); rows.Next(); count++ {
+		if pageSize > 0 && count > pageSize {
+			nextPageToken = common.EncodePageToken(lastTime, lastID)
+			break
+		}
+		var id int64
+		var mname, mvalue, sqlTimeStr, trialName, worker string
+		var step sql.NullInt64
+		err := rows.Scan(&id, &sqlTimeStr, &mname, &mvalue, &step, &trialName, &worker)
 		if err != nil {
 			klog.Errorf("Error scanning log: %v", err)
 			continue
@@ -166,21 +278,188 @@ func (d *dbConn) GetObservationLog(trialName string, metricName string, startTim
 			klog.Errorf("Error parsing time %s: %v", sqlTimeStr, err)
 			continue
 		}
+		lastTime, lastID = ptime, id
 		timeStamp := ptime.UTC().Format(time.RFC3339Nano)
-		result.MetricLogs = append(result.MetricLogs, &v1beta1.MetricLog{
+		mlog := &v1beta1.MetricLog{
 			TimeStamp: timeStamp,
 			Metric: &v1beta1.Metric{
 				Name:  mname,
 				Value: mvalue,
 			},
-		})
+			Worker: worker,
+		}
+		if step.Valid {
+			mlog.Step = &step.Int64
+		}
+		if len(request.TrialNames) != 0 {
+			mlog.TrialName = trialName
+		}
+		result.MetricLogs = append(result.MetricLogs, mlog)
 	}
 
-	return result, nil
+	return result, nextPageToken, nil
 }
 
-func (d *dbConn) DeleteObservationLog(trialName string) error {
-	_, err := d.db.Exec("DELETE FROM observation_logs WHERE trial_name = $1", trialName)
+func (d *dbConn) DeleteObservationLog(namespace string, trialName string) error {
+	_, err := d.db.Exec("DELETE FROM observation_logs WHERE trial_name = $1 AND namespace = $2", trialName, namespace)
+	if err != nil {
+		return err
+	}
+	_, err = d.db.Exec("DELETE FROM observation_log_batches WHERE trial_name = $1 AND namespace = $2", trialName, namespace)
 
 	return err
 }
+
+func (d *dbConn) BackfillObservationLogNamespace(namespace string, trialName string) (int64, error) {
+	result, err := d.db.Exec("UPDATE observation_logs SET namespace = $1 WHERE trial_name = $2 AND namespace = ''", namespace, trialName)
+	if err != nil {
+		return 0, fmt.Errorf("Failed to backfill ObservationLog namespace %v", err)
+	}
+	return result.RowsAffected()
+}
+
+// getRank0Workers returns the rank 0 worker of every metric of the Trials.
+func (d *dbConn) getRank0Workers(trialNames []string, namespace string, metricNames []string) ([]common.MetricWorker, error) {
+	qfield := []interface{}{}
+	for _, trialName := range trialNames {
+		qfield = append(qfield, trialName)
+	}
+	qfield = append(qfield, namespace)
+	qstr := ""
+	if len(metricNames) != 0 {
+		qstr += fmt.Sprintf(" AND metric_name IN (%s)", paramList(len(trialNames)+2, len(metricNames)))
+		for _, metricName := range metricNames {
+			qfield = append(qfield, metricName)
+		}
+	}
+	rows, err := d.db.Query(fmt.Sprintf("SELECT DISTINCT trial_name, metric_name, worker FROM observation_logs WHERE trial_name IN (%s) AND namespace = $%d",
+		paramList(1, len(trialNames)), len(trialNames)+1)+qstr, qfield...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get workers of ObservationLogs %v", err)
+	}
+	defer rows.Close()
+	workers := []common.MetricWorker{}
+	for rows.Next() {
+		var w common.MetricWorker
+		if err := rows.Scan(&w.TrialName, &w.MetricName, &w.Worker); err != nil {
+			return nil, err
+		}
+		workers = append(workers, w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return common.Rank0Workers(workers), nil
+}
+
+func (d *dbConn) GetObservationSummary(namespace string, trialName string, metricNames []string) ([]*v1beta1.MetricSummary, error) {
+	numericValue := "CASE WHEN value ~ $1 THEN CAST(value AS DOUBLE PRECISION) END"
+	qfield := []interface{}{common.NumericValueRegexp, trialName, namespace}
+	qstr := ""
+	if len(metricNames) != 0 {
+		placeholders := []string{}
+		for _, metricName := range metricNames {
+			qfield = append(qfield, metricName)
+			placeholders = append(placeholders, fmt.Sprintf("$%d", len(qfield)))
+		}
+		qstr += " AND metric_name IN (" + strings.Join(placeholders, ", ") + ")"
+	}
+	rows, err := d.db.Query(`SELECT metric_name, worker, value, metric_count, min_value, max_value FROM
+		(SELECT metric_name, worker, value,
+		COUNT(*) OVER (PARTITION BY metric_name, worker) AS metric_count,
+		MIN(`+numericValue+`) OVER (PARTITION BY metric_name, worker) AS min_value,
+		MAX(`+numericValue+`) OVER (PARTITION BY metric_name, worker) AS max_value,
+		ROW_NUMBER() OVER (PARTITION BY metric_name, worker ORDER BY time DESC, id DESC) AS row_num
+		FROM observation_logs WHERE trial_name = $2 AND namespace = $3`+qstr+`) summary
+		WHERE row_num = 1 ORDER BY metric_name, worker`,
+		qfield...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get ObservationSummary %v", err)
+	}
+	defer rows.Close()
+	result := []*v1beta1.MetricSummary{}
+	for rows.Next() {
+		var mname, worker, latest string
+		var count int64
+		var minValue, maxValue sql.NullFloat64
+		if err := rows.Scan(&mname, &worker, &latest, &count, &minValue, &maxValue); err != nil {
+			return nil, fmt.Errorf("Error scanning summary: %v", err)
+		}
+		result = append(result, common.NewMetricSummary(mname, worker, latest, count, minValue, maxValue))
+	}
+	return result, rows.Err()
+}
+
+// DownsampleObservationLog keeps the minimum, maximum and latest log of every metric in each bucket
+// for the logs older than before and deletes at most limit of the rest.
+func (d *dbConn) DownsampleObservationLog(before time.Time, bucket time.Duration, limit int) (int64, error) {
+	numericValue := "CASE WHEN value ~ $1 THEN CAST(value AS DOUBLE PRECISION) END"
+	bucketPartition := "PARTITION BY namespace, trial_name, metric_name, worker, bucket"
+	result, err := d.db.Exec(`DELETE FROM observation_logs WHERE id IN (SELECT id FROM
+		(SELECT id,
+		ROW_NUMBER() OVER (`+bucketPartition+` ORDER BY time DESC, id DESC) AS latest_num,
+		ROW_NUMBER() OVER (`+bucketPartition+` ORDER BY numeric_value IS NULL, numeric_value, id) AS min_num,
+		ROW_NUMBER() OVER (`+bucketPartition+` ORDER BY numeric_value IS NULL, numeric_value DESC, id) AS max_num
+		FROM (SELECT id, namespace, trial_name, metric_name, worker, time, `+numericValue+` AS numeric_value,
+		FLOOR(EXTRACT(EPOCH FROM time) / $2) AS bucket
+		FROM observation_logs WHERE time < $3) logs) ranked
+		WHERE latest_num > 1 AND min_num > 1 AND max_num > 1 LIMIT $4)`,
+		common.NumericValueRegexp, int64(bucket.Seconds()), before.UTC().Format(time.RFC3339Nano), limit)
+	if err != nil {
+		return 0, fmt.Errorf("Failed to downsample ObservationLogs %v", err)
+	}
+	return result.RowsAffected()
+}
+
+// ExpireObservationLog deletes the logs of the trials which haven't reported any log since before.
+func (d *dbConn) ExpireObservationLog(before time.Time) (int64, error) {
+	result, err := d.db.Exec(`DELETE FROM observation_logs WHERE (namespace, trial_name) IN
+		(SELECT namespace, trial_name FROM
+		(SELECT namespace, trial_name FROM observation_logs GROUP BY namespace, trial_name HAVING MAX(time) < $1) expired)`,
+		before.UTC().Format(time.RFC3339Nano))
+	if err != nil {
+		return 0, fmt.Errorf("Failed to expire ObservationLogs %v", err)
+	}
+	// Batches are only kept to drop the retried reports, so they expire with the logs.
+	if _, err = d.db.Exec("DELETE FROM observation_log_batches WHERE created_at < $1", before.UTC().Format(time.RFC3339Nano)); err != nil {
+		return 0, fmt.Errorf("Failed to expire ObservationLog batches %v", err)
+	}
+	return result.RowsAffected()
+}
+
+// ListObservationLogTrials returns the trials which have logs.
+// Rows stored before the namespace column was added are skipped until their namespace is backfilled.
+func (d *dbConn) ListObservationLogTrials() ([]types.NamespacedName, error) {
+	rows, err := d.db.Query("SELECT DISTINCT namespace, trial_name FROM observation_logs WHERE namespace <> ''")
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list ObservationLog trials %v", err)
+	}
+	defer rows.Close()
+	result := []types.NamespacedName{}
+	for rows.Next() {
+		var trial types.NamespacedName
+		if err := rows.Scan(&trial.Namespace, &trial.Name); err != nil {
+			return nil, fmt.Errorf("Error scanning trial: %v", err)
+		}
+		result = append(result, trial)
+	}
+	return result, rows.Err()
+}
+
+// ListLegacyObservationLogTrials returns the names of the trials which have logs without namespace.
+func (d *dbConn) ListLegacyObservationLogTrials() ([]string, error) {
+	rows, err := d.db.Query("SELECT DISTINCT trial_name FROM observation_logs WHERE namespace = '' ORDER BY trial_name")
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list legacy ObservationLog trials %v", err)
+	}
+	defer rows.Close()
+	result := []string{}
+	for rows.Next() {
+		var trialName string
+		if err := rows.Scan(&trialName); err != nil {
+			return nil, fmt.Errorf("Error scanning trial: %v", err)
+		}
+		result = append(result, trialName)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlite
+
+import (
+	"fmt"
+
+	"k8s.io/klog"
+
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/env"
+)
+
+func (d *dbConn) DBInit() {
+	skipDbInitialization := env.GetEnvOrDefault(common.SkipDbInitializationEnvName, "false")
+
+	if skipDbInitialization == "false" {
+		klog.Info("Initializing v1beta1 DB schema")
+
+		if err := d.MigrateUp(); err != nil {
+			klog.Fatalf("Error migrating v1beta1 DB schema: %v", err)
+		}
+	} else {
+		klog.Info("Skipping v1beta1 DB schema initialization.")
+
+		status, err := d.MigrationStatus()
+		if err != nil {
+			klog.Fatalf("Error validating v1beta1 DB schema: %v", err)
+		}
+		if status.CurrentVersion < status.LatestVersion {
+			klog.Fatalf("DB schema version %d is older than %d, run `katib-db-manager migrate up` to upgrade it",
+				status.CurrentVersion, status.LatestVersion)
+		}
+	}
+}
+
+func (d *dbConn) migrator() *common.Migrator {
+	return common.NewMigrator(d.db, migrationDialect, migrations)
+}
+
+func (d *dbConn) MigrateUp() error {
+	return d.migrator().Up()
+}
+
+func (d *dbConn) MigrateDown(steps int) error {
+	return d.migrator().Down(steps)
+}
+
+func (d *dbConn) MigrationStatus() (*common.MigrationStatus, error) {
+	return d.migrator().Status()
+}
+
+func (d *dbConn) SelectOne() error {
+	db := d.db
+	_, err := db.Exec(`SELECT 1`)
+	if err != nil {
+		return fmt.Errorf("Error `SELECT 1` probing: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlite
+
+import (
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
+)
+
+// SQLite allows a single writer, so migrations don't need an additional lock.
+var migrationDialect = common.MigrationDialect{
+	VersionTableExists: "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'schema_version'",
+	CreateVersionTable: `CREATE TABLE IF NOT EXISTS schema_version
+		(version INTEGER PRIMARY KEY,
+		description TEXT NOT NULL,
+		applied_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP)`,
+	InsertVersion: "INSERT INTO schema_version (version, description) VALUES (?, ?)",
+	DeleteVersion: "DELETE FROM schema_version WHERE version = ?",
+}
+
+// migrations must be sorted by version and released migrations must never be changed.
+var migrations = []common.Migration{
+	{
+		Version:     1,
+		Description: "Create observation_logs table",
+		Up: common.ExecMigration(`CREATE TABLE IF NOT EXISTS observation_logs
+		(trial_name TEXT NOT NULL,
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		time TEXT,
+		metric_name TEXT NOT NULL,
+		value TEXT NOT NULL,
+		namespace TEXT NOT NULL DEFAULT '')`),
+		Down: common.ExecMigration("DROP TABLE IF EXISTS observation_logs"),
+	},
+	{
+		Version:     2,
+		Description: "Add trial_name and time index to observation_logs table",
+		Up:          common.ExecMigration("CREATE INDEX IF NOT EXISTS observation_logs_trial_name_time ON observation_logs (trial_name, time)"),
+		Down:        common.ExecMigration("DROP INDEX IF EXISTS observation_logs_trial_name_time"),
+	},
+	{
+		Version:     3,
+		Description: "Add step column to observation_logs table",
+		Up:          common.ExecMigration("ALTER TABLE observation_logs ADD COLUMN step INTEGER"),
+		Down:        common.ExecMigration("ALTER TABLE observation_logs DROP COLUMN step"),
+	},
+	{
+		Version:     4,
+		Description: "Create observation_log_batches table",
+		Up: common.ExecMigration(`CREATE TABLE IF NOT EXISTS observation_log_batches
+		(namespace TEXT NOT NULL,
+		trial_name TEXT NOT NULL,
+		batch_id TEXT NOT NULL,
+		created_at TEXT NOT NULL,
+		PRIMARY KEY (namespace, trial_name, batch_id))`),
+		Down: common.ExecMigration("DROP TABLE IF EXISTS observation_log_batches"),
+	},
+	{
+		Version:     5,
+		Description: "Add worker column to observation_logs table",
+		// Existing rows keep an empty worker.
+		Up:   common.ExecMigration("ALTER TABLE observation_logs ADD COLUMN worker TEXT NOT NULL DEFAULT ''"),
+		Down: common.ExecMigration("ALTER TABLE observation_logs DROP COLUMN worker"),
+	},
+}
@@ -0,0 +1,712 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
+	"k8s.io/apimachinery/pkg/types"
+
+	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
+)
+
+var dbInterface common.KatibDBInterface
+
+func TestMain(m *testing.M) {
+	db, err := sql.Open(dbDriver, "file::memory:")
+	if err != nil {
+		fmt.Printf("error opening db: %v\n", err)
+		os.Exit(1)
+	}
+	// Every connection to ":memory:" gets its own database.
+	db.SetMaxOpenConns(1)
+	dbInterface = &dbConn{db: db}
+	dbInterface.DBInit()
+	if err = dbInterface.SelectOne(); err != nil {
+		fmt.Printf("error `SELECT 1` probing: %v\n", err)
+		os.Exit(1)
+	}
+	os.Exit(m.Run())
+}
+
+func newMetricLog(timeStamp, name, value string) *api_pb.MetricLog {
+	return &api_pb.MetricLog{
+		TimeStamp: timeStamp,
+		Metric: &api_pb.Metric{
+			Name:  name,
+			Value: value,
+		},
+	}
+}
+
+func TestObservationLog(t *testing.T) {
+	obsLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			newMetricLog("2016-12-31T20:02:05.123456Z", "loss", "0.5"),
+			newMetricLog("2016-12-31T20:01:05.123456Z", "f1_score", "88.95"),
+			newMetricLog("2016-12-31T20:03:05.123456Z", "f1_score", "89.01"),
+			newMetricLog("", "f1_score", "0"),
+		},
+	}
+	if err := dbInterface.RegisterObservationLog("test-namespace", "test1_trial1", "", obsLog); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+	otherLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			newMetricLog("2016-12-31T20:01:05.123456Z", "f1_score", "12.3"),
+		},
+	}
+	if err := dbInterface.RegisterObservationLog("other-namespace", "test1_trial1", "", otherLog); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+	defer dbInterface.DeleteObservationLog("other-namespace", "test1_trial1")
+
+	cases := map[string]struct {
+		namespace  string
+		metricName string
+		startTime  string
+		endTime    string
+		want       []*api_pb.MetricLog
+	}{
+		"all metrics ordered by time": {
+			namespace: "test-namespace",
+			want: []*api_pb.MetricLog{
+				newMetricLog("2016-12-31T20:01:05.123456Z", "f1_score", "88.95"),
+				newMetricLog("2016-12-31T20:02:05.123456Z", "loss", "0.5"),
+				newMetricLog("2016-12-31T20:03:05.123456Z", "f1_score", "89.01"),
+			},
+		},
+		"filter by metric name and time range": {
+			namespace:  "test-namespace",
+			metricName: "f1_score",
+			startTime:  "2016-12-31T20:02:00Z",
+			endTime:    "2016-12-31T20:04:00Z",
+			want: []*api_pb.MetricLog{
+				newMetricLog("2016-12-31T20:03:05.123456Z", "f1_score", "89.01"),
+			},
+		},
+		"other namespace": {
+			namespace: "other-namespace",
+			want: []*api_pb.MetricLog{
+				newMetricLog("2016-12-31T20:01:05.123456Z", "f1_score", "12.3"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, _, err := dbInterface.GetObservationLog(&api_pb.GetObservationLogRequest{
+				TrialName:  "test1_trial1",
+				Namespace:  tc.namespace,
+				MetricName: tc.metricName,
+				StartTime:  tc.startTime,
+				EndTime:    tc.endTime,
+			})
+			if err != nil {
+				t.Fatalf("GetObservationLog failed: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got.MetricLogs, protocmp.Transform()); diff != "" {
+				t.Errorf("Unexpected observation logs (-want,+got):\n%s", diff)
+			}
+		})
+	}
+
+	if err := dbInterface.DeleteObservationLog("test-namespace", "test1_trial1"); err != nil {
+		t.Fatalf("DeleteObservationLog failed: %v", err)
+	}
+	got, _, err := dbInterface.GetObservationLog(&api_pb.GetObservationLogRequest{
+		TrialName: "test1_trial1",
+		Namespace: "test-namespace",
+	})
+	if err != nil {
+		t.Fatalf("GetObservationLog failed: %v", err)
+	}
+	if len(got.MetricLogs) != 0 {
+		t.Errorf("Expected no observation logs after delete, got %v", got.MetricLogs)
+	}
+	got, _, err = dbInterface.GetObservationLog(&api_pb.GetObservationLogRequest{
+		TrialName: "test1_trial1",
+		Namespace: "other-namespace",
+	})
+	if err != nil {
+		t.Fatalf("GetObservationLog failed: %v", err)
+	}
+	if len(got.MetricLogs) != 1 {
+		t.Errorf("Expected observation logs in other namespace to be kept, got %v", got.MetricLogs)
+	}
+}
+
+func TestBackfillObservationLogNamespace(t *testing.T) {
+	// Logs stored before the namespace column was added have an empty namespace.
+	legacyLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			newMetricLog("2016-12-31T20:01:05.123456Z", "loss", "0.5"),
+			newMetricLog("2016-12-31T20:02:05.123456Z", "loss", "0.4"),
+		},
+	}
+	if err := dbInterface.RegisterObservationLog("", "legacy_trial", "", legacyLog); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+	defer dbInterface.DeleteObservationLog("test-namespace", "legacy_trial")
+
+	getLogs := func(namespace string) []*api_pb.MetricLog {
+		got, _, err := dbInterface.GetObservationLog(&api_pb.GetObservationLogRequest{
+			TrialName: "legacy_trial",
+			Namespace: namespace,
+		})
+		if err != nil {
+			t.Fatalf("GetObservationLog failed: %v", err)
+		}
+		return got.MetricLogs
+	}
+
+	// The legacy logs are neither returned nor deleted for any namespace until their namespace is backfilled.
+	if got := getLogs("test-namespace"); len(got) != 0 {
+		t.Errorf("Expected no legacy logs before backfill, got %v", got)
+	}
+	if err := dbInterface.DeleteObservationLog("other-namespace", "legacy_trial"); err != nil {
+		t.Fatalf("DeleteObservationLog failed: %v", err)
+	}
+	legacyTrials, err := dbInterface.ListLegacyObservationLogTrials()
+	if err != nil {
+		t.Fatalf("ListLegacyObservationLogTrials failed: %v", err)
+	}
+	if diff := cmp.Diff([]string{"legacy_trial"}, legacyTrials); diff != "" {
+		t.Errorf("Unexpected legacy trials (-want,+got):\n%s", diff)
+	}
+
+	updated, err := dbInterface.BackfillObservationLogNamespace("test-namespace", "legacy_trial")
+	if err != nil {
+		t.Fatalf("BackfillObservationLogNamespace failed: %v", err)
+	}
+	if updated != 2 {
+		t.Errorf("Expected 2 backfilled logs, got %d", updated)
+	}
+	if got := getLogs("test-namespace"); len(got) != 2 {
+		t.Errorf("Expected the backfilled logs in their namespace, got %v", got)
+	}
+	if got := getLogs("other-namespace"); len(got) != 0 {
+		t.Errorf("Expected no backfilled logs in other namespace, got %v", got)
+	}
+	legacyTrials, err = dbInterface.ListLegacyObservationLogTrials()
+	if err != nil {
+		t.Fatalf("ListLegacyObservationLogTrials failed: %v", err)
+	}
+	if len(legacyTrials) != 0 {
+		t.Errorf("Expected no legacy trials after backfill, got %v", legacyTrials)
+	}
+}
+
+func TestRegisterObservationLogBatch(t *testing.T) {
+	obsLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			newMetricLog("2016-12-31T20:02:05.123456Z", "loss", "0.5"),
+		},
+	}
+	if err := dbInterface.RegisterObservationLog("test-namespace", "batch_trial", "batch-1", obsLog); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+	defer dbInterface.DeleteObservationLog("test-namespace", "batch_trial")
+
+	err := dbInterface.RegisterObservationLog("test-namespace", "batch_trial", "batch-1", obsLog)
+	if !errors.Is(err, common.ErrObservationLogBatchExists) {
+		t.Fatalf("Expected ErrObservationLogBatchExists, got %v", err)
+	}
+	if err = dbInterface.RegisterObservationLog("test-namespace", "batch_trial", "batch-2", obsLog); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+
+	got, _, err := dbInterface.GetObservationLog(&api_pb.GetObservationLogRequest{
+		TrialName: "batch_trial",
+		Namespace: "test-namespace",
+	})
+	if err != nil {
+		t.Fatalf("GetObservationLog failed: %v", err)
+	}
+	if len(got.MetricLogs) != 2 {
+		t.Errorf("Expected the duplicated batch to be dropped, got %v", got.MetricLogs)
+	}
+}
+
+func TestGetObservationLogPages(t *testing.T) {
+	obsLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			newMetricLog("2016-12-31T20:01:05.123456Z", "loss", "0.9"),
+			newMetricLog("2016-12-31T20:02:05.123456Z", "loss", "0.8"),
+			newMetricLog("2016-12-31T20:02:05.123456Z", "loss", "0.7"),
+			newMetricLog("2016-12-31T20:02:05.123456Z", "loss", "0.6"),
+			newMetricLog("2016-12-31T20:03:05.123456Z", "loss", "0.5"),
+		},
+	}
+	if err := dbInterface.RegisterObservationLog("test-namespace", "paged_trial", "", obsLog); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+	defer dbInterface.DeleteObservationLog("test-namespace", "paged_trial")
+
+	var got []*api_pb.MetricLog
+	pages := 0
+	pageToken := ""
+	for {
+		page, nextPageToken, err := dbInterface.GetObservationLog(&api_pb.GetObservationLogRequest{
+			TrialName:  "paged_trial",
+			Namespace:  "test-namespace",
+			MetricName: "loss",
+			PageSize:   2,
+			PageToken:  pageToken,
+		})
+		if err != nil {
+			t.Fatalf("GetObservationLog failed: %v", err)
+		}
+		got = append(got, page.MetricLogs...)
+		pages++
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	if pages != 3 {
+		t.Errorf("Expected 3 pages, got %d", pages)
+	}
+	if diff := cmp.Diff(obsLog.MetricLogs, got, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected observation logs (-want,+got):\n%s", diff)
+	}
+
+	if _, _, err := dbInterface.GetObservationLog(&api_pb.GetObservationLogRequest{
+		TrialName: "paged_trial",
+		Namespace: "test-namespace",
+		PageSize:  2,
+		PageToken: "invalid",
+	}); err == nil {
+		t.Errorf("Expected error for invalid page token")
+	}
+}
+
+func TestGetObservationLogStepRange(t *testing.T) {
+	newStepLog := func(timeStamp, value string, step int64) *api_pb.MetricLog {
+		mlog := newMetricLog(timeStamp, "loss", value)
+		mlog.Step = &step
+		return mlog
+	}
+	obsLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			newStepLog("2016-12-31T20:01:05.123456Z", "0.9", 1),
+			newStepLog("2016-12-31T20:02:05.123456Z", "0.8", 2),
+			newStepLog("2016-12-31T20:03:05.123456Z", "0.7", 3),
+			newMetricLog("2016-12-31T20:04:05.123456Z", "loss", "0.6"),
+		},
+	}
+	if err := dbInterface.RegisterObservationLog("test-namespace", "step_trial", "", obsLog); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+	defer dbInterface.DeleteObservationLog("test-namespace", "step_trial")
+
+	startStep, endStep := int64(2), int64(3)
+	cases := map[string]struct {
+		startStep *int64
+		endStep   *int64
+		want      []*api_pb.MetricLog
+	}{
+		"no step range": {
+			want: obsLog.MetricLogs,
+		},
+		"start step": {
+			startStep: &startStep,
+			want:      obsLog.MetricLogs[1:3],
+		},
+		"start and end step": {
+			startStep: &startStep,
+			endStep:   &startStep,
+			want:      obsLog.MetricLogs[1:2],
+		},
+		"end step": {
+			endStep: &endStep,
+			want:    obsLog.MetricLogs[0:3],
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, _, err := dbInterface.GetObservationLog(&api_pb.GetObservationLogRequest{
+				TrialName: "step_trial",
+				Namespace: "test-namespace",
+				StartStep: tc.startStep,
+				EndStep:   tc.endStep,
+			})
+			if err != nil {
+				t.Fatalf("GetObservationLog failed: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got.MetricLogs, protocmp.Transform()); diff != "" {
+				t.Errorf("Unexpected observation logs (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetObservationLogTrials(t *testing.T) {
+	for _, trialName := range []string{"batch_trial1", "batch_trial2", "batch_trial3"} {
+		obsLog := &api_pb.ObservationLog{
+			MetricLogs: []*api_pb.MetricLog{
+				newMetricLog("2016-12-31T20:01:05.123456Z", "loss", "0.5"),
+				newMetricLog("2016-12-31T20:02:05.123456Z", "f1_score", "88.95"),
+				newMetricLog("2016-12-31T20:03:05.123456Z", "recall", "0.7"),
+			},
+		}
+		if err := dbInterface.RegisterObservationLog("test-namespace", trialName, "", obsLog); err != nil {
+			t.Fatalf("RegisterObservationLog failed: %v", err)
+		}
+		defer dbInterface.DeleteObservationLog("test-namespace", trialName)
+	}
+	newTrialLog := func(trialName, timeStamp, name, value string) *api_pb.MetricLog {
+		mlog := newMetricLog(timeStamp, name, value)
+		mlog.TrialName = trialName
+		return mlog
+	}
+
+	got, _, err := dbInterface.GetObservationLog(&api_pb.GetObservationLogRequest{
+		Namespace:   "test-namespace",
+		TrialName:   "batch_trial1",
+		TrialNames:  []string{"batch_trial2", "batch_trial1"},
+		MetricNames: []string{"loss", "f1_score"},
+	})
+	if err != nil {
+		t.Fatalf("GetObservationLog failed: %v", err)
+	}
+	want := []*api_pb.MetricLog{
+		newTrialLog("batch_trial1", "2016-12-31T20:01:05.123456Z", "loss", "0.5"),
+		newTrialLog("batch_trial2", "2016-12-31T20:01:05.123456Z", "loss", "0.5"),
+		newTrialLog("batch_trial1", "2016-12-31T20:02:05.123456Z", "f1_score", "88.95"),
+		newTrialLog("batch_trial2", "2016-12-31T20:02:05.123456Z", "f1_score", "88.95"),
+	}
+	if diff := cmp.Diff(want, got.MetricLogs, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected observation logs (-want,+got):\n%s", diff)
+	}
+
+	// Only the first logs of each Trial are paged.
+	request := &api_pb.GetObservationLogRequest{
+		Namespace:    "test-namespace",
+		TrialNames:   []string{"batch_trial1", "batch_trial2", "batch_trial3"},
+		LogsPerTrial: 2,
+		PageSize:     4,
+	}
+	got, nextPageToken, err := dbInterface.GetObservationLog(request)
+	if err != nil {
+		t.Fatalf("GetObservationLog failed: %v", err)
+	}
+	request.PageToken = nextPageToken
+	nextPage, nextPageToken, err := dbInterface.GetObservationLog(request)
+	if err != nil {
+		t.Fatalf("GetObservationLog failed: %v", err)
+	}
+	if nextPageToken != "" {
+		t.Errorf("Expected no next page, got token %q", nextPageToken)
+	}
+	want = []*api_pb.MetricLog{
+		newTrialLog("batch_trial1", "2016-12-31T20:01:05.123456Z", "loss", "0.5"),
+		newTrialLog("batch_trial2", "2016-12-31T20:01:05.123456Z", "loss", "0.5"),
+		newTrialLog("batch_trial3", "2016-12-31T20:01:05.123456Z", "loss", "0.5"),
+		newTrialLog("batch_trial1", "2016-12-31T20:02:05.123456Z", "f1_score", "88.95"),
+		newTrialLog("batch_trial2", "2016-12-31T20:02:05.123456Z", "f1_score", "88.95"),
+		newTrialLog("batch_trial3", "2016-12-31T20:02:05.123456Z", "f1_score", "88.95"),
+	}
+	if diff := cmp.Diff(want, append(got.MetricLogs, nextPage.MetricLogs...), protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected first logs of each trial (-want,+got):\n%s", diff)
+	}
+}
+
+func TestGetObservationSummary(t *testing.T) {
+	obsLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			newMetricLog("2020-04-13T14:47:38+08:00", "error", "0.03"),
+			newMetricLog("2020-04-13T14:47:39+08:00", "error", "0.02"),
+			newMetricLog("2020-04-13T14:47:40+08:00", "error", "0.01"),
+			newMetricLog("2020-04-13T14:47:41+08:00", "error", "0.05"),
+			newMetricLog("2020-04-13T14:47:41+08:00", "error", "0.06"),
+			newMetricLog("2020-04-13T14:47:41+08:00", "error", "0.07"),
+			newMetricLog("2020-04-12T14:47:42+08:00", "error", "0.1"),
+			newMetricLog("2020-04-13T14:47:38+08:00", "accuracy", "0.7"),
+			newMetricLog("2020-04-13T14:47:39+08:00", "accuracy", "unavailable"),
+			newMetricLog("2020-04-13T14:47:40+08:00", "accuracy", "1e-1"),
+			newMetricLog("2020-04-13T14:47:38+08:00", "loss", "unavailable"),
+		},
+	}
+	if err := dbInterface.RegisterObservationLog("test-namespace", "summary_trial", "", obsLog); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+	defer dbInterface.DeleteObservationLog("test-namespace", "summary_trial")
+
+	cases := map[string]struct {
+		metricNames []string
+		want        []*api_pb.MetricSummary
+	}{
+		"all metrics": {
+			want: []*api_pb.MetricSummary{
+				{Name: "accuracy", Min: "0.1", Max: "0.7", Latest: "1e-1", Count: 3},
+				{Name: "error", Min: "0.01", Max: "0.1", Latest: "0.07", Count: 7},
+				{Name: "loss", Latest: "unavailable", Count: 1},
+			},
+		},
+		"selected metrics": {
+			metricNames: []string{"loss", "error", "precision"},
+			want: []*api_pb.MetricSummary{
+				{Name: "error", Min: "0.01", Max: "0.1", Latest: "0.07", Count: 7},
+				{Name: "loss", Latest: "unavailable", Count: 1},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := dbInterface.GetObservationSummary("test-namespace", "summary_trial", tc.metricNames)
+			if err != nil {
+				t.Fatalf("GetObservationSummary failed: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("Unexpected observation summary (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetObservationSummaryWorkers(t *testing.T) {
+	newWorkerMetricLog := func(timeStamp, value, worker string) *api_pb.MetricLog {
+		mlog := newMetricLog(timeStamp, "loss", value)
+		mlog.Worker = worker
+		return mlog
+	}
+	obsLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			newWorkerMetricLog("2016-12-31T20:00:01Z", "0.5", "worker-1"),
+			newWorkerMetricLog("2016-12-31T20:00:02Z", "0.3", "worker-1"),
+			newWorkerMetricLog("2016-12-31T20:00:01Z", "0.4", "master-0"),
+			newWorkerMetricLog("2016-12-31T20:00:02Z", "0.2", "master-0"),
+			newWorkerMetricLog("2016-12-31T20:00:03Z", "0.6", "master-0"),
+		},
+	}
+	if err := dbInterface.RegisterObservationLog("test-namespace", "workers_trial", "", obsLog); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+	defer dbInterface.DeleteObservationLog("test-namespace", "workers_trial")
+
+	got, err := dbInterface.GetObservationSummary("test-namespace", "workers_trial", nil)
+	if err != nil {
+		t.Fatalf("GetObservationSummary failed: %v", err)
+	}
+	want := []*api_pb.MetricSummary{
+		{Name: "loss", Min: "0.2", Max: "0.6", Latest: "0.6", Count: 3, Worker: "master-0"},
+		{Name: "loss", Min: "0.3", Max: "0.5", Latest: "0.3", Count: 2, Worker: "worker-1"},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected observation summary (-want,+got):\n%s", diff)
+	}
+
+	gotLog, _, err := dbInterface.GetObservationLog(&api_pb.GetObservationLogRequest{TrialName: "workers_trial", Namespace: "test-namespace"})
+	if err != nil {
+		t.Fatalf("GetObservationLog failed: %v", err)
+	}
+	if len(gotLog.MetricLogs) != len(obsLog.MetricLogs) || gotLog.MetricLogs[0].Worker != "worker-1" {
+		t.Errorf("Unexpected observation log: %v", gotLog)
+	}
+
+	gotLog, _, err = dbInterface.GetObservationLog(&api_pb.GetObservationLogRequest{TrialName: "workers_trial", Namespace: "test-namespace", Rank0Worker: true})
+	if err != nil {
+		t.Fatalf("GetObservationLog failed: %v", err)
+	}
+	wantLog := []*api_pb.MetricLog{
+		newWorkerMetricLog("2016-12-31T20:00:01Z", "0.4", "master-0"),
+		newWorkerMetricLog("2016-12-31T20:00:02Z", "0.2", "master-0"),
+		newWorkerMetricLog("2016-12-31T20:00:03Z", "0.6", "master-0"),
+	}
+	if diff := cmp.Diff(wantLog, gotLog.MetricLogs, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected rank 0 observation logs (-want,+got):\n%s", diff)
+	}
+}
+
+func TestDownsampleObservationLog(t *testing.T) {
+	obsLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			newMetricLog("2016-12-31T20:00:01Z", "loss", "0.5"),
+			newMetricLog("2016-12-31T20:00:02Z", "loss", "0.9"),
+			newMetricLog("2016-12-31T20:00:03Z", "loss", "0.1"),
+			newMetricLog("2016-12-31T20:00:04Z", "loss", "0.4"),
+			newMetricLog("2016-12-31T20:00:05Z", "loss", "0.3"),
+			newMetricLog("2016-12-31T20:01:01Z", "loss", "0.2"),
+			newMetricLog("2016-12-31T20:01:02Z", "loss", "unavailable"),
+			newMetricLog("2016-12-31T20:02:01Z", "loss", "0.8"),
+			newMetricLog("2016-12-31T20:02:02Z", "loss", "0.7"),
+			newMetricLog("2016-12-31T20:02:03Z", "loss", "0.6"),
+			newMetricLog("2016-12-31T20:02:04Z", "loss", "0.5"),
+		},
+	}
+	if err := dbInterface.RegisterObservationLog("test-namespace", "downsample_trial", "", obsLog); err != nil {
+		t.Fatalf("RegisterObservationLog failed: %v", err)
+	}
+	defer dbInterface.DeleteObservationLog("test-namespace", "downsample_trial")
+
+	// Logs are deleted in batches of at most limit logs.
+	for _, limit := range []int{1, 100} {
+		deleted, err := dbInterface.DownsampleObservationLog(time.Date(2016, 12, 31, 20, 2, 0, 0, time.UTC), time.Minute, limit)
+		if err != nil {
+			t.Fatalf("DownsampleObservationLog failed: %v", err)
+		}
+		if deleted != 1 {
+			t.Errorf("Expected 1 downsampled log with limit %d, got %d", limit, deleted)
+		}
+	}
+	got, _, err := dbInterface.GetObservationLog(&api_pb.GetObservationLogRequest{
+		TrialName: "downsample_trial",
+		Namespace: "test-namespace",
+	})
+	if err != nil {
+		t.Fatalf("GetObservationLog failed: %v", err)
+	}
+	want := []*api_pb.MetricLog{
+		newMetricLog("2016-12-31T20:00:02Z", "loss", "0.9"),
+		newMetricLog("2016-12-31T20:00:03Z", "loss", "0.1"),
+		newMetricLog("2016-12-31T20:00:05Z", "loss", "0.3"),
+		newMetricLog("2016-12-31T20:01:01Z", "loss", "0.2"),
+		newMetricLog("2016-12-31T20:01:02Z", "loss", "unavailable"),
+		newMetricLog("2016-12-31T20:02:01Z", "loss", "0.8"),
+		newMetricLog("2016-12-31T20:02:02Z", "loss", "0.7"),
+		newMetricLog("2016-12-31T20:02:03Z", "loss", "0.6"),
+		newMetricLog("2016-12-31T20:02:04Z", "loss", "0.5"),
+	}
+	if diff := cmp.Diff(want, got.MetricLogs, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected observation logs (-want,+got):\n%s", diff)
+	}
+
+	deleted, err := dbInterface.DownsampleObservationLog(time.Date(2016, 12, 31, 20, 2, 0, 0, time.UTC), time.Minute, 100)
+	if err != nil {
+		t.Fatalf("DownsampleObservationLog failed: %v", err)
+	}
+	if deleted != 0 {
+		t.Errorf("Expected downsampling to be idempotent, got %d deleted logs", deleted)
+	}
+}
+
+func TestExpireObservationLog(t *testing.T) {
+	for trialName, timeStamp := range map[string]string{
+		"expired_trial": "2016-12-31T20:00:00Z",
+		"active_trial":  "2016-12-31T22:00:00Z",
+	} {
+		obsLog := &api_pb.ObservationLog{
+			MetricLogs: []*api_pb.MetricLog{
+				newMetricLog("2016-12-31T19:00:00Z", "loss", "0.5"),
+				newMetricLog(timeStamp, "loss", "0.4"),
+			},
+		}
+		if err := dbInterface.RegisterObservationLog("test-namespace", trialName, "", obsLog); err != nil {
+			t.Fatalf("RegisterObservationLog failed: %v", err)
+		}
+		defer dbInterface.DeleteObservationLog("test-namespace", trialName)
+	}
+
+	trials, err := dbInterface.ListObservationLogTrials()
+	if err != nil {
+		t.Fatalf("ListObservationLogTrials failed: %v", err)
+	}
+	wantTrials := []types.NamespacedName{
+		{Namespace: "test-namespace", Name: "active_trial"},
+		{Namespace: "test-namespace", Name: "expired_trial"},
+	}
+	sortTrials := cmpopts.SortSlices(func(a, b types.NamespacedName) bool { return a.String() < b.String() })
+	if diff := cmp.Diff(wantTrials, trials, sortTrials); diff != "" {
+		t.Errorf("Unexpected trials (-want,+got):\n%s", diff)
+	}
+
+	deleted, err := dbInterface.ExpireObservationLog(time.Date(2016, 12, 31, 21, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("ExpireObservationLog failed: %v", err)
+	}
+	if deleted != 2 {
+		t.Errorf("Expected 2 expired logs, got %d", deleted)
+	}
+	trials, err = dbInterface.ListObservationLogTrials()
+	if err != nil {
+		t.Fatalf("ListObservationLogTrials failed: %v", err)
+	}
+	if diff := cmp.Diff(wantTrials[:1], trials); diff != "" {
+		t.Errorf("Unexpected trials after expiration (-want,+got):\n%s", diff)
+	}
+}
+
+func TestMigrations(t *testing.T) {
+	hasStepColumn := func() bool {
+		var count int
+		err := dbInterface.(*dbConn).db.QueryRow(
+			"SELECT COUNT(*) FROM pragma_table_info('observation_logs') WHERE name = 'step'").Scan(&count)
+		if err != nil {
+			t.Fatalf("Failed to check step column: %v", err)
+		}
+		return count == 1
+	}
+
+	status, err := dbInterface.MigrationStatus()
+	if err != nil {
+		t.Fatalf("MigrationStatus failed: %v", err)
+	}
+	if status.CurrentVersion != 5 || status.LatestVersion != 5 || len(status.Pending) != 0 {
+		t.Errorf("Unexpected migration status after DBInit: %+v", status)
+	}
+	if !hasStepColumn() {
+		t.Errorf("Expected observation_logs step column after DBInit")
+	}
+
+	if err = dbInterface.MigrateDown(3); err != nil {
+		t.Fatalf("MigrateDown failed: %v", err)
+	}
+	status, err = dbInterface.MigrationStatus()
+	if err != nil {
+		t.Fatalf("MigrationStatus failed: %v", err)
+	}
+	if status.CurrentVersion != 2 || len(status.Pending) != 3 || status.Pending[0].Version != 3 {
+		t.Errorf("Unexpected migration status after MigrateDown: %+v", status)
+	}
+	if hasStepColumn() {
+		t.Errorf("Expected observation_logs step column to be dropped after MigrateDown")
+	}
+
+	if err = dbInterface.MigrateUp(); err != nil {
+		t.Fatalf("MigrateUp failed: %v", err)
+	}
+	status, err = dbInterface.MigrationStatus()
+	if err != nil {
+		t.Fatalf("MigrationStatus failed: %v", err)
+	}
+	if status.CurrentVersion != 5 || len(status.Pending) != 0 {
+		t.Errorf("Unexpected migration status after MigrateUp: %+v", status)
+	}
+	if !hasStepColumn() {
+		t.Errorf("Expected observation_logs step column after MigrateUp")
+	}
+}
+
+func TestGetDbName(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "katib.db")
+	t.Setenv(common.SQLiteDBPathEnvName, dbPath)
+
+	want := "file:" + dbPath + "?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"
+	if got := getDbName(); got != want {
+		t.Errorf("getDbName returned %q, want %q", got, want)
+	}
+}
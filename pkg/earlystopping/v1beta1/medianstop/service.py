@@ -21,6 +21,7 @@ import grpc
 from kubernetes import client, config
 
 from pkg.apis.manager.v1beta1.python import api_pb2, api_pb2_grpc
+from pkg.util.v1beta1.grpctls import grpctls
 
 logger = logging.getLogger()
 logging.basicConfig(level=logging.INFO)
@@ -186,41 +187,59 @@ class MedianStopService(api_pb2_grpc.EarlyStoppingServicer):
                 self.start_step = int(setting.value)
 
     def get_median_value(self, trials: Iterable[api_pb2.Trial]) -> Optional[float]:
-        for trial in trials:
-            # Get metrics only for the new succeeded Trials.
-            if (
-                trial.name not in self.trials_avg_history
-                and trial.status.condition == SUCCEEDED_TRIAL
-            ):
-                with grpc.insecure_channel(
-                    f"{self.db_manager_address[0]}:{self.db_manager_address[1]}"
-                ) as channel:
-                    stub = api_pb2_grpc.DBManagerStub(channel)
-                    get_log_response: api_pb2.GetObservationLogReply = (
-                        stub.GetObservationLog(
-                            api_pb2.GetObservationLogRequest(
-                                trial_name=trial.name, metric_name=self.objective_metric
-                            ),
-                            timeout=APISERVER_TIMEOUT,
-                        )
+        # Get metrics only for the new succeeded Trials.
+        new_trial_names = [
+            trial.name
+            for trial in trials
+            if trial.name not in self.trials_avg_history
+            and trial.status.condition == SUCCEEDED_TRIAL
+        ]
+        if new_trial_names:
+            with grpctls.channel(
+                f"{self.db_manager_address[0]}:{self.db_manager_address[1]}"
+            ) as channel:
+                stub = api_pb2_grpc.DBManagerStub(channel)
+                # Logs of all new Trials are fetched in a single call.
+                # Only the first start_step logs of each Trial are returned,
+                # so the reply doesn't exceed the gRPC message size limit.
+                # Only logs of the rank 0 worker are used, so the logs of the distributed
+                # training workers are not interleaved.
+                get_log_response: api_pb2.GetObservationLogReply = (
+                    stub.GetObservationLog(
+                        api_pb2.GetObservationLogRequest(
+                            trial_names=new_trial_names,
+                            metric_name=self.objective_metric,
+                            namespace=self.namespace,
+                            rank0_worker=True,
+                            logs_per_trial=self.start_step,
+                        ),
+                        timeout=APISERVER_TIMEOUT,
                     )
+                )
+
+            trial_logs = {trial_name: [] for trial_name in new_trial_names}
+            for log in get_log_response.observation_log.metric_logs:
+                trial_logs[log.trial_name].append(log)
 
-                # Get only first start_step metrics.
-                # Since metrics are collected consistently and ordered by time,
-                # we slice top start_step metrics.
-                first_x_logs = get_log_response.observation_log.metric_logs[
-                    : self.start_step
-                ]
+            for trial_name, first_x_logs in trial_logs.items():
+                # Trial without the objective metric logs is skipped.
+                if not first_x_logs:
+                    logger.info(
+                        "Skipping succeeded Trial: {} without {} metric logs".format(
+                            trial_name, self.objective_metric
+                        )
+                    )
+                    continue
                 metric_sum = 0
                 for log in first_x_logs:
                     metric_sum += float(log.metric.value)
 
                 # Get average metric value for the Trial.
                 new_average = metric_sum / len(first_x_logs)
-                self.trials_avg_history[trial.name] = new_average
+                self.trials_avg_history[trial_name] = new_average
                 logger.info(
                     "Adding new succeeded Trial: {} with average metrics value: {}".format(
-                        trial.name, new_average
+                        trial_name, new_average
                     )
                 )
                 logger.info(
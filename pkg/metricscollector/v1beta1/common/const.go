@@ -30,6 +30,14 @@ const (
 	DefaultTimeout = 0
 	// DefaultWaitAll is the default value whether wait for all other main process of container exiting
 	DefaultWaitAllProcesses = "true"
+	// DefaultFlushInterval is the default value for interval between the metrics reports to DB manager
+	DefaultFlushInterval = 10 * time.Second
+	// DefaultReportTimeout is the default value for timeout of the metrics report retries once the training is completed
+	DefaultReportTimeout = 5 * time.Minute
+	// DefaultScrapeInterval is the default value for interval between the metrics endpoint scrapes
+	DefaultScrapeInterval = 10 * time.Second
+	// DefaultScrapeTimeout is the default value for timeout of the metrics endpoint scrape
+	DefaultScrapeTimeout = 5 * time.Second
 	// TrainingCompleted is the job finished marker in $$$$.pid file when main training process is completed
 	TrainingCompleted = "completed"
 
@@ -44,10 +52,26 @@ const (
 	// Score=-7.53e+05
 	// Score=1E0
 	// Score=1.23E10
-	DefaultFilter = `([\w|-]+)\s*=\s*([+-]?\d*(\.\d+)?([Ee][+-]?\d+)?)`
+	// loss=nan
+	// loss=-Inf
+	// NaN and infinity are matched in any letter case, so the divergence early stopping rule can be applied.
+	DefaultFilter = `([\w|-]+)\s*=\s*([+-]?(?i:nan|inf(?:inity)?)\b|[+-]?\d*(\.\d+)?([Ee][+-]?\d+)?)`
 
 	TimeStampJsonKey = "timestamp"
 
+	// MetricsSpoolDir is the directory in the metrics volume where the metrics batches are kept until they are reported.
+	MetricsSpoolDir = ".katib-spool"
+
+	// StepKey and EpochKey are the keys of the training step and epoch in the metrics logs.
+	// Step takes precedence if both are reported.
+	StepKey  = "step"
+	EpochKey = "epoch"
+
+	// ReplicaTypeLabel and ReplicaIndexLabel are the labels of the distributed training job pods
+	// which are set by the Training Operator. They identify the worker which reports the metrics.
+	ReplicaTypeLabel  = "training.kubeflow.org/replica-type"
+	ReplicaIndexLabel = "training.kubeflow.org/replica-index"
+
 	// TODO (andreyvelich): Do we need to maintain 2 names? Should we leave only 1?
 	MetricCollectorContainerName       = "metrics-collector"
 	MetricLoggerCollectorContainerName = "metrics-logger-and-collector"
@@ -18,6 +18,8 @@ DEFAULT_POLL_INTERVAL = 1
 DEFAULT_TIMEOUT = 0
 # Default value whether wait for all other main process of container exiting
 DEFAULT_WAIT_ALL_PROCESSES = "True"
+# Default value for timeout in seconds of the metrics report retries once the training is completed
+DEFAULT_REPORT_TIMEOUT = 300
 # Default value for directory where TF event metrics are reported
 DEFAULT_METRICS_FILE_DIR = "/log"
 # Job finished marker in $$$$.pid file when main process is completed
@@ -0,0 +1,394 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	psutil "github.com/shirou/gopsutil/v3/process"
+	"google.golang.org/grpc"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	api "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
+)
+
+// StopRulesFlag is the flag value with the early stopping rules passed to the metrics collectors.
+// Each rule is set in the name;value;comparison;startStep order, e.g. accuracy;0.8;less;4.
+// The type, patience and group of the rule can follow the start step, e.g. loss;;less;0;patience;5;overfit.
+type StopRulesFlag []commonv1beta1.EarlyStoppingRule
+
+func (flag *StopRulesFlag) String() string {
+	stopRuleStrings := []string{}
+	for _, r := range *flag {
+		stopRuleStrings = append(stopRuleStrings, FormatStopRule(r))
+	}
+	return strings.Join(stopRuleStrings, ";")
+}
+
+func (flag *StopRulesFlag) Set(value string) error {
+	stopRuleParsed := strings.Split(value, ";")
+	if len(stopRuleParsed) != 4 && len(stopRuleParsed) != 7 {
+		return fmt.Errorf("Invalid Early Stopping rule: %v", value)
+	}
+
+	// Get int start step.
+	startStep, err := strconv.Atoi(stopRuleParsed[3])
+	if err != nil {
+		return fmt.Errorf("Parse start step: %v to int error: %v", stopRuleParsed[3], err)
+	}
+
+	// For each stop rule this order: 1 - metric name, 2 - metric value, 3 - comparison type, 4 - start step.
+	// Start step is equal to 0, if it's not defined.
+	stopRule := commonv1beta1.EarlyStoppingRule{
+		Name:       stopRuleParsed[0],
+		Value:      stopRuleParsed[1],
+		Comparison: commonv1beta1.ComparisonType(stopRuleParsed[2]),
+		StartStep:  startStep,
+	}
+	// Optional 5 - rule type, 6 - patience, 7 - group.
+	if len(stopRuleParsed) == 7 {
+		stopRule.Type = commonv1beta1.EarlyStoppingRuleType(stopRuleParsed[4])
+		if stopRule.Patience, err = strconv.Atoi(stopRuleParsed[5]); err != nil {
+			return fmt.Errorf("Parse patience: %v to int error: %v", stopRuleParsed[5], err)
+		}
+		stopRule.Group = stopRuleParsed[6]
+	}
+
+	*flag = append(*flag, stopRule)
+	return nil
+}
+
+// FormatStopRule returns the early stopping rule in the StopRulesFlag format.
+// The type, patience and group are omitted if they are not set, so the rule can be parsed by the older metrics collectors.
+func FormatStopRule(rule commonv1beta1.EarlyStoppingRule) string {
+	fields := []string{rule.Name, rule.Value, string(rule.Comparison), strconv.Itoa(rule.StartStep)}
+	if rule.Type != "" || rule.Patience != 0 || rule.Group != "" {
+		fields = append(fields, string(rule.Type), strconv.Itoa(rule.Patience), rule.Group)
+	}
+	return strings.Join(fields, ";")
+}
+
+// ValidateEarlyStoppingRules validates the early stopping rules for the Experiment objective.
+func ValidateEarlyStoppingRules(rules []commonv1beta1.EarlyStoppingRule, objective *commonv1beta1.ObjectiveSpec) error {
+	metricNames := append([]string{objective.ObjectiveMetricName}, objective.AdditionalMetricNames...)
+	for i, rule := range rules {
+		if err := validateEarlyStoppingRule(rule, objective.ObjectiveMetricName, metricNames); err != nil {
+			return fmt.Errorf("Invalid early stopping rule %d for metric %v: %v", i, rule.Name, err)
+		}
+	}
+	return nil
+}
+
+func validateEarlyStoppingRule(rule commonv1beta1.EarlyStoppingRule, objectiveName string, metricNames []string) error {
+	if !slices.Contains(metricNames, rule.Name) {
+		return fmt.Errorf("rule must be set for the objective or additional metric")
+	}
+	if rule.StartStep < 0 {
+		return fmt.Errorf("start step must be non-negative")
+	}
+	if strings.Contains(rule.Name+rule.Value+rule.Group, ";") {
+		return fmt.Errorf("name, value and group must not contain \";\"")
+	}
+	if rule.Type != commonv1beta1.EarlyStoppingRuleTypePatience && rule.Patience != 0 {
+		return fmt.Errorf("patience can be set only for the %v rule", commonv1beta1.EarlyStoppingRuleTypePatience)
+	}
+	isDirection := rule.Comparison == commonv1beta1.ComparisonTypeLess || rule.Comparison == commonv1beta1.ComparisonTypeGreater
+
+	switch rule.Type {
+	case "", commonv1beta1.EarlyStoppingRuleTypeThreshold:
+		if _, err := strconv.ParseFloat(rule.Value, 64); err != nil {
+			return fmt.Errorf("value %v must be float", rule.Value)
+		}
+		if !isDirection && rule.Comparison != commonv1beta1.ComparisonTypeEqual {
+			return fmt.Errorf("comparison %v must be one of equal, less or greater", rule.Comparison)
+		}
+	case commonv1beta1.EarlyStoppingRuleTypePatience:
+		if rule.Patience <= 0 {
+			return fmt.Errorf("patience must be positive")
+		}
+		if rule.Value != "" {
+			if minDelta, err := strconv.ParseFloat(rule.Value, 64); err != nil || minDelta < 0 {
+				return fmt.Errorf("value %v must be non-negative float", rule.Value)
+			}
+		}
+		// The objective metric improves in the objective type direction by default.
+		if !isDirection && (rule.Name != objectiveName || (rule.Comparison != "" && rule.Comparison != commonv1beta1.ComparisonTypeEqual)) {
+			return fmt.Errorf("comparison %v must be less or greater", rule.Comparison)
+		}
+	case commonv1beta1.EarlyStoppingRuleTypeDivergence:
+		if rule.Value != "" {
+			if _, err := strconv.ParseFloat(rule.Value, 64); err != nil {
+				return fmt.Errorf("value %v must be float", rule.Value)
+			}
+			if !isDirection {
+				return fmt.Errorf("comparison %v must be less or greater", rule.Comparison)
+			}
+		}
+	default:
+		return fmt.Errorf("type %v must be one of %v, %v or %v", rule.Type, commonv1beta1.EarlyStoppingRuleTypeThreshold,
+			commonv1beta1.EarlyStoppingRuleTypePatience, commonv1beta1.EarlyStoppingRuleTypeDivergence)
+	}
+	return nil
+}
+
+// StopRules tracks the early stopping rules which have not been reached yet.
+// Rules in the same group are ANDed, and the groups are ORed.
+type StopRules struct {
+	rules         []*stopRule
+	objectiveName string
+	objectiveType commonv1beta1.ObjectiveType
+
+	// For objective metric we calculate best optimal value from the recorded metrics.
+	// This is workaround for Median Stop algorithm.
+	// TODO (andreyvelich): Think about it, maybe define latest, max or min strategy type in stop-rule as well ?
+	optimalObjValue *float64
+}
+
+// stopRule is the state of the single early stopping rule.
+type stopRule struct {
+	commonv1beta1.EarlyStoppingRule
+	// reports is the number of the reported metric values.
+	reports int
+	// bestValue and staleReports are the best metric value and the number of reports without improvement for the patience rule.
+	bestValue    *float64
+	staleReports int
+	reached      bool
+}
+
+// NewStopRules returns the StopRules for the given rules.
+// objectiveName and objectiveType are used to calculate the optimal value of the objective metric.
+func NewStopRules(rules []commonv1beta1.EarlyStoppingRule, objectiveName string, objectiveType commonv1beta1.ObjectiveType) *StopRules {
+	stopRules := make([]*stopRule, 0, len(rules))
+	for _, rule := range rules {
+		stopRules = append(stopRules, &stopRule{EarlyStoppingRule: rule})
+	}
+	return &StopRules{
+		rules:         stopRules,
+		objectiveName: objectiveName,
+		objectiveType: objectiveType,
+	}
+}
+
+// Contains returns true if one of the rules which have not been reached yet is set for the metric.
+func (s *StopRules) Contains(metricName string) bool {
+	for _, rule := range s.rules {
+		if !rule.reached && rule.Name == metricName {
+			return true
+		}
+	}
+	return false
+}
+
+// Names returns the unique metric names of the rules which have not been reached yet.
+func (s *StopRules) Names() []string {
+	names := []string{}
+	for _, rule := range s.rules {
+		if !rule.reached && !slices.Contains(names, rule.Name) {
+			names = append(names, rule.Name)
+		}
+	}
+	return names
+}
+
+// Reached returns true if all rules in any group are reached and training must be early stopped.
+func (s *StopRules) Reached() bool {
+	groupReached := map[string]bool{}
+	for _, rule := range s.rules {
+		reached, exist := groupReached[rule.Group]
+		groupReached[rule.Group] = rule.reached && (reached || !exist)
+	}
+	for _, reached := range groupReached {
+		if reached {
+			return true
+		}
+	}
+	return len(s.rules) == 0
+}
+
+// Update applies the reported metric value to the rules.
+// Once rule is reached, it stays reached.
+func (s *StopRules) Update(metricName string, metricValue float64) error {
+	// Calculate optimalObjValue.
+	if metricName == s.objectiveName && !math.IsNaN(metricValue) {
+		if s.optimalObjValue == nil ||
+			(s.objectiveType == commonv1beta1.ObjectiveTypeMaximize && metricValue > *s.optimalObjValue) ||
+			(s.objectiveType == commonv1beta1.ObjectiveTypeMinimize && metricValue < *s.optimalObjValue) {
+			s.optimalObjValue = &metricValue
+		}
+	}
+	for _, rule := range s.rules {
+		if rule.reached || rule.Name != metricName {
+			continue
+		}
+		reached, err := s.isReached(rule, metricValue)
+		if err != nil {
+			return err
+		}
+		rule.reached = reached
+	}
+	return nil
+}
+
+func (s *StopRules) isReached(rule *stopRule, metricValue float64) (bool, error) {
+	var ruleValue float64
+	if rule.Value != "" || rule.Type == "" || rule.Type == commonv1beta1.EarlyStoppingRuleTypeThreshold {
+		var err error
+		if ruleValue, err = strconv.ParseFloat(rule.Value, 64); err != nil {
+			return false, fmt.Errorf("Unable to parse value %v to float for rule metric %v", rule.Value, rule.Name)
+		}
+	}
+
+	// Patience rule counts the reports without improvement from the first recorded metric.
+	if rule.Type == commonv1beta1.EarlyStoppingRuleTypePatience {
+		if rule.bestValue == nil || s.isImproved(rule, metricValue, ruleValue) {
+			rule.bestValue = &metricValue
+			rule.staleReports = 0
+		} else {
+			rule.staleReports++
+		}
+	}
+
+	// We should apply early stopping rule only if metric is reported at least "start_step" times.
+	rule.reports++
+	if rule.reports < rule.StartStep {
+		return false, nil
+	}
+
+	switch rule.Type {
+	case commonv1beta1.EarlyStoppingRuleTypePatience:
+		return rule.staleReports >= rule.Patience, nil
+	case commonv1beta1.EarlyStoppingRuleTypeDivergence:
+		if math.IsNaN(metricValue) || math.IsInf(metricValue, 0) {
+			return true, nil
+		}
+		if rule.Value == "" {
+			return false, nil
+		}
+		return compare(metricValue, ruleValue, rule.Comparison), nil
+	}
+
+	// Assign best optimal value to metric value.
+	if rule.Name == s.objectiveName && s.optimalObjValue != nil {
+		metricValue = *s.optimalObjValue
+	}
+	// Metric value can be equal, less or greater than stop rule.
+	return compare(metricValue, ruleValue, rule.Comparison), nil
+}
+
+// isImproved returns true if the metric value is better than the best value of the patience rule at least by minDelta.
+func (s *StopRules) isImproved(rule *stopRule, metricValue, minDelta float64) bool {
+	comparison := rule.Comparison
+	if comparison != commonv1beta1.ComparisonTypeLess && comparison != commonv1beta1.ComparisonTypeGreater {
+		// The objective metric improves in the objective type direction by default.
+		comparison = commonv1beta1.ComparisonTypeGreater
+		if s.objectiveType == commonv1beta1.ObjectiveTypeMinimize {
+			comparison = commonv1beta1.ComparisonTypeLess
+		}
+	}
+	if comparison == commonv1beta1.ComparisonTypeGreater {
+		return metricValue > *rule.bestValue+minDelta
+	}
+	return metricValue < *rule.bestValue-minDelta
+}
+
+func compare(metricValue, ruleValue float64, comparison commonv1beta1.ComparisonType) bool {
+	switch comparison {
+	case commonv1beta1.ComparisonTypeEqual:
+		return metricValue == ruleValue
+	case commonv1beta1.ComparisonTypeLess:
+		return metricValue < ruleValue
+	case commonv1beta1.ComparisonTypeGreater:
+		return metricValue > ruleValue
+	}
+	return false
+}
+
+// StopTraining marks the main training process as early stopped and terminates its child process.
+// markDirPath is the directory where the main process writes the completed marker.
+func StopTraining(markDirPath string, mainProc *psutil.Process) error {
+	// Create ".pid" file with "early-stopped" line.
+	// Which means that training is early stopped and Trial status is updated.
+	markFile := filepath.Join(markDirPath, fmt.Sprintf("%d.pid", mainProc.Pid))
+	if err := os.WriteFile(markFile, []byte(TrainingEarlyStopped), 0644); err != nil {
+		return fmt.Errorf("Write to file %v error: %v", markFile, err)
+	}
+
+	// Get child process from main PID.
+	childProc, err := mainProc.Children()
+	if err != nil {
+		return fmt.Errorf("Get children proceses for main PID: %v failed: %v", mainProc.Pid, err)
+	}
+
+	// TODO (andreyvelich): Currently support only single child process.
+	if len(childProc) != 1 {
+		return fmt.Errorf("Multiple children processes are not supported. Children processes: %v", childProc)
+	}
+
+	// Terminate the child process.
+	if err = childProc[0].Terminate(); err != nil {
+		return fmt.Errorf("Unable to terminate child process %v, error: %v", childProc[0], err)
+	}
+	return nil
+}
+
+// WaitProcessCompleted waits until the process is completed or the timeout is out.
+func WaitProcessCompleted(proc *psutil.Process, timeout time.Duration) error {
+	endTime := time.Now().Add(timeout)
+	isProcRunning := true
+	for isProcRunning && time.Now().Before(endTime) {
+		var err error
+		isProcRunning, err = proc.IsRunning()
+		// Ignore "no such file error". It means that process is complete.
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("Check process status for main PID: %v failed: %v", proc.Pid, err)
+		}
+	}
+	return nil
+}
+
+// SetTrialEarlyStopped sends request to the Early Stopping service to change Trial status to early stopped.
+// The Early Stopping service runs in the Trial namespace.
+func SetTrialEarlyStopped(earlyStopServiceAddr, trialName, trialNamespace string) error {
+	// Create connection and client for Early Stopping service.
+	credsOpt, err := grpctls.DialOption(grpctls.NamespaceServerName(trialNamespace))
+	if err != nil {
+		return fmt.Errorf("Failed to set up credentials for Early Stopping service, error: %v", err)
+	}
+	conn, err := grpc.Dial(earlyStopServiceAddr, credsOpt)
+	if err != nil {
+		return fmt.Errorf("Could not connect to Early Stopping service, error: %v", err)
+	}
+	defer conn.Close()
+	c := api.NewEarlyStoppingClient(conn)
+
+	setTrialStatusReq := &api.SetTrialStatusRequest{
+		TrialName: trialName,
+	}
+	if _, err = c.SetTrialStatus(context.Background(), setTrialStatusReq); err != nil {
+		return fmt.Errorf("Set Trial status error: %v", err)
+	}
+	return nil
+}
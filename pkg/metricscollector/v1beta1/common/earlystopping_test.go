@@ -0,0 +1,250 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+)
+
+func TestStopRules(t *testing.T) {
+	type metric struct {
+		name  string
+		value float64
+	}
+	testCases := map[string]struct {
+		rules       []commonv1beta1.EarlyStoppingRule
+		metrics     []metric
+		wantReached bool
+	}{
+		"Rule is reached": {
+			rules: []commonv1beta1.EarlyStoppingRule{
+				{Name: "loss", Value: "0.5", Comparison: commonv1beta1.ComparisonTypeGreater},
+			},
+			metrics:     []metric{{"loss", 0.4}, {"loss", 0.6}},
+			wantReached: true,
+		},
+		"Rule is not reached before start step": {
+			rules: []commonv1beta1.EarlyStoppingRule{
+				{Name: "loss", Value: "0.5", Comparison: commonv1beta1.ComparisonTypeGreater, StartStep: 3},
+			},
+			metrics:     []metric{{"loss", 0.6}, {"loss", 0.6}},
+			wantReached: false,
+		},
+		"Objective rule uses the optimal value": {
+			rules: []commonv1beta1.EarlyStoppingRule{
+				{Name: "accuracy", Value: "0.5", Comparison: commonv1beta1.ComparisonTypeLess},
+			},
+			// The optimal accuracy is 0.8, so the rule is not reached by 0.4.
+			metrics:     []metric{{"accuracy", 0.8}, {"accuracy", 0.4}},
+			wantReached: false,
+		},
+		"All rules must be reached": {
+			rules: []commonv1beta1.EarlyStoppingRule{
+				{Name: "accuracy", Value: "0.5", Comparison: commonv1beta1.ComparisonTypeLess},
+				{Name: "loss", Value: "0.5", Comparison: commonv1beta1.ComparisonTypeGreater},
+			},
+			metrics:     []metric{{"accuracy", 0.4}},
+			wantReached: false,
+		},
+		"Rule is applied after start step": {
+			rules: []commonv1beta1.EarlyStoppingRule{
+				{Name: "loss", Value: "0.5", Comparison: commonv1beta1.ComparisonTypeGreater, StartStep: 2},
+			},
+			metrics:     []metric{{"loss", 0.4}, {"loss", 0.4}, {"loss", 0.6}},
+			wantReached: true,
+		},
+		"Patience rule is reached": {
+			rules: []commonv1beta1.EarlyStoppingRule{
+				{Name: "loss", Value: "0.01", Comparison: commonv1beta1.ComparisonTypeLess, Type: commonv1beta1.EarlyStoppingRuleTypePatience, Patience: 2},
+			},
+			// 0.495 is not improved by the minimum delta.
+			metrics:     []metric{{"loss", 0.6}, {"loss", 0.5}, {"loss", 0.495}, {"loss", 0.55}},
+			wantReached: true,
+		},
+		"Patience rule is not reached after improvement": {
+			rules: []commonv1beta1.EarlyStoppingRule{
+				{Name: "accuracy", Type: commonv1beta1.EarlyStoppingRuleTypePatience, Patience: 2},
+			},
+			metrics:     []metric{{"accuracy", 0.6}, {"accuracy", 0.5}, {"accuracy", 0.7}, {"accuracy", 0.6}},
+			wantReached: false,
+		},
+		"Divergence rule is reached by NaN": {
+			rules: []commonv1beta1.EarlyStoppingRule{
+				{Name: "loss", Type: commonv1beta1.EarlyStoppingRuleTypeDivergence},
+			},
+			metrics:     []metric{{"loss", 0.6}, {"loss", math.NaN()}},
+			wantReached: true,
+		},
+		"Divergence rule is reached by bound": {
+			rules: []commonv1beta1.EarlyStoppingRule{
+				{Name: "accuracy", Value: "0.1", Comparison: commonv1beta1.ComparisonTypeLess, Type: commonv1beta1.EarlyStoppingRuleTypeDivergence},
+			},
+			// Divergence rule uses the latest value instead of the optimal value of the objective.
+			metrics:     []metric{{"accuracy", 0.8}, {"accuracy", 0.05}},
+			wantReached: true,
+		},
+		"One of groups is reached": {
+			rules: []commonv1beta1.EarlyStoppingRule{
+				{Name: "accuracy", Value: "0.5", Comparison: commonv1beta1.ComparisonTypeLess},
+				{Name: "loss", Value: "0.5", Comparison: commonv1beta1.ComparisonTypeGreater},
+				{Name: "loss", Type: commonv1beta1.EarlyStoppingRuleTypeDivergence, Group: "diverged"},
+			},
+			metrics:     []metric{{"accuracy", 0.4}, {"loss", math.Inf(1)}},
+			wantReached: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			stopRules := NewStopRules(tc.rules, "accuracy", commonv1beta1.ObjectiveTypeMaximize)
+			for _, m := range tc.metrics {
+				if err := stopRules.Update(m.name, m.value); err != nil {
+					t.Fatalf("Unexpected error from Update: %v", err)
+				}
+			}
+			if reached := stopRules.Reached(); reached != tc.wantReached {
+				t.Errorf("Expected reached %v, got %v", tc.wantReached, reached)
+			}
+		})
+	}
+}
+
+func TestStopRulesFlag(t *testing.T) {
+	testCases := map[string]struct {
+		value     string
+		wantRules StopRulesFlag
+		wantError bool
+	}{
+		"Rule with start step": {
+			value:     "accuracy;0.8;less;4",
+			wantRules: StopRulesFlag{{Name: "accuracy", Value: "0.8", Comparison: commonv1beta1.ComparisonTypeLess, StartStep: 4}},
+		},
+		"Rule with type, patience and group": {
+			value: "loss;;less;0;patience;5;overfit",
+			wantRules: StopRulesFlag{{Name: "loss", Comparison: commonv1beta1.ComparisonTypeLess,
+				Type: commonv1beta1.EarlyStoppingRuleTypePatience, Patience: 5, Group: "overfit"}},
+		},
+		"Invalid number of fields": {
+			value:     "loss;;less;0;patience",
+			wantError: true,
+		},
+		"Invalid patience": {
+			value:     "loss;;less;0;patience;five;",
+			wantError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var flag StopRulesFlag
+			err := flag.Set(tc.value)
+			if tc.wantError != (err != nil) {
+				t.Fatalf("Expected error %v, got %v", tc.wantError, err)
+			}
+			if diff := cmp.Diff(tc.wantRules, flag); len(diff) != 0 {
+				t.Errorf("Unexpected rules (-want,+got):\n%s", diff)
+			}
+			if err == nil && FormatStopRule(flag[0]) != tc.value {
+				t.Errorf("Expected formatted rule %v, got %v", tc.value, FormatStopRule(flag[0]))
+			}
+		})
+	}
+}
+
+func TestValidateEarlyStoppingRules(t *testing.T) {
+	objective := &commonv1beta1.ObjectiveSpec{
+		Type:                  commonv1beta1.ObjectiveTypeMaximize,
+		ObjectiveMetricName:   "accuracy",
+		AdditionalMetricNames: []string{"loss"},
+	}
+	testCases := map[string]struct {
+		rules     []commonv1beta1.EarlyStoppingRule
+		wantError bool
+	}{
+		"Valid rules": {
+			rules: []commonv1beta1.EarlyStoppingRule{
+				{Name: "accuracy", Value: "0.8", Comparison: commonv1beta1.ComparisonTypeLess, StartStep: 4},
+				{Name: "accuracy", Type: commonv1beta1.EarlyStoppingRuleTypePatience, Patience: 5, Group: "patience"},
+				{Name: "loss", Value: "0.01", Comparison: commonv1beta1.ComparisonTypeLess, Type: commonv1beta1.EarlyStoppingRuleTypePatience, Patience: 5},
+				{Name: "loss", Type: commonv1beta1.EarlyStoppingRuleTypeDivergence, Group: "diverged"},
+				{Name: "loss", Value: "100", Comparison: commonv1beta1.ComparisonTypeGreater, Type: commonv1beta1.EarlyStoppingRuleTypeDivergence, Group: "diverged"},
+			},
+		},
+		"Unknown metric": {
+			rules: []commonv1beta1.EarlyStoppingRule{
+				{Name: "epoch", Value: "10", Comparison: commonv1beta1.ComparisonTypeEqual},
+			},
+			wantError: true,
+		},
+		"Invalid threshold value": {
+			rules: []commonv1beta1.EarlyStoppingRule{
+				{Name: "accuracy", Comparison: commonv1beta1.ComparisonTypeLess},
+			},
+			wantError: true,
+		},
+		"Patience rule without patience": {
+			rules: []commonv1beta1.EarlyStoppingRule{
+				{Name: "accuracy", Type: commonv1beta1.EarlyStoppingRuleTypePatience},
+			},
+			wantError: true,
+		},
+		"Patience rule without direction for additional metric": {
+			rules: []commonv1beta1.EarlyStoppingRule{
+				{Name: "loss", Type: commonv1beta1.EarlyStoppingRuleTypePatience, Patience: 5},
+			},
+			wantError: true,
+		},
+		"Patience for threshold rule": {
+			rules: []commonv1beta1.EarlyStoppingRule{
+				{Name: "accuracy", Value: "0.8", Comparison: commonv1beta1.ComparisonTypeLess, Patience: 5},
+			},
+			wantError: true,
+		},
+		"Divergence bound without direction": {
+			rules: []commonv1beta1.EarlyStoppingRule{
+				{Name: "loss", Value: "100", Type: commonv1beta1.EarlyStoppingRuleTypeDivergence},
+			},
+			wantError: true,
+		},
+		"Invalid type": {
+			rules: []commonv1beta1.EarlyStoppingRule{
+				{Name: "loss", Value: "100", Comparison: commonv1beta1.ComparisonTypeGreater, Type: "unknown"},
+			},
+			wantError: true,
+		},
+		"Group with separator": {
+			rules: []commonv1beta1.EarlyStoppingRule{
+				{Name: "loss", Type: commonv1beta1.EarlyStoppingRuleTypeDivergence, Group: "a;b"},
+			},
+			wantError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateEarlyStoppingRules(tc.rules, objective)
+			if tc.wantError != (err != nil) {
+				t.Errorf("Expected error %v, got %v", tc.wantError, err)
+			}
+		})
+	}
+}
@@ -19,9 +19,11 @@ package common
 import (
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"syscall"
 	"time"
 
 	psutil "github.com/shirou/gopsutil/v3/process"
@@ -34,10 +36,16 @@ type WaitPidsOpts struct {
 	Timeout                time.Duration
 	WaitAll                bool
 	CompletedMarkedDirPath string
+	// NativeSidecar is true when metrics collector is injected as the native sidecar container.
+	// In that case, the training processes aren't visible, so the completed marker is waited instead.
+	NativeSidecar bool
 }
 
 // WaitMainProcesses holds metrics collector parser until required pids are finished.
 func WaitMainProcesses(opts WaitPidsOpts) error {
+	if opts.NativeSidecar {
+		return WaitCompletedMarker(opts)
+	}
 
 	if runtime.GOOS != "linux" {
 		return fmt.Errorf("platform '%s' unsupported", runtime.GOOS)
@@ -179,3 +187,57 @@ func WaitPIDs(pids map[int]bool, mainPid int, opts WaitPidsOpts) error {
 	}
 	return nil
 }
+
+// WaitCompletedMarker waits until the main process writes the "completed" marker file
+// in the completed marker directory, e.g. /var/log/katib/6.pid.
+// Native sidecar is terminated once the training containers are completed, so the wait is also
+// finished when metrics collector receives SIGTERM, e.g. when the training is failed.
+// SIGTERM stays handled after the wait, so the metrics are still reported until the sidecar is killed.
+func WaitCompletedMarker(opts WaitPidsOpts) error {
+	terminated := make(chan os.Signal, 1)
+	signal.Notify(terminated, syscall.SIGTERM)
+
+	timeout := opts.Timeout
+	endTime := time.Now().Add(timeout)
+	for timeout == 0 || time.Now().Before(endTime) {
+		completed, err := isCompletedMarked(opts.CompletedMarkedDirPath)
+		if err != nil {
+			return err
+		}
+		if completed {
+			return nil
+		}
+		select {
+		case <-terminated:
+			// The marker can be written right before the termination.
+			if completed, err = isCompletedMarked(opts.CompletedMarkedDirPath); err != nil {
+				return err
+			}
+			if !completed {
+				klog.Infof("Metrics collector is terminated before the completed marker is found in %v", opts.CompletedMarkedDirPath)
+			}
+			return nil
+		case <-time.After(opts.PollInterval):
+		}
+	}
+	return fmt.Errorf("timed out waiting for completed marker")
+}
+
+// isCompletedMarked returns true if any of the ".pid" files in the directory contains "completed" marker.
+func isCompletedMarked(completedMarkedDirPath string) (bool, error) {
+	markFiles, err := filepath.Glob(filepath.Join(completedMarkedDirPath, "*.pid"))
+	if err != nil {
+		return false, fmt.Errorf("failed to list completed marker files: %v", err)
+	}
+	for _, markFile := range markFiles {
+		// The file can be removed or not written completely yet, so it is checked again on the next poll.
+		contents, err := os.ReadFile(markFile)
+		if err != nil {
+			continue
+		}
+		if strings.TrimSpace(string(contents)) == TrainingCompleted {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -12,7 +12,9 @@
 # See the License for the specific language governing permissions and
 # limitations under the License.
 
+import glob
 import os
+import signal
 import sys
 import time
 
@@ -135,3 +137,50 @@ def WaitPIDs(pids, main_pid, pool_interval, timout, wait_all, completed_marked_d
     # After main loop not finished pids set should be empty
     if not_finished_pids:
         raise Exception("Timed out waiting for pids to complete")
+
+
+def WaitCompletedMarker(pool_interval, timout, completed_marked_dir):
+    """
+    Waits until the main process writes the "completed" marker file
+    in the completed marker directory, e.g. /var/log/katib/6.pid.
+    Native sidecar is terminated once the training containers are completed,
+    so the wait is also finished when metrics collector receives SIGTERM.
+    """
+    terminated = []
+    signal.signal(signal.SIGTERM, lambda signum, frame: terminated.append(signum))
+
+    if pool_interval <= 0:
+        raise Exception("Poll interval seconds must be a positive integer")
+
+    start = 0
+    while timout <= 0 or start < timout:
+        # The marker can be written right before the termination.
+        if IsCompletedMarked(completed_marked_dir):
+            return
+        if terminated:
+            print(
+                "Metrics collector is terminated before the completed marker is found in {}".format(
+                    completed_marked_dir
+                )
+            )
+            return
+        time.sleep(pool_interval)
+        start = start + pool_interval
+
+    raise Exception("Timed out waiting for completed marker")
+
+
+def IsCompletedMarked(completed_marked_dir):
+    """
+    Returns true if any of the ".pid" files in the directory contains "completed" marker.
+    """
+    for mark_file in glob.glob(os.path.join(completed_marked_dir, "*.pid")):
+        # The file can be removed or not written completely yet,
+        # so it is checked again on the next poll.
+        try:
+            with open(mark_file) as file_obj:
+                if file_obj.read().strip() == const.TRAINING_COMPLETED:
+                    return True
+        except OSError:
+            continue
+    return False
@@ -0,0 +1,89 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestWaitCompletedMarker(t *testing.T) {
+	testCases := map[string]struct {
+		markers map[string]string
+		wantErr bool
+	}{
+		"Completed marker is written": {
+			markers: map[string]string{
+				"6.pid": TrainingCompleted + "\n",
+			},
+		},
+		"Marker without completed line": {
+			markers: map[string]string{
+				"6.pid": TrainingEarlyStopped,
+			},
+			wantErr: true,
+		},
+		"Completed marker is not written": {
+			wantErr: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			for fileName, contents := range tc.markers {
+				if err := os.WriteFile(filepath.Join(dir, fileName), []byte(contents), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			err := WaitCompletedMarker(WaitPidsOpts{
+				PollInterval:           10 * time.Millisecond,
+				Timeout:                100 * time.Millisecond,
+				CompletedMarkedDirPath: dir,
+				NativeSidecar:          true,
+			})
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Unexpected error: %v, want error: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestWaitCompletedMarkerTerminated(t *testing.T) {
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		if err := syscall.Kill(os.Getpid(), syscall.SIGTERM); err != nil {
+			t.Error(err)
+		}
+	}()
+	err := WaitCompletedMarker(WaitPidsOpts{
+		PollInterval:           10 * time.Millisecond,
+		CompletedMarkedDirPath: t.TempDir(),
+		NativeSidecar:          true,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Metrics are still reported after the wait, so SIGTERM must not kill the metrics collector.
+	if err = syscall.Kill(os.Getpid(), syscall.SIGTERM); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(50 * time.Millisecond)
+}
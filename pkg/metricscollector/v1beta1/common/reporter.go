@@ -0,0 +1,352 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+const (
+	// initialRetryBackoff and maxRetryBackoff limit the delay between the failed metrics reports.
+	initialRetryBackoff = time.Second
+	maxRetryBackoff     = time.Minute
+	// maxBufferedMetricLogs is the maximum number of the metrics logs which are not reported yet.
+	maxBufferedMetricLogs = 10000
+	// unavailableSource is the metrics source of the unavailable objective metric value.
+	unavailableSource = "katib:unavailable"
+)
+
+// Position is the position in the metrics source, e.g. the metrics file, after the added metrics logs.
+type Position struct {
+	// Generation is incremented once the metrics source is read from the beginning again,
+	// e.g. when the metrics file is rotated or truncated.
+	Generation int64 `json:"generation"`
+	// Offset is the byte offset in the metrics file or the number of the metrics logs read from the source.
+	Offset int64 `json:"offset"`
+}
+
+// after returns true if the position is after the other position.
+func (p Position) after(other Position) bool {
+	return p.Generation > other.Generation || (p.Generation == other.Generation && p.Offset > other.Offset)
+}
+
+// MetricsReporter buffers the collected metrics logs and reports them to the DB manager in batches,
+// so the metrics are available while the training is running.
+// Every batch has the metrics logs of the single metrics source, and its ID is derived from the Trial,
+// the metrics source and the position in the source after the batch. The DB manager registers the batch
+// only once, so the retried batch and the batch which is read again from the same position are not duplicated.
+// Once the number of the buffered metrics logs reaches the limit, the new metrics logs are added
+// only after the buffered metrics logs are reported, so the metrics source is read at the report pace.
+type MetricsReporter struct {
+	client         v1beta1.DBManagerClient
+	trialName      string
+	trialNamespace string
+	// worker of the distributed training is set to the added metrics logs if it's not empty.
+	worker string
+	// objectiveMetric is the first metric in the metric names.
+	objectiveMetric string
+	// spool is nil if the batches are kept only in memory.
+	spool *spool
+
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	maxBuffered    int
+
+	flushMu sync.Mutex
+	// batches are not reported yet. It is guarded by flushMu.
+	batches []*v1beta1.ReportObservationLogRequest
+
+	mu sync.Mutex
+	// cond is broadcast once the buffered metrics logs are reported or the reporter is stopped.
+	cond *sync.Cond
+	// pending are the metrics logs of the metrics sources which are not added to the batches yet.
+	pending map[string][]*v1beta1.MetricLog
+	// positions are the positions of the metrics sources after the pending metrics logs.
+	positions map[string]Position
+	// buffered is the number of the pending and not reported metrics logs.
+	buffered          int
+	stopped           bool
+	objectiveReported bool
+}
+
+// NewMetricsReporter returns the MetricsReporter for the Trial.
+// worker is the name of the distributed training worker which reports the metrics.
+// metrics are the objective and additional metric names.
+// If spoolDir is set, the batches are kept in spoolDir until they are reported,
+// and the batches spooled by the previous run of the metrics collector are reported first.
+// The positions of the metrics sources are kept in spoolDir as well, so the restarted metrics collector
+// continues to read the metrics sources from the positions of the spooled metrics logs.
+func NewMetricsReporter(client v1beta1.DBManagerClient, trialName, trialNamespace, worker string, metrics []string, spoolDir string) (*MetricsReporter, error) {
+	r := &MetricsReporter{
+		client:         client,
+		trialName:      trialName,
+		trialNamespace: trialNamespace,
+		worker:         worker,
+		initialBackoff: initialRetryBackoff,
+		maxBackoff:     maxRetryBackoff,
+		maxBuffered:    maxBufferedMetricLogs,
+		pending:        map[string][]*v1beta1.MetricLog{},
+		positions:      map[string]Position{},
+	}
+	r.cond = sync.NewCond(&r.mu)
+	if len(metrics) != 0 {
+		r.objectiveMetric = metrics[0]
+	}
+	if spoolDir != "" {
+		var err error
+		if r.spool, err = newSpool(spoolDir); err != nil {
+			return nil, err
+		}
+		if r.batches, err = r.spool.load(); err != nil {
+			return nil, fmt.Errorf("Failed to load spooled metrics: %w", err)
+		}
+		if r.positions, err = r.spool.loadPositions(); err != nil {
+			return nil, fmt.Errorf("Failed to load metrics sources positions: %w", err)
+		}
+		for _, batch := range r.batches {
+			for _, mlog := range batch.GetObservationLog().GetMetricLogs() {
+				if mlog.GetMetric().GetName() == r.objectiveMetric {
+					r.objectiveReported = true
+				}
+			}
+			r.buffered += len(batch.GetObservationLog().GetMetricLogs())
+		}
+		// The metrics source without the offsets continues to count the metrics logs after the spooled batches.
+		r.positions[""] = r.spooledPosition("", r.positions[""])
+		if len(r.batches) != 0 {
+			klog.Infof("%d spooled metrics batches will be reported", len(r.batches))
+		}
+	}
+	return r, nil
+}
+
+// Position returns the position in the metrics source after the metrics logs
+// which were added by the previous run of the metrics collector.
+func (r *MetricsReporter) Position(source string) Position {
+	r.flushMu.Lock()
+	defer r.flushMu.Unlock()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.spooledPosition(source, r.positions[source])
+}
+
+// spooledPosition returns the position of the last batch of the metrics source if it's after the position,
+// since the batch can be spooled before the positions are saved.
+func (r *MetricsReporter) spooledPosition(source string, pos Position) Position {
+	prefix := r.sourceID(source) + "-"
+	for _, batch := range r.batches {
+		var batchPos Position
+		if !strings.HasPrefix(batch.BatchId, prefix) {
+			continue
+		}
+		if _, err := fmt.Sscanf(strings.TrimPrefix(batch.BatchId, prefix), "%d-%d", &batchPos.Generation, &batchPos.Offset); err == nil && batchPos.after(pos) {
+			pos = batchPos
+		}
+	}
+	return pos
+}
+
+// sourceID returns the unique ID of the metrics source of the Trial's worker.
+func (r *MetricsReporter) sourceID(source string) string {
+	hash := sha256.Sum256([]byte(strings.Join([]string{r.trialNamespace, r.trialName, r.worker, source}, "/")))
+	return hex.EncodeToString(hash[:8])
+}
+
+// batchID returns the ID of the metrics source's batch which ends at the position.
+// IDs of the metrics source are sorted in the report order.
+func (r *MetricsReporter) batchID(source string, pos Position) string {
+	return fmt.Sprintf("%s-%010d-%020d", r.sourceID(source), pos.Generation, pos.Offset)
+}
+
+// Add adds the metrics logs to the next batch.
+// The position of the metrics source without the offsets is the number of the added metrics logs.
+func (r *MetricsReporter) Add(mlogs ...*v1beta1.MetricLog) {
+	r.mu.Lock()
+	pos := r.positions[""]
+	r.mu.Unlock()
+	pos.Offset += int64(len(mlogs))
+	r.AddAt("", pos, mlogs...)
+}
+
+// AddAt adds the metrics logs which are read from the metrics source before the position to the next batch.
+// It blocks while the buffer is full until the buffered metrics logs are reported or the reporter is stopped.
+func (r *MetricsReporter) AddAt(source string, pos Position, mlogs ...*v1beta1.MetricLog) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for len(mlogs) != 0 && r.buffered >= r.maxBuffered && !r.stopped {
+		r.cond.Wait()
+	}
+	r.positions[source] = pos
+	r.buffered += len(mlogs)
+	for _, mlog := range mlogs {
+		if mlog.Metric.Name == r.objectiveMetric {
+			r.objectiveReported = true
+		}
+		if r.worker != "" {
+			mlog.Worker = r.worker
+		}
+	}
+	if len(mlogs) != 0 {
+		r.pending[source] = append(r.pending[source], mlogs...)
+	}
+}
+
+// Flush reports the buffered metrics logs as the new batch of every metrics source.
+// If the report fails, the batch is kept with the same ID for the next flush.
+func (r *MetricsReporter) Flush(ctx context.Context) error {
+	// Flushes are serialized to keep the order of the batches.
+	r.flushMu.Lock()
+	defer r.flushMu.Unlock()
+
+	// The new metrics logs can be added while the batches are reported.
+	r.mu.Lock()
+	pending := r.pending
+	r.pending = map[string][]*v1beta1.MetricLog{}
+	positions := make(map[string]Position, len(r.positions))
+	for source, pos := range r.positions {
+		positions[source] = pos
+	}
+	r.mu.Unlock()
+	sources := make([]string, 0, len(pending))
+	for source := range pending {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+	for _, source := range sources {
+		batch := &v1beta1.ReportObservationLogRequest{
+			TrialName: r.trialName,
+			Namespace: r.trialNamespace,
+			BatchId:   r.batchID(source, positions[source]),
+			ObservationLog: &v1beta1.ObservationLog{
+				MetricLogs: pending[source],
+			},
+		}
+		if r.spool != nil {
+			if err := r.spool.save(batch); err != nil {
+				klog.Warningf("Failed to spool metrics batch %v: %v", batch.BatchId, err)
+			}
+		}
+		r.batches = append(r.batches, batch)
+	}
+	// The positions are saved once the batches are spooled, so the spooled metrics logs are not read again.
+	if r.spool != nil && len(sources) != 0 {
+		if err := r.spool.savePositions(positions); err != nil {
+			klog.Warningf("Failed to save metrics sources positions: %v", err)
+		}
+	}
+
+	for len(r.batches) != 0 {
+		batch := r.batches[0]
+		if _, err := r.client.ReportObservationLog(ctx, batch); err != nil {
+			return fmt.Errorf("Failed to Report logs: %w", err)
+		}
+		klog.Infof("Metrics reported. :\n%v", batch.ObservationLog)
+		r.batches = r.batches[1:]
+		r.mu.Lock()
+		r.buffered -= len(batch.GetObservationLog().GetMetricLogs())
+		r.cond.Broadcast()
+		r.mu.Unlock()
+		if r.spool != nil {
+			if err := r.spool.remove(batch.BatchId); err != nil {
+				klog.Warningf("Failed to remove spooled metrics batch %v: %v", batch.BatchId, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Run flushes the metrics logs on every interval until the context is cancelled.
+// After the failed flush, the next flush is delayed with the exponential backoff.
+// Once the context is cancelled, the metrics logs are added regardless of the buffer limit.
+func (r *MetricsReporter) Run(ctx context.Context, interval time.Duration) {
+	defer r.stop()
+	delay := interval
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			if err := r.Flush(ctx); err != nil {
+				delay = min(delay*2, max(interval, r.maxBackoff))
+				klog.Warningf("%v. Metrics will be reported again in %v", err, delay)
+			} else {
+				delay = interval
+			}
+			timer.Reset(delay)
+		}
+	}
+}
+
+// stop unblocks the metrics logs additions which wait for the reports.
+func (r *MetricsReporter) stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stopped = true
+	r.cond.Broadcast()
+}
+
+// Close reports the rest of the metrics logs once the training is completed.
+// If objective metric was not reported, the unavailable value is reported.
+// Failed reports are retried with the exponential backoff until the context is done.
+func (r *MetricsReporter) Close(ctx context.Context) error {
+	r.stop()
+	r.mu.Lock()
+	if !r.objectiveReported {
+		klog.Infof("Objective metric %v is not found in training logs, %v value is reported", r.objectiveMetric, consts.UnavailableMetricValue)
+		r.pending[unavailableSource] = append(r.pending[unavailableSource], &v1beta1.MetricLog{
+			TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+			Metric: &v1beta1.Metric{
+				Name:  r.objectiveMetric,
+				Value: consts.UnavailableMetricValue,
+			},
+			Worker: r.worker,
+		})
+		r.positions[unavailableSource] = Position{Offset: 1}
+		r.buffered++
+		r.objectiveReported = true
+	}
+	r.mu.Unlock()
+
+	backoff := r.initialBackoff
+	for {
+		err := r.Flush(ctx)
+		if err == nil {
+			return nil
+		}
+		klog.Warningf("%v. Metrics will be reported again in %v", err, backoff)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: %w", err, ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, r.maxBackoff)
+	}
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+type fakeDBManagerClient struct {
+	v1beta1.DBManagerClient
+	err error
+	// failures is the number of the reports which fail before the reports succeed.
+	failures int
+	reports  [][]string
+	batchIDs []string
+}
+
+func (c *fakeDBManagerClient) ReportObservationLog(ctx context.Context, in *v1beta1.ReportObservationLogRequest, opts ...grpc.CallOption) (*v1beta1.ReportObservationLogReply, error) {
+	c.batchIDs = append(c.batchIDs, in.BatchId)
+	if c.err != nil {
+		return nil, c.err
+	}
+	if c.failures > 0 {
+		c.failures--
+		return nil, errors.New("unavailable")
+	}
+	values := []string{}
+	for _, mlog := range in.ObservationLog.MetricLogs {
+		value := mlog.Metric.Name + "=" + mlog.Metric.Value
+		if mlog.Worker != "" {
+			value += "@" + mlog.Worker
+		}
+		values = append(values, value)
+	}
+	c.reports = append(c.reports, values)
+	return &v1beta1.ReportObservationLogReply{}, nil
+}
+
+func newMetricLog(name, value string) *v1beta1.MetricLog {
+	return &v1beta1.MetricLog{
+		Metric: &v1beta1.Metric{
+			Name:  name,
+			Value: value,
+		},
+	}
+}
+
+func TestMetricsReporter(t *testing.T) {
+	testCases := map[string]struct {
+		batches     [][]*v1beta1.MetricLog
+		worker      string
+		failFlushes int
+		wantReports [][]string
+	}{
+		"Metrics are reported in batches": {
+			batches: [][]*v1beta1.MetricLog{
+				{newMetricLog("accuracy", "0.5"), newMetricLog("loss", "0.9")},
+				{newMetricLog("accuracy", "0.7")},
+			},
+			wantReports: [][]string{
+				{"accuracy=0.5", "loss=0.9"},
+				{"accuracy=0.7"},
+			},
+		},
+		"Failed batch is reported on the next flush": {
+			batches: [][]*v1beta1.MetricLog{
+				{newMetricLog("accuracy", "0.5")},
+				{newMetricLog("accuracy", "0.7")},
+			},
+			failFlushes: 1,
+			wantReports: [][]string{
+				{"accuracy=0.5"},
+				{"accuracy=0.7"},
+			},
+		},
+		"Unavailable objective metric is reported on close": {
+			batches: [][]*v1beta1.MetricLog{
+				{newMetricLog("loss", "0.9")},
+			},
+			wantReports: [][]string{
+				{"loss=0.9"},
+				{"accuracy=" + consts.UnavailableMetricValue},
+			},
+		},
+		"Metrics are reported with the worker": {
+			batches: [][]*v1beta1.MetricLog{
+				{newMetricLog("loss", "0.9")},
+			},
+			worker: "worker-1",
+			wantReports: [][]string{
+				{"loss=0.9@worker-1"},
+				{"accuracy=" + consts.UnavailableMetricValue + "@worker-1"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := &fakeDBManagerClient{}
+			reporter, err := NewMetricsReporter(client, "trial", "default", tc.worker, []string{"accuracy", "loss"}, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i, batch := range tc.batches {
+				reporter.Add(batch...)
+				client.err = nil
+				if i < tc.failFlushes {
+					client.err = errors.New("unavailable")
+				}
+				if err := reporter.Flush(context.Background()); (err != nil) != (i < tc.failFlushes) {
+					t.Errorf("Unexpected error from Flush: %v", err)
+				}
+			}
+			if err := reporter.Close(context.Background()); err != nil {
+				t.Fatalf("Unexpected error from Close: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantReports, client.reports); len(diff) != 0 {
+				t.Errorf("Unexpected reports (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMetricsReporterRetry(t *testing.T) {
+	client := &fakeDBManagerClient{err: errors.New("unavailable")}
+	reporter, err := NewMetricsReporter(client, "trial", "default", "", []string{"accuracy"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	reporter.initialBackoff = time.Millisecond
+	reporter.maxBackoff = time.Millisecond
+
+	reporter.Add(newMetricLog("accuracy", "0.5"))
+	if err = reporter.Flush(context.Background()); err == nil {
+		t.Fatal("Expected error from Flush")
+	}
+
+	// Close returns the error once the context is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err = reporter.Close(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected deadline exceeded error from Close, got %v", err)
+	}
+
+	// Close retries the failed reports.
+	client.err = nil
+	client.failures = 2
+	client.batchIDs = nil
+	if err = reporter.Close(context.Background()); err != nil {
+		t.Fatalf("Unexpected error from Close: %v", err)
+	}
+	if diff := cmp.Diff([][]string{{"accuracy=0.5"}}, client.reports); len(diff) != 0 {
+		t.Errorf("Unexpected reports (-want,+got):\n%s", diff)
+	}
+	// The retried batch keeps its ID.
+	if len(client.batchIDs) != 3 || client.batchIDs[0] == "" || client.batchIDs[0] != client.batchIDs[2] {
+		t.Errorf("Expected the same batch ID for the retries, got %v", client.batchIDs)
+	}
+}
+
+func TestMetricsReporterSpool(t *testing.T) {
+	spoolDir := filepath.Join(t.TempDir(), MetricsSpoolDir)
+	client := &fakeDBManagerClient{err: errors.New("unavailable")}
+	reporter, err := NewMetricsReporter(client, "trial", "default", "", []string{"accuracy"}, spoolDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reporter.AddAt("metrics.log", Position{Offset: 12}, newMetricLog("accuracy", "0.5"))
+	reporter.AddAt("metrics.log", Position{Offset: 20})
+	if err = reporter.Flush(context.Background()); err == nil {
+		t.Fatal("Expected error from Flush")
+	}
+	if batches, _ := filepath.Glob(filepath.Join(spoolDir, "*"+spoolFileExt)); len(batches) != 1 {
+		t.Fatalf("Expected 1 spooled batch, got %v", batches)
+	}
+
+	// The restarted metrics collector reports the spooled batch.
+	client = &fakeDBManagerClient{}
+	reporter, err = NewMetricsReporter(client, "trial", "default", "", []string{"accuracy"}, spoolDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The metrics source is read after the spooled metrics logs.
+	if diff := cmp.Diff(Position{Offset: 20}, reporter.Position("metrics.log")); len(diff) != 0 {
+		t.Errorf("Unexpected position (-want,+got):\n%s", diff)
+	}
+	if err = reporter.Close(context.Background()); err != nil {
+		t.Fatalf("Unexpected error from Close: %v", err)
+	}
+	if diff := cmp.Diff([][]string{{"accuracy=0.5"}}, client.reports); len(diff) != 0 {
+		t.Errorf("Unexpected reports (-want,+got):\n%s", diff)
+	}
+	if batches, _ := filepath.Glob(filepath.Join(spoolDir, "*"+spoolFileExt)); len(batches) != 0 {
+		t.Errorf("Expected empty spool, got %v", batches)
+	}
+}
+
+func TestMetricsReporterBatchID(t *testing.T) {
+	spoolDir := filepath.Join(t.TempDir(), MetricsSpoolDir)
+	client := &fakeDBManagerClient{}
+	reporter, err := NewMetricsReporter(client, "trial", "default", "", []string{"accuracy"}, spoolDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reporter.AddAt("metrics.log", Position{Offset: 12}, newMetricLog("accuracy", "0.5"))
+	reporter.AddAt("other.log", Position{Offset: 7}, newMetricLog("loss", "0.9"))
+	if err = reporter.Flush(context.Background()); err != nil {
+		t.Fatalf("Unexpected error from Flush: %v", err)
+	}
+	if len(client.batchIDs) != 2 || client.batchIDs[0] == client.batchIDs[1] {
+		t.Fatalf("Expected the batch of every metrics source, got %v", client.batchIDs)
+	}
+
+	// The batch which is read again from the same position has the same ID.
+	client.err = errors.New("unavailable")
+	reporter, err = NewMetricsReporter(client, "trial", "default", "", []string{"accuracy"}, filepath.Join(t.TempDir(), MetricsSpoolDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	reporter.AddAt("metrics.log", Position{Offset: 12}, newMetricLog("accuracy", "0.5"))
+	if err = reporter.Flush(context.Background()); err == nil {
+		t.Fatal("Expected error from Flush")
+	}
+	if client.batchIDs[2] != client.batchIDs[0] {
+		t.Errorf("Expected batch ID %v, got %v", client.batchIDs[0], client.batchIDs[2])
+	}
+
+	// The position of the spooled batch is used if the positions are not saved.
+	if err = os.Remove(filepath.Join(spoolDir, positionsFile)); err != nil {
+		t.Fatal(err)
+	}
+	spool, err := newSpool(spoolDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = spool.save(reporter.batches[0]); err != nil {
+		t.Fatal(err)
+	}
+	reporter, err = NewMetricsReporter(client, "trial", "default", "", []string{"accuracy"}, spoolDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(Position{Offset: 12}, reporter.Position("metrics.log")); len(diff) != 0 {
+		t.Errorf("Unexpected position (-want,+got):\n%s", diff)
+	}
+}
+
+func TestMetricsReporterBufferLimit(t *testing.T) {
+	client := &fakeDBManagerClient{err: errors.New("unavailable")}
+	reporter, err := NewMetricsReporter(client, "trial", "default", "", []string{"accuracy"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	reporter.maxBuffered = 1
+	reporter.Add(newMetricLog("accuracy", "0.5"))
+
+	added := make(chan struct{})
+	go func() {
+		reporter.Add(newMetricLog("accuracy", "0.7"))
+		close(added)
+	}()
+	// The failed report doesn't free the buffer.
+	if err = reporter.Flush(context.Background()); err == nil {
+		t.Fatal("Expected error from Flush")
+	}
+	select {
+	case <-added:
+		t.Fatal("Expected Add to wait for the reports")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	client.err = nil
+	if err = reporter.Flush(context.Background()); err != nil {
+		t.Fatalf("Unexpected error from Flush: %v", err)
+	}
+	select {
+	case <-added:
+	case <-time.After(time.Second):
+		t.Fatal("Expected Add to complete once the metrics are reported")
+	}
+	if err = reporter.Close(context.Background()); err != nil {
+		t.Fatalf("Unexpected error from Close: %v", err)
+	}
+	if diff := cmp.Diff([][]string{{"accuracy=0.5"}, {"accuracy=0.7"}}, client.reports); len(diff) != 0 {
+		t.Errorf("Unexpected reports (-want,+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+const (
+	spoolFileExt = ".json"
+	// positionsFile keeps the positions of the metrics sources after the spooled metrics logs.
+	positionsFile = "positions"
+)
+
+// spool keeps the metrics batches which are not reported yet in the metrics volume,
+// so they are not lost if the metrics collector is restarted.
+type spool struct {
+	dir string
+}
+
+func newSpool(dir string) (*spool, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("Failed to create spool directory %v: %w", dir, err)
+	}
+	return &spool{dir: dir}, nil
+}
+
+// save writes the batch to the spool.
+// The file is renamed once it is written, so the partially written batches are never loaded.
+func (s *spool) save(batch *v1beta1.ReportObservationLogRequest) error {
+	data, err := protojson.Marshal(batch)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(s.dir, batch.BatchId+spoolFileExt)
+	if err = os.WriteFile(path+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// remove deletes the reported batch from the spool.
+func (s *spool) remove(batchID string) error {
+	if err := os.Remove(filepath.Join(s.dir, batchID+spoolFileExt)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// load returns the spooled batches in the report order of every metrics source.
+func (s *spool) load() ([]*v1beta1.ReportObservationLogRequest, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), spoolFileExt) {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	batches := []*v1beta1.ReportObservationLogRequest{}
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(s.dir, name))
+		if err != nil {
+			return nil, err
+		}
+		batch := &v1beta1.ReportObservationLogRequest{}
+		if err = protojson.Unmarshal(data, batch); err != nil {
+			return nil, fmt.Errorf("Failed to parse spooled batch %v: %w", name, err)
+		}
+		batches = append(batches, batch)
+	}
+	return batches, nil
+}
+
+// savePositions writes the positions of the metrics sources to the spool.
+func (s *spool) savePositions(positions map[string]Position) error {
+	data, err := json.Marshal(positions)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(s.dir, positionsFile)
+	if err = os.WriteFile(path+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// loadPositions returns the positions of the metrics sources which were saved to the spool.
+func (s *spool) loadPositions() (map[string]Position, error) {
+	positions := map[string]Position{}
+	data, err := os.ReadFile(filepath.Join(s.dir, positionsFile))
+	if os.IsNotExist(err) {
+		return positions, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, &positions); err != nil {
+		return nil, err
+	}
+	return positions, nil
+}
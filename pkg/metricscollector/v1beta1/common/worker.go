@@ -0,0 +1,32 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"strings"
+)
+
+// GetWorkerName returns the name of the distributed training worker in the <replica-type>-<replica-index> format,
+// e.g. worker-1, from the labels of the worker pod.
+// The name is empty if the pod is not the replica of the distributed training job.
+func GetWorkerName(podLabels map[string]string) string {
+	replicaType, replicaIndex := podLabels[ReplicaTypeLabel], podLabels[ReplicaIndexLabel]
+	if replicaType == "" || replicaIndex == "" {
+		return ""
+	}
+	return strings.ToLower(replicaType) + "-" + replicaIndex
+}
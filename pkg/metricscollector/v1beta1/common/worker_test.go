@@ -0,0 +1,50 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+)
+
+func TestGetWorkerName(t *testing.T) {
+	cases := map[string]struct {
+		labels map[string]string
+		want   string
+	}{
+		"worker replica": {
+			labels: map[string]string{ReplicaTypeLabel: "worker", ReplicaIndexLabel: "1"},
+			want:   "worker-1",
+		},
+		"master replica": {
+			labels: map[string]string{ReplicaTypeLabel: "Master", ReplicaIndexLabel: "0"},
+			want:   "master-0",
+		},
+		"no replica index": {
+			labels: map[string]string{ReplicaTypeLabel: "worker"},
+		},
+		"not distributed training": {
+			labels: map[string]string{"job-name": "trial"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := GetWorkerName(tc.labels); got != tc.want {
+				t.Errorf("Unexpected worker name, want %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
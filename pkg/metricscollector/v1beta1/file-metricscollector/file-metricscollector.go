@@ -17,6 +17,9 @@ limitations under the License.
 package sidecarmetricscollector
 
 import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -27,25 +30,67 @@ import (
 	"strings"
 	"time"
 
+	"github.com/tidwall/gjson"
 	"k8s.io/klog"
+	"sigs.k8s.io/yaml"
 
 	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
-	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
 )
 
 var (
-	errFileFormat = fmt.Errorf("format must be set %v or %v", commonv1beta1.TextFormat, commonv1beta1.JsonFormat)
-	errOpenFile   = errors.New("failed to open the file")
-	errReadFile   = errors.New("failed to read the file")
-	errParseJson  = errors.New("failed to parse the json object")
+	errFileFormat = fmt.Errorf("format must be set %v, %v, %v, %v or %v", commonv1beta1.TextFormat, commonv1beta1.JsonFormat,
+		commonv1beta1.CsvFormat, commonv1beta1.JsonDocumentFormat, commonv1beta1.YamlFormat)
+	errOpenFile  = errors.New("failed to open the file")
+	errReadFile  = errors.New("failed to read the file")
+	errParseJson = errors.New("failed to parse the json object")
+	errParseCsv  = errors.New("failed to parse the csv record")
+	errParseYaml = errors.New("failed to parse the yaml document")
+
+	errInvalidSelector = errors.New("invalid JSON selector")
 )
 
-func CollectObservationLog(fileName string, metrics []string, filters []string, fileFormat commonv1beta1.FileFormat) (*v1beta1.ObservationLog, error) {
+// ParserOptions is the configuration of the metrics file parser.
+type ParserOptions struct {
+	Metrics []string
+	// Filters are the regular expressions of the metrics in the TEXT format.
+	Filters []string
+	// Selectors are the metric value selectors, the key is the metric name.
+	// Selector is the GJSON or JSONPath selector for the JSON, JSONDocument and YAML formats,
+	// and the column name for the CSV format.
+	Selectors map[string]string
+	// TimestampColumn and StepColumn are the column names of the timestamp and the step in the CSV format.
+	TimestampColumn string
+	StepColumn      string
+	// TimestampFormat is the format of the timestamp in the TEXT format, see NewTimestampParser.
+	TimestampFormat string
+	FileFormat      commonv1beta1.FileFormat
+}
+
+// IsDocumentFormat returns true if the metrics file of the format can be parsed only as the whole document.
+func IsDocumentFormat(fileFormat commonv1beta1.FileFormat) bool {
+	return fileFormat == commonv1beta1.JsonDocumentFormat || fileFormat == commonv1beta1.YamlFormat
+}
+
+// CollectObservationLog parses the whole metrics file and returns the observation log.
+func CollectObservationLog(fileName string, opts ParserOptions) (*v1beta1.ObservationLog, error) {
+	if IsDocumentFormat(opts.FileFormat) {
+		document, err := os.ReadFile(fileName)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errOpenFile, err.Error())
+		}
+		mlogs, err := ParseDocument(document, opts)
+		if err != nil {
+			return nil, err
+		}
+		return common.NewObservationLog(mlogs, opts.Metrics), nil
+	}
+
 	// we should check fileFormat first in case of opening an invalid file
-	if fileFormat != commonv1beta1.JsonFormat && fileFormat != commonv1beta1.TextFormat {
-		return nil, errFileFormat
+	parser, err := NewLineParser(opts)
+	if err != nil {
+		return nil, err
 	}
 
 	file, err := os.Open(fileName)
@@ -53,146 +98,387 @@ func CollectObservationLog(fileName string, metrics []string, filters []string,
 		return nil, fmt.Errorf("%w: %s", errOpenFile, err.Error())
 	}
 	defer file.Close()
-	content, err := io.ReadAll(file)
+
+	mlogs := []*v1beta1.MetricLog{}
+	reader := bufio.NewReader(file)
+	for {
+		logline, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return nil, fmt.Errorf("%w: %s", errReadFile, err.Error())
+		}
+		lineLogs, parseErr := parser.Parse(strings.TrimSuffix(logline, "\n"))
+		if parseErr != nil {
+			return nil, parseErr
+		}
+		mlogs = append(mlogs, lineLogs...)
+		if err == io.EOF {
+			break
+		}
+	}
+	return common.NewObservationLog(mlogs, opts.Metrics), nil
+}
+
+// ParseDocument returns the metrics logs from the whole metrics file in the JSONDocument or YAML format.
+// The document is either the single object or the array of objects, e.g. the history of the epochs.
+func ParseDocument(document []byte, opts ParserOptions) ([]*v1beta1.MetricLog, error) {
+	if !IsDocumentFormat(opts.FileFormat) {
+		return nil, errFileFormat
+	}
+	selectors, err := gjsonSelectors(opts.Selectors)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %s", errReadFile, err.Error())
+		return nil, err
+	}
+	if opts.FileFormat == commonv1beta1.YamlFormat {
+		if document, err = yaml.YAMLToJSON(document); err != nil {
+			return nil, fmt.Errorf("%w: %s", errParseYaml, err.Error())
+		}
+	}
+	if len(bytes.TrimSpace(document)) == 0 {
+		return nil, nil
+	}
+	if !gjson.ValidBytes(document) {
+		return nil, fmt.Errorf("%w: invalid json document", errParseJson)
 	}
-	logs := string(content)
 
-	switch fileFormat {
+	result := gjson.ParseBytes(document)
+	switch {
+	case result.IsObject():
+		return parseJsonObject(result.Raw, opts.Metrics, selectors)
+	case result.IsArray():
+		mlogs := []*v1beta1.MetricLog{}
+		for _, item := range result.Array() {
+			if !item.IsObject() {
+				return nil, fmt.Errorf("%w: array item must be object: %s", errParseJson, item.Raw)
+			}
+			itemLogs, err := parseJsonObject(item.Raw, opts.Metrics, selectors)
+			if err != nil {
+				return nil, err
+			}
+			mlogs = append(mlogs, itemLogs...)
+		}
+		return mlogs, nil
+	default:
+		return nil, fmt.Errorf("%w: document must be object or array of objects", errParseJson)
+	}
+}
+
+// LineParser parses the metrics logs from the metrics file line by line,
+// so the file can be parsed while the training is running.
+type LineParser struct {
+	metrics         []string
+	metricRegList   []*regexp.Regexp
+	timestampParser *TimestampParser
+	// selectors are the GJSON paths of the metric values in the JSON format,
+	// and the column names of the metric values in the CSV format.
+	selectors       map[string]string
+	timestampColumn string
+	stepColumn      string
+	fileFormat      commonv1beta1.FileFormat
+	// header is the first record of the metrics file in the CSV format.
+	header map[string]int
+	// rawHeader is used to skip the header which is written again, e.g. once the training is restarted.
+	rawHeader string
+}
+
+// NewLineParser returns the LineParser for the metrics file format.
+// The JSONDocument and YAML formats can't be parsed line by line, use ParseDocument instead.
+func NewLineParser(opts ParserOptions) (*LineParser, error) {
+	selectors := opts.Selectors
+	var timestampParser *TimestampParser
+	switch opts.FileFormat {
 	case commonv1beta1.TextFormat:
-		return parseLogsInTextFormat(strings.Split(logs, "\n"), metrics, filters)
+		var err error
+		if timestampParser, err = NewTimestampParser(opts.TimestampFormat); err != nil {
+			return nil, err
+		}
+	case commonv1beta1.CsvFormat:
 	case commonv1beta1.JsonFormat:
-		return parseLogsInJsonFormat(strings.Split(logs, "\n"), metrics)
+		var err error
+		if selectors, err = gjsonSelectors(opts.Selectors); err != nil {
+			return nil, err
+		}
 	default:
-		return nil, nil
+		return nil, errFileFormat
 	}
+	return &LineParser{
+		metrics:         opts.Metrics,
+		metricRegList:   GetFilterRegexpList(opts.Filters),
+		timestampParser: timestampParser,
+		selectors:       selectors,
+		timestampColumn: opts.TimestampColumn,
+		stepColumn:      opts.StepColumn,
+		fileFormat:      opts.FileFormat,
+	}, nil
 }
 
-func parseLogsInTextFormat(logs []string, metrics []string, filters []string) (*v1beta1.ObservationLog, error) {
-	metricRegList := GetFilterRegexpList(filters)
-	mlogs := make([]*v1beta1.MetricLog, 0, len(logs))
-
-	for _, logline := range logs {
-		// skip line which doesn't contain any metrics keywords, avoiding unnecessary pattern match
-		isMetricLine := false
-		for _, m := range metrics {
-			if strings.Contains(logline, m) {
-				isMetricLine = true
-				break
-			}
+// Parse returns the metrics logs from the single line of the metrics file.
+func (p *LineParser) Parse(logline string) ([]*v1beta1.MetricLog, error) {
+	switch p.fileFormat {
+	case commonv1beta1.TextFormat:
+		return p.parseLineInTextFormat(logline), nil
+	case commonv1beta1.JsonFormat:
+		if len(logline) == 0 {
+			return nil, nil
 		}
-		if !isMetricLine {
-			continue
+		return parseJsonObject(logline, p.metrics, p.selectors)
+	case commonv1beta1.CsvFormat:
+		return p.parseLineInCsvFormat(logline)
+	default:
+		return nil, errFileFormat
+	}
+}
+
+// gjsonSelectors converts the metric value selectors to the GJSON paths.
+func gjsonSelectors(selectors map[string]string) (map[string]string, error) {
+	paths := make(map[string]string, len(selectors))
+	for name, selector := range selectors {
+		path, err := ParseJSONSelector(selector)
+		if err != nil {
+			return nil, err
 		}
+		paths[name] = path
+	}
+	return paths, nil
+}
 
-		timestamp := time.Time{}.UTC().Format(time.RFC3339)
-		ls := strings.SplitN(logline, " ", 2)
-		if len(ls) != 2 {
-			klog.Warningf("Metrics will not have timestamp since %s doesn't begin with timestamp string", logline)
-		} else {
-			if _, err := time.Parse(time.RFC3339Nano, ls[0]); err != nil {
-				klog.Warningf("Metrics will not have timestamp since error parsing time %s: %v", ls[0], err)
-			} else {
-				timestamp = ls[0]
-			}
+func (p *LineParser) parseLineInTextFormat(logline string) []*v1beta1.MetricLog {
+	// skip line which doesn't contain any metrics keywords, avoiding unnecessary pattern match
+	isMetricLine := false
+	for _, m := range p.metrics {
+		if strings.Contains(logline, m) {
+			isMetricLine = true
+			break
 		}
+	}
+	if !isMetricLine {
+		return nil
+	}
+
+	timestamp, err := p.timestampParser.Parse(logline)
+	if err != nil {
+		klog.Warningf("Metrics will not have timestamp since error parsing time of %s: %v", logline, err)
+		timestamp = time.Time{}.UTC().Format(time.RFC3339)
+	}
 
-		for _, metricReg := range metricRegList {
-			matchStrs := metricReg.FindAllStringSubmatch(logline, -1)
-			for _, kevList := range matchStrs {
-				if len(kevList) < 3 {
+	lineLogs := make([]*v1beta1.MetricLog, 0, len(p.metrics))
+	stepValues := map[string]interface{}{}
+	for _, metricReg := range p.metricRegList {
+		matchStrs := metricReg.FindAllStringSubmatch(logline, -1)
+		for _, kevList := range matchStrs {
+			if len(kevList) < 3 {
+				continue
+			}
+			name := strings.TrimSpace(kevList[1])
+			value := strings.TrimSpace(kevList[2])
+			if name == common.StepKey || name == common.EpochKey {
+				stepValues[name] = value
+			}
+			for _, m := range p.metrics {
+				if name != m {
 					continue
 				}
-				name := strings.TrimSpace(kevList[1])
-				value := strings.TrimSpace(kevList[2])
-				for _, m := range metrics {
-					if name != m {
-						continue
-					}
-					mlogs = append(mlogs, &v1beta1.MetricLog{
-						TimeStamp: timestamp,
-						Metric: &v1beta1.Metric{
-							Name:  name,
-							Value: value,
-						},
-					})
-					break
-				}
+				lineLogs = append(lineLogs, &v1beta1.MetricLog{
+					TimeStamp: timestamp,
+					Metric: &v1beta1.Metric{
+						Name:  name,
+						Value: value,
+					},
+				})
+				break
 			}
 		}
 	}
-	return newObservationLog(mlogs, metrics), nil
+	setStep(lineLogs, stepValues)
+	return lineLogs
 }
 
-func parseLogsInJsonFormat(logs []string, metrics []string) (*v1beta1.ObservationLog, error) {
-	mlogs := make([]*v1beta1.MetricLog, 0, len(logs))
+// parseJsonObject returns the metrics logs from the JSON object.
+// selectors are the GJSON paths of the metric values, the key is the metric name.
+func parseJsonObject(object string, metrics []string, selectors map[string]string) ([]*v1beta1.MetricLog, error) {
+	var jsonObj map[string]interface{}
+	if err := json.Unmarshal([]byte(object), &jsonObj); err != nil {
+		return nil, fmt.Errorf("%w: %s", errParseJson, err.Error())
+	}
 
-	for _, logline := range logs {
-		if len(logline) == 0 {
+	timestamp := time.Time{}.UTC().Format(time.RFC3339)
+	timestampJsonValue, exist := jsonObj[common.TimeStampJsonKey]
+	if !exist {
+		klog.Warningf("Metrics will not have timestamp since %s doesn't have the key timestamp", object)
+	} else {
+		if parsedTimestamp := parseTimestamp(timestampJsonValue); parsedTimestamp == "" {
+			klog.Warningf("Metrics will not have timestamp since error parsing time %v", timestampJsonValue)
+		} else {
+			timestamp = parsedTimestamp
+		}
+	}
+
+	objectLogs := make([]*v1beta1.MetricLog, 0, len(metrics))
+	for _, m := range metrics {
+		path, exist := selectors[m]
+		if !exist {
+			path = escapeGJSONKey(m)
+		}
+		result := gjson.Get(object, path)
+		value, ok := jsonMetricValue(result)
+		if !ok {
+			if result.Exists() {
+				klog.Warningf("Metric %s is skipped since %s is neither number, boolean nor string", m, result.Raw)
+			}
 			continue
 		}
-		var jsonObj map[string]interface{}
-		if err := json.Unmarshal([]byte(logline), &jsonObj); err != nil {
-			return nil, fmt.Errorf("%w: %s", errParseJson, err.Error())
+		objectLogs = append(objectLogs, &v1beta1.MetricLog{
+			TimeStamp: timestamp,
+			Metric: &v1beta1.Metric{
+				Name:  m,
+				Value: value,
+			},
+		})
+	}
+	setStep(objectLogs, jsonObj)
+	return objectLogs, nil
+}
+
+func (p *LineParser) parseLineInCsvFormat(logline string) ([]*v1beta1.MetricLog, error) {
+	if strings.TrimSpace(logline) == "" {
+		return nil, nil
+	}
+	reader := csv.NewReader(strings.NewReader(logline))
+	reader.FieldsPerRecord = -1
+	record, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errParseCsv, err.Error())
+	}
+	for i := range record {
+		record[i] = strings.TrimSpace(record[i])
+	}
+
+	if p.header == nil {
+		p.header = make(map[string]int, len(record))
+		for i, column := range record {
+			p.header[column] = i
+		}
+		p.rawHeader = logline
+		return nil, nil
+	}
+	if logline == p.rawHeader {
+		return nil, nil
+	}
+	cell := func(column string) (string, bool) {
+		i, exist := p.header[column]
+		if !exist || i >= len(record) || record[i] == "" {
+			return "", false
 		}
+		return record[i], true
+	}
 
-		timestamp := time.Time{}.UTC().Format(time.RFC3339)
-		timestampJsonValue, exist := jsonObj[common.TimeStampJsonKey]
-		if !exist {
-			klog.Warningf("Metrics will not have timestamp since %s doesn't have the key timestamp", logline)
+	timestamp := time.Time{}.UTC().Format(time.RFC3339)
+	timestampColumn := p.timestampColumn
+	if timestampColumn == "" {
+		timestampColumn = common.TimeStampJsonKey
+	}
+	if value, exist := cell(timestampColumn); exist {
+		var timestampValue interface{} = value
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			timestampValue = floatValue
+		}
+		if parsedTimestamp := parseTimestamp(timestampValue); parsedTimestamp == "" {
+			klog.Warningf("Metrics will not have timestamp since error parsing time %v", value)
 		} else {
-			if parsedTimestamp := parseTimestamp(timestampJsonValue); parsedTimestamp == "" {
-				klog.Warningf("Metrics will not have timestamp since error parsing time %v", timestampJsonValue)
-			} else {
-				timestamp = parsedTimestamp
-			}
+			timestamp = parsedTimestamp
 		}
+	}
 
-		for _, m := range metrics {
-			value, exist := jsonObj[m].(string)
-			if !exist {
-				continue
+	lineLogs := make([]*v1beta1.MetricLog, 0, len(p.metrics))
+	for _, m := range p.metrics {
+		column, exist := p.selectors[m]
+		if !exist {
+			column = m
+		}
+		// The value is empty if the metric is not reported in the same step.
+		value, exist := cell(column)
+		if !exist {
+			continue
+		}
+		lineLogs = append(lineLogs, &v1beta1.MetricLog{
+			TimeStamp: timestamp,
+			Metric: &v1beta1.Metric{
+				Name:  m,
+				Value: value,
+			},
+		})
+	}
+
+	stepValues := map[string]interface{}{}
+	if p.stepColumn != "" {
+		if value, exist := cell(p.stepColumn); exist {
+			stepValues[common.StepKey] = value
+		}
+	} else {
+		for _, key := range []string{common.StepKey, common.EpochKey} {
+			if value, exist := cell(key); exist {
+				stepValues[key] = value
 			}
-			mlogs = append(mlogs, &v1beta1.MetricLog{
-				TimeStamp: timestamp,
-				Metric: &v1beta1.Metric{
-					Name:  m,
-					Value: value,
-				},
-			})
 		}
 	}
-	return newObservationLog(mlogs, metrics), nil
+	setStep(lineLogs, stepValues)
+	return lineLogs, nil
 }
 
-func newObservationLog(mlogs []*v1beta1.MetricLog, metrics []string) *v1beta1.ObservationLog {
-	// Metrics logs must contain at least one objective metric value
-	// Objective metric is located at first index
-	isObjectiveMetricReported := false
-	for _, mLog := range mlogs {
-		if mLog.Metric.Name == metrics[0] {
-			isObjectiveMetricReported = true
-			break
-		}
+// jsonMetricValue returns the metric value of the JSON value.
+// Booleans are converted to 1 and 0, so they can be compared as the numeric metrics.
+func jsonMetricValue(result gjson.Result) (string, bool) {
+	switch result.Type {
+	case gjson.String:
+		return result.Str, true
+	case gjson.Number:
+		// Raw value keeps the precision of the reported number.
+		return result.Raw, true
+	case gjson.True:
+		return "1", true
+	case gjson.False:
+		return "0", true
+	default:
+		return "", false
 	}
-	// If objective metrics were not reported, insert unavailable value in the DB
-	if !isObjectiveMetricReported {
-		klog.Infof("Objective metric %v is not found in training logs, %v value is reported", metrics[0], consts.UnavailableMetricValue)
-		return &v1beta1.ObservationLog{
-			MetricLogs: []*v1beta1.MetricLog{
-				{
-					TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
-					Metric: &v1beta1.Metric{
-						Name:  metrics[0],
-						Value: consts.UnavailableMetricValue,
-					},
-				},
-			},
+}
+
+// setStep sets the step of the metrics logs from the step or epoch value reported in the same line.
+func setStep(mlogs []*v1beta1.MetricLog, values map[string]interface{}) {
+	if len(mlogs) == 0 {
+		return
+	}
+	for _, key := range []string{common.StepKey, common.EpochKey} {
+		value, exist := values[key]
+		if !exist {
+			continue
 		}
+		step, ok := parseStep(value)
+		if !ok {
+			klog.Warningf("Metrics will not have %s since error parsing %v", key, value)
+			continue
+		}
+		for _, mlog := range mlogs {
+			mlog.Step = &step
+		}
+		return
 	}
-	return &v1beta1.ObservationLog{
-		MetricLogs: mlogs,
+}
+
+func parseStep(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case float64:
+		if v != float64(int64(v)) {
+			return 0, false
+		}
+		return int64(v), true
+	case string:
+		step, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, false
+		}
+		return step, true
+	default:
+		return 0, false
 	}
 }
 
@@ -250,3 +536,79 @@ func GetFilterRegexpList(filters []string) []*regexp.Regexp {
 	}
 	return regexpList
 }
+
+// ParseJSONSelector returns the GJSON path of the metric value selector.
+// Selector is either GJSON path, ref https://github.com/tidwall/gjson, or JSONPath expression which starts with "$".
+// JSONPath supports only the child and array index operators, for example: $.eval.acc or $['eval']['acc'][0].
+func ParseJSONSelector(selector string) (string, error) {
+	selector = strings.TrimSpace(selector)
+	if selector == "" {
+		return "", fmt.Errorf("%w: selector must not be empty", errInvalidSelector)
+	}
+	if !strings.HasPrefix(selector, "$") {
+		return selector, nil
+	}
+
+	var comps []string
+	rest := selector[1:]
+	for len(rest) != 0 {
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			return "", fmt.Errorf("%w: recursive descent is not supported: %s", errInvalidSelector, selector)
+		case rest[0] == '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			key := rest[:end]
+			if key == "" || key == "*" {
+				return "", fmt.Errorf("%w: member name is required: %s", errInvalidSelector, selector)
+			}
+			comps = append(comps, escapeGJSONKey(key))
+			rest = rest[end:]
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if len(rest) > 1 && (rest[1] == '\'' || rest[1] == '"') {
+				// Quoted member name can contain the closing bracket.
+				closing := strings.IndexByte(rest[2:], rest[1])
+				if closing == -1 || !strings.HasPrefix(rest[closing+3:], "]") {
+					return "", fmt.Errorf("%w: unterminated member name: %s", errInvalidSelector, selector)
+				}
+				comps = append(comps, escapeGJSONKey(rest[2:closing+2]))
+				rest = rest[closing+4:]
+				continue
+			}
+			if end == -1 {
+				return "", fmt.Errorf("%w: unterminated bracket: %s", errInvalidSelector, selector)
+			}
+			index := rest[1:end]
+			if _, err := strconv.ParseUint(index, 10, 32); err != nil {
+				return "", fmt.Errorf("%w: only array index is supported in brackets: %s", errInvalidSelector, selector)
+			}
+			comps = append(comps, index)
+			rest = rest[end+1:]
+		default:
+			return "", fmt.Errorf("%w: unexpected character %q: %s", errInvalidSelector, rest[0], selector)
+		}
+	}
+	if len(comps) == 0 {
+		return "", fmt.Errorf("%w: selector must select the metric value: %s", errInvalidSelector, selector)
+	}
+	return strings.Join(comps, "."), nil
+}
+
+// escapeGJSONKey escapes the GJSON special characters in the object key.
+func escapeGJSONKey(key string) string {
+	var b strings.Builder
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		isSafe := c <= ' ' || c > '~' || c == '_' || c == '-' || c == ':' ||
+			(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+		if !isSafe {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
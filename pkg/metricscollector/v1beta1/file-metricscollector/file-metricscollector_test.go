@@ -31,13 +31,17 @@ import (
 
 func TestCollectObservationLog(t *testing.T) {
 	testCases := map[string]struct {
-		fileName   string
-		testData   string
-		metrics    []string
-		filters    []string
-		fileFormat commonv1beta1.FileFormat
-		wantError  error
-		expected   *v1beta1.ObservationLog
+		fileName        string
+		testData        string
+		metrics         []string
+		filters         []string
+		selectors       map[string]string
+		timestampColumn string
+		stepColumn      string
+		timestampFormat string
+		fileFormat      commonv1beta1.FileFormat
+		wantError       error
+		expected        *v1beta1.ObservationLog
 	}{
 		"Positive case for logs in JSON format": {
 			fileName: "good.json",
@@ -161,6 +165,158 @@ func TestCollectObservationLog(t *testing.T) {
 				},
 			},
 		},
+		"Step and epoch for logs in JSON format": {
+			fileName: "step.json",
+			testData: `{"loss": "0.22082142531871796", "step": 10, "epoch": 1, "timestamp": "2021-12-02T14:27:50Z"}
+{"loss": "0.1414974331855774", "epoch": "2", "timestamp": "2021-12-02T14:27:51Z"}
+{"loss": "0.10683439671993256", "step": 2.5, "timestamp": "2021-12-02T14:27:52Z"}`,
+			metrics:    []string{"loss"},
+			fileFormat: commonv1beta1.JsonFormat,
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: "2021-12-02T14:27:50Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.22082142531871796",
+						},
+						Step: int64Ptr(10),
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:51Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.1414974331855774",
+						},
+						Step: int64Ptr(2),
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:52Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.10683439671993256",
+						},
+					},
+				},
+			},
+		},
+		"Step and epoch for logs in TEXT format": {
+			fileName: "step.log",
+			testData: `2024-03-04T17:55:08Z INFO     epoch=1 step=100 accuracy=0.8078 loss=0.5183
+2024-03-04T17:55:09Z INFO     epoch=2 accuracy=0.6752
+2024-03-04T17:55:10Z INFO     accuracy=0.9`,
+			metrics:    []string{"accuracy", "loss"},
+			fileFormat: commonv1beta1.TextFormat,
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: "2024-03-04T17:55:08Z",
+						Metric: &v1beta1.Metric{
+							Name:  "accuracy",
+							Value: "0.8078",
+						},
+						Step: int64Ptr(100),
+					},
+					{
+						TimeStamp: "2024-03-04T17:55:08Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.5183",
+						},
+						Step: int64Ptr(100),
+					},
+					{
+						TimeStamp: "2024-03-04T17:55:09Z",
+						Metric: &v1beta1.Metric{
+							Name:  "accuracy",
+							Value: "0.6752",
+						},
+						Step: int64Ptr(2),
+					},
+					{
+						TimeStamp: "2024-03-04T17:55:10Z",
+						Metric: &v1beta1.Metric{
+							Name:  "accuracy",
+							Value: "0.9",
+						},
+					},
+				},
+			},
+		},
+		"NaN and infinity for logs in TEXT format": {
+			fileName: "nan.log",
+			testData: `2024-03-04T17:55:08Z INFO     loss=nan
+2024-03-04T17:55:09Z INFO     loss=-Inf
+2024-03-04T17:55:10Z INFO     loss=INFINITY
+2024-03-04T17:55:11Z INFO     loss=info`,
+			metrics:    []string{"loss"},
+			fileFormat: commonv1beta1.TextFormat,
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: "2024-03-04T17:55:08Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "nan",
+						},
+					},
+					{
+						TimeStamp: "2024-03-04T17:55:09Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "-Inf",
+						},
+					},
+					{
+						TimeStamp: "2024-03-04T17:55:10Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "INFINITY",
+						},
+					},
+					{
+						TimeStamp: "2024-03-04T17:55:11Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "",
+						},
+					},
+				},
+			},
+		},
+		"Python logging timestamp for logs in TEXT format": {
+			fileName: "python.log",
+			testData: `2024-03-04 17:55:08,123 INFO accuracy=0.8078
+2024-03-04T17:55:09Z INFO accuracy=0.6752`,
+			metrics:         []string{"accuracy"},
+			timestampFormat: "Python",
+			fileFormat:      commonv1beta1.TextFormat,
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: "2024-03-04T17:55:08.123Z",
+						Metric: &v1beta1.Metric{
+							Name:  "accuracy",
+							Value: "0.8078",
+						},
+					},
+					{
+						TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+						Metric: &v1beta1.Metric{
+							Name:  "accuracy",
+							Value: "0.6752",
+						},
+					},
+				},
+			},
+		},
+		"Invalid timestamp format for logs in TEXT format": {
+			fileName:        "good.log",
+			metrics:         []string{"accuracy"},
+			timestampFormat: "ts=[",
+			fileFormat:      commonv1beta1.TextFormat,
+			wantError:       errInvalidTimestampFormat,
+		},
 		"Invalid case for logs in TEXT format": {
 			fileName: "invalid-value.log",
 			testData: `2024-03-04T17:55:08Z INFO     {metricName: accuracy, metricValue: .333}
@@ -182,6 +338,240 @@ func TestCollectObservationLog(t *testing.T) {
 				},
 			},
 		},
+		"Numeric, boolean and nested values for logs in JSON format": {
+			fileName: "values.json",
+			testData: `{"accuracy": 0.93, "loss": 1e-05, "timestamp": "2021-12-02T14:27:50Z"}
+{"eval": {"acc": 0.95, "scores": [0.1, 0.2]}, "converged": true, "timestamp": "2021-12-02T14:27:51Z"}
+{"accuracy": null, "loss": {"value": 0.2}, "timestamp": "2021-12-02T14:27:52Z"}`,
+			metrics:    []string{"accuracy", "loss", "converged", "eval-score"},
+			selectors:  map[string]string{"accuracy": "$.eval.acc", "eval-score": "eval.scores.1"},
+			fileFormat: commonv1beta1.JsonFormat,
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: "2021-12-02T14:27:50Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "1e-05",
+						},
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:51Z",
+						Metric: &v1beta1.Metric{
+							Name:  "accuracy",
+							Value: "0.95",
+						},
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:51Z",
+						Metric: &v1beta1.Metric{
+							Name:  "converged",
+							Value: "1",
+						},
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:51Z",
+						Metric: &v1beta1.Metric{
+							Name:  "eval-score",
+							Value: "0.2",
+						},
+					},
+				},
+			},
+		},
+		"Invalid selector for logs in JSON format": {
+			fileName:   "values.json",
+			metrics:    []string{"accuracy"},
+			selectors:  map[string]string{"accuracy": "$..acc"},
+			fileFormat: commonv1beta1.JsonFormat,
+			wantError:  errInvalidSelector,
+		},
+		"Positive case for logs in CSV format": {
+			fileName: "good.csv",
+			testData: `epoch,accuracy,val_accuracy,timestamp
+0,0.8078,0.7912,1638422847.28721
+1,0.9012,,2021-12-02T14:27:50Z
+epoch,accuracy,val_accuracy,timestamp
+
+2,"0.9349",0.9102,`,
+			metrics:    []string{"accuracy", "val-accuracy"},
+			selectors:  map[string]string{"val-accuracy": "val_accuracy"},
+			fileFormat: commonv1beta1.CsvFormat,
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: "2021-12-02T05:27:27.000028721Z",
+						Metric: &v1beta1.Metric{
+							Name:  "accuracy",
+							Value: "0.8078",
+						},
+						Step: int64Ptr(0),
+					},
+					{
+						TimeStamp: "2021-12-02T05:27:27.000028721Z",
+						Metric: &v1beta1.Metric{
+							Name:  "val-accuracy",
+							Value: "0.7912",
+						},
+						Step: int64Ptr(0),
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:50Z",
+						Metric: &v1beta1.Metric{
+							Name:  "accuracy",
+							Value: "0.9012",
+						},
+						Step: int64Ptr(1),
+					},
+					{
+						TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+						Metric: &v1beta1.Metric{
+							Name:  "accuracy",
+							Value: "0.9349",
+						},
+						Step: int64Ptr(2),
+					},
+					{
+						TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+						Metric: &v1beta1.Metric{
+							Name:  "val-accuracy",
+							Value: "0.9102",
+						},
+						Step: int64Ptr(2),
+					},
+				},
+			},
+		},
+		"Timestamp and step columns for logs in CSV format": {
+			fileName: "columns.csv",
+			testData: `wall_time, global_step, loss, step
+2021-12-02T14:27:50Z, 10, 0.2208, 1
+2021-12-02T14:27:51Z, 20, 0.1414, 2`,
+			metrics:         []string{"loss"},
+			timestampColumn: "wall_time",
+			stepColumn:      "global_step",
+			fileFormat:      commonv1beta1.CsvFormat,
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: "2021-12-02T14:27:50Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.2208",
+						},
+						Step: int64Ptr(10),
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:51Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.1414",
+						},
+						Step: int64Ptr(20),
+					},
+				},
+			},
+		},
+		"Invalid formatted file for logs in CSV format": {
+			fileName: "invalid-format.csv",
+			testData: `epoch,accuracy
+0,"0.8078`,
+			metrics:    []string{"accuracy"},
+			fileFormat: commonv1beta1.CsvFormat,
+			wantError:  errParseCsv,
+		},
+		"Positive case for logs in JSONDocument format": {
+			fileName: "history.json",
+			testData: `[
+  {"epoch": 0, "accuracy": 0.8078, "eval": {"acc": 0.7912}, "timestamp": "2021-12-02T14:27:50Z"},
+  {"epoch": 1, "accuracy": 0.9012, "eval": {"acc": 0.8823}, "timestamp": "2021-12-02T14:27:51Z"}
+]`,
+			metrics:    []string{"accuracy", "eval-accuracy"},
+			selectors:  map[string]string{"eval-accuracy": "$.eval.acc"},
+			fileFormat: commonv1beta1.JsonDocumentFormat,
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: "2021-12-02T14:27:50Z",
+						Metric: &v1beta1.Metric{
+							Name:  "accuracy",
+							Value: "0.8078",
+						},
+						Step: int64Ptr(0),
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:50Z",
+						Metric: &v1beta1.Metric{
+							Name:  "eval-accuracy",
+							Value: "0.7912",
+						},
+						Step: int64Ptr(0),
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:51Z",
+						Metric: &v1beta1.Metric{
+							Name:  "accuracy",
+							Value: "0.9012",
+						},
+						Step: int64Ptr(1),
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:51Z",
+						Metric: &v1beta1.Metric{
+							Name:  "eval-accuracy",
+							Value: "0.8823",
+						},
+						Step: int64Ptr(1),
+					},
+				},
+			},
+		},
+		"Invalid formatted file for logs in JSONDocument format": {
+			fileName:   "invalid-format-document.json",
+			testData:   `[{"accuracy": 0.8078}, 0.9012]`,
+			metrics:    []string{"accuracy"},
+			fileFormat: commonv1beta1.JsonDocumentFormat,
+			wantError:  errParseJson,
+		},
+		"Positive case for logs in YAML format": {
+			fileName: "results.yaml",
+			testData: `timestamp: "2021-12-02T14:27:50Z"
+step: 100
+metrics:
+  accuracy: 0.9349
+  loss: 0.1068`,
+			metrics:    []string{"accuracy", "loss"},
+			selectors:  map[string]string{"accuracy": "metrics.accuracy", "loss": "$['metrics']['loss']"},
+			fileFormat: commonv1beta1.YamlFormat,
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: "2021-12-02T14:27:50Z",
+						Metric: &v1beta1.Metric{
+							Name:  "accuracy",
+							Value: "0.9349",
+						},
+						Step: int64Ptr(100),
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:50Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.1068",
+						},
+						Step: int64Ptr(100),
+					},
+				},
+			},
+		},
+		"Invalid formatted file for logs in YAML format": {
+			fileName: "invalid-format.yaml",
+			testData: `metrics:
+  accuracy: [0.9349`,
+			metrics:    []string{"accuracy"},
+			fileFormat: commonv1beta1.YamlFormat,
+			wantError:  errParseYaml,
+		},
 		"Invalid file name": {
 			fileName:   "invalid",
 			fileFormat: commonv1beta1.JsonFormat,
@@ -316,7 +706,15 @@ invalid INFO     {metricName: loss, metricValue: 0.3634}`,
 					t.Fatalf("failed to write test data: %v", err)
 				}
 			}
-			actual, err := CollectObservationLog(filepath.Join(tmpDir, test.fileName), test.metrics, test.filters, test.fileFormat)
+			actual, err := CollectObservationLog(filepath.Join(tmpDir, test.fileName), ParserOptions{
+				Metrics:         test.metrics,
+				Filters:         test.filters,
+				Selectors:       test.selectors,
+				TimestampColumn: test.timestampColumn,
+				StepColumn:      test.stepColumn,
+				TimestampFormat: test.timestampFormat,
+				FileFormat:      test.fileFormat,
+			})
 			if diff := cmp.Diff(test.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
 				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
 			}
@@ -327,3 +725,67 @@ invalid INFO     {metricName: loss, metricValue: 0.3634}`,
 		})
 	}
 }
+
+func TestParseJSONSelector(t *testing.T) {
+	testCases := map[string]struct {
+		selector  string
+		want      string
+		wantError error
+	}{
+		"GJSON path": {
+			selector: "eval.scores.#(name==\"acc\").value",
+			want:     "eval.scores.#(name==\"acc\").value",
+		},
+		"JSONPath with child operators": {
+			selector: "$.eval.acc",
+			want:     "eval.acc",
+		},
+		"JSONPath with brackets": {
+			selector: "$['eval']['val.acc'][0]",
+			want:     "eval.val\\.acc.0",
+		},
+		"JSONPath with quoted bracket": {
+			selector: `$["a]b"].c`,
+			want:     "a\\]b.c",
+		},
+		"Empty selector": {
+			selector:  " ",
+			wantError: errInvalidSelector,
+		},
+		"JSONPath root": {
+			selector:  "$",
+			wantError: errInvalidSelector,
+		},
+		"JSONPath recursive descent": {
+			selector:  "$..acc",
+			wantError: errInvalidSelector,
+		},
+		"JSONPath wildcard": {
+			selector:  "$.scores[*]",
+			wantError: errInvalidSelector,
+		},
+		"JSONPath filter": {
+			selector:  "$.scores[?(@.name=='acc')]",
+			wantError: errInvalidSelector,
+		},
+		"JSONPath unterminated bracket": {
+			selector:  "$['eval'",
+			wantError: errInvalidSelector,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseJSONSelector(tc.selector)
+			if diff := cmp.Diff(tc.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+			if got != tc.want {
+				t.Errorf("Unexpected GJSON path, want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
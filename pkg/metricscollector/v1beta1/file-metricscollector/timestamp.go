@@ -0,0 +1,195 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sidecarmetricscollector
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Presets of the timestamp format in the TEXT format metrics file.
+const (
+	TimestampFormatRFC3339     = "RFC3339"
+	TimestampFormatRFC3339Nano = "RFC3339Nano"
+	// TimestampFormatPython is the default asctime format of the Python logging, for example: 2021-12-02 14:27:50,123.
+	TimestampFormatPython = "Python"
+	// TimestampFormatGlog is the glog prefix, for example: I1202 14:27:50.123456.
+	TimestampFormatGlog = "Glog"
+	// TimestampFormatUnix and TimestampFormatUnixMilli are the seconds and milliseconds since the Unix epoch.
+	TimestampFormatUnix      = "Unix"
+	TimestampFormatUnixMilli = "UnixMilli"
+)
+
+const (
+	pythonLayout = "2006-01-02 15:04:05,000"
+	glogLayout   = "0102 15:04:05.000000"
+	// timestampSubexpName is the name of the capture group of the timestamp,
+	// otherwise the first capture group is used.
+	timestampSubexpName = "timestamp"
+)
+
+var (
+	errInvalidTimestampFormat = errors.New("invalid timestamp format")
+	errParseTimestamp         = errors.New("failed to parse the timestamp")
+
+	glogRegexp = regexp.MustCompile(`^[IWEF](\d{4} \d{2}:\d{2}:\d{2}\.\d{6})`)
+)
+
+// TimestampParser parses the timestamp of the metrics line in the TEXT format.
+type TimestampParser struct {
+	// layout is the Go time layout or the Unix time preset.
+	// If layout is empty, the captured timestamp can be in any of RFC3339Nano, Python or Unix formats.
+	layout string
+	// pattern captures the timestamp in the line,
+	// otherwise the timestamp is at the beginning of the line.
+	pattern *regexp.Regexp
+	index   int
+}
+
+// NewTimestampParser returns the TimestampParser for the timestamp format.
+// Format is either the preset, the regular expression with the capture group of the timestamp or the Go time layout.
+func NewTimestampParser(format string) (*TimestampParser, error) {
+	switch format {
+	case "", TimestampFormatRFC3339Nano:
+		return &TimestampParser{layout: time.RFC3339Nano}, nil
+	case TimestampFormatRFC3339:
+		return &TimestampParser{layout: time.RFC3339}, nil
+	case TimestampFormatPython:
+		return &TimestampParser{layout: pythonLayout}, nil
+	case TimestampFormatGlog:
+		return &TimestampParser{layout: glogLayout, pattern: glogRegexp, index: 1}, nil
+	case TimestampFormatUnix, TimestampFormatUnixMilli:
+		return &TimestampParser{layout: format}, nil
+	}
+
+	if strings.Contains(format, "(") {
+		pattern, err := regexp.Compile(format)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errInvalidTimestampFormat, err.Error())
+		}
+		if pattern.NumSubexp() == 0 {
+			return nil, fmt.Errorf("%w: capture group of the timestamp is required: %s", errInvalidTimestampFormat, format)
+		}
+		index := pattern.SubexpIndex(timestampSubexpName)
+		if index == -1 {
+			index = 1
+		}
+		return &TimestampParser{pattern: pattern, index: index}, nil
+	}
+
+	// The layout must format the time, so it can be parsed back.
+	formatted := time.Date(2021, time.December, 2, 14, 27, 50, 0, time.UTC).Format(format)
+	if formatted == format {
+		return nil, fmt.Errorf("%w: layout doesn't have any time element: %s", errInvalidTimestampFormat, format)
+	}
+	if _, err := time.Parse(format, formatted); err != nil {
+		return nil, fmt.Errorf("%w: %s", errInvalidTimestampFormat, err.Error())
+	}
+	return &TimestampParser{layout: format}, nil
+}
+
+// Parse returns the timestamp of the metrics line in RFC3339Nano format.
+func (p *TimestampParser) Parse(logline string) (string, error) {
+	if p.pattern != nil {
+		match := p.pattern.FindStringSubmatch(logline)
+		if match == nil {
+			return "", fmt.Errorf("%w: line doesn't match %s", errParseTimestamp, p.pattern.String())
+		}
+		return p.parseValue(match[p.index])
+	}
+
+	switch p.layout {
+	case time.RFC3339Nano, time.RFC3339, TimestampFormatUnix, TimestampFormatUnixMilli:
+		// The timestamp is the first field of the line.
+		value, _, found := strings.Cut(logline, " ")
+		if !found {
+			return "", fmt.Errorf("%w: line doesn't begin with timestamp string", errParseTimestamp)
+		}
+		return p.parseValue(value)
+	}
+	// The layout can contain spaces, so the timestamp is the shortest prefix of the line parsed with the layout.
+	err := fmt.Errorf("%w: line doesn't begin with timestamp string", errParseTimestamp)
+	for i := 0; i < len(logline) && i <= 2*len(p.layout); i++ {
+		if logline[i] != ' ' {
+			continue
+		}
+		var timestamp string
+		if timestamp, err = p.parseValue(logline[:i]); err == nil {
+			return timestamp, nil
+		}
+	}
+	return "", err
+}
+
+func (p *TimestampParser) parseValue(value string) (string, error) {
+	switch p.layout {
+	case time.RFC3339Nano, time.RFC3339:
+		if _, err := time.Parse(p.layout, value); err != nil {
+			return "", fmt.Errorf("%w: %s", errParseTimestamp, err.Error())
+		}
+		return value, nil
+	case TimestampFormatUnix:
+		return parseUnixTimestamp(value)
+	case TimestampFormatUnixMilli:
+		msec, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("%w: %s", errParseTimestamp, err.Error())
+		}
+		return time.UnixMilli(msec).UTC().Format(time.RFC3339Nano), nil
+	case "":
+		if _, err := time.Parse(time.RFC3339Nano, value); err == nil {
+			return value, nil
+		}
+		if t, err := time.Parse(pythonLayout, value); err == nil {
+			return t.UTC().Format(time.RFC3339Nano), nil
+		}
+		return parseUnixTimestamp(value)
+	default:
+		t, err := time.Parse(p.layout, value)
+		if err != nil {
+			return "", fmt.Errorf("%w: %s", errParseTimestamp, err.Error())
+		}
+		// Year is not logged in some formats, e.g. glog.
+		if t.Year() == 0 {
+			t = t.AddDate(time.Now().UTC().Year(), 0, 0)
+		}
+		return t.UTC().Format(time.RFC3339Nano), nil
+	}
+}
+
+func parseUnixTimestamp(value string) (string, error) {
+	sec, frac, _ := strings.Cut(value, ".")
+	unixSec, err := strconv.ParseInt(sec, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", errParseTimestamp, err.Error())
+	}
+	var nanoSec uint64
+	if frac != "" {
+		// Fraction of the second is truncated to nanoseconds.
+		if len(frac) > 9 {
+			frac = frac[:9]
+		}
+		if nanoSec, err = strconv.ParseUint(frac+strings.Repeat("0", 9-len(frac)), 10, 64); err != nil {
+			return "", fmt.Errorf("%w: %s", errParseTimestamp, err.Error())
+		}
+	}
+	return time.Unix(unixSec, int64(nanoSec)).UTC().Format(time.RFC3339Nano), nil
+}
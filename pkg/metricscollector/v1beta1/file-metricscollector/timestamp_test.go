@@ -0,0 +1,137 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sidecarmetricscollector
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestTimestampParser(t *testing.T) {
+	year := time.Now().UTC().Year()
+	testCases := map[string]struct {
+		format        string
+		logline       string
+		want          string
+		wantFormatErr error
+		wantParseErr  error
+	}{
+		"Default RFC3339Nano timestamp": {
+			logline: "2021-12-02T14:27:50.000035161+09:00 accuracy=0.9",
+			want:    "2021-12-02T14:27:50.000035161+09:00",
+		},
+		"Missing default timestamp": {
+			logline:      "accuracy=0.9",
+			wantParseErr: errParseTimestamp,
+		},
+		"RFC3339 preset": {
+			format:  TimestampFormatRFC3339,
+			logline: "2021-12-02T14:27:50Z accuracy=0.9",
+			want:    "2021-12-02T14:27:50Z",
+		},
+		"Python preset": {
+			format:  TimestampFormatPython,
+			logline: "2021-12-02 14:27:50,123 - INFO - accuracy=0.9",
+			want:    "2021-12-02T14:27:50.123Z",
+		},
+		"Glog preset": {
+			format:  TimestampFormatGlog,
+			logline: "I1202 14:27:50.000035    12 train.cc:10] accuracy=0.9",
+			want:    fmt.Sprintf("%d-12-02T14:27:50.000035Z", year),
+		},
+		"Unix preset": {
+			format:  TimestampFormatUnix,
+			logline: "1638422847.28721 accuracy=0.9",
+			want:    "2021-12-02T05:27:27.28721Z",
+		},
+		"UnixMilli preset": {
+			format:  TimestampFormatUnixMilli,
+			logline: "1638422847287 accuracy=0.9",
+			want:    "2021-12-02T05:27:27.287Z",
+		},
+		"Invalid Unix timestamp": {
+			format:       TimestampFormatUnix,
+			logline:      "2021-12-02T14:27:50Z accuracy=0.9",
+			wantParseErr: errParseTimestamp,
+		},
+		"Go layout": {
+			format:  "02/01/2006 15:04:05 MST",
+			logline: "02/12/2021 14:27:50 UTC [train] accuracy=0.9",
+			want:    "2021-12-02T14:27:50Z",
+		},
+		"Go layout with spaces": {
+			format:  "2006-01-02 15:04:05",
+			logline: "2021-12-02 14:27:50 INFO accuracy=0.9",
+			want:    "2021-12-02T14:27:50Z",
+		},
+		"Go layout without year": {
+			format:  "Jan _2 15:04:05",
+			logline: "Dec  2 14:27:50 accuracy=0.9",
+			want:    fmt.Sprintf("%d-12-02T14:27:50Z", year),
+		},
+		"Regular expression": {
+			format:  `step_time=([0-9.]+)`,
+			logline: "epoch 1 step_time=1638422847 accuracy=0.9",
+			want:    "2021-12-02T05:27:27Z",
+		},
+		"Regular expression with named capture group": {
+			format:  `\[(\w+)\] \[(?P<timestamp>[^\]]+)\]`,
+			logline: "[INFO] [2021-12-02 14:27:50,123] accuracy=0.9",
+			want:    "2021-12-02T14:27:50.123Z",
+		},
+		"Line doesn't match regular expression": {
+			format:       `time=(\S+)`,
+			logline:      "accuracy=0.9",
+			wantParseErr: errParseTimestamp,
+		},
+		"Invalid regular expression": {
+			format:        `time=(\S+`,
+			wantFormatErr: errInvalidTimestampFormat,
+		},
+		"Regular expression without capture group": {
+			format:        `time=\(\S+\)`,
+			wantFormatErr: errInvalidTimestampFormat,
+		},
+		"Layout without time elements": {
+			format:        "timestamp",
+			wantFormatErr: errInvalidTimestampFormat,
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			parser, err := NewTimestampParser(test.format)
+			if diff := cmp.Diff(test.wantFormatErr, err, cmpopts.EquateErrors()); len(diff) != 0 {
+				t.Fatalf("Unexpected error from NewTimestampParser (-want,+got):\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			got, err := parser.Parse(test.logline)
+			if diff := cmp.Diff(test.wantParseErr, err, cmpopts.EquateErrors()); len(diff) != 0 {
+				t.Errorf("Unexpected error from Parse (-want,+got):\n%s", diff)
+			}
+			if got != test.want {
+				t.Errorf("Unexpected timestamp, want: %s, got: %s", test.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,418 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheusmetricscollector
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+)
+
+const (
+	// acceptHeader prefers the OpenMetrics format and falls back to the Prometheus text format.
+	acceptHeader = "application/openmetrics-text;version=1.0.0,text/plain;version=0.0.4;q=0.5,*/*;q=0.1"
+
+	openMetricsMediaType = "application/openmetrics-text"
+)
+
+var (
+	errScrape         = errors.New("failed to scrape the metrics endpoint")
+	errParseMetrics   = errors.New("failed to parse the metrics")
+	errParseSelector  = errors.New("failed to parse the selector")
+	errInvalidLabels  = errors.New("invalid labels")
+	errInvalidSamples = errors.New("invalid sample")
+)
+
+// Selector selects the series of the metric by the label values,
+// e.g. accuracy{phase="validation"}.
+type Selector struct {
+	Name   string
+	Labels map[string]string
+}
+
+// ParseSelector parses the selector in the <metric_name>{<label>="<value>",...} format.
+// Labels are optional.
+func ParseSelector(s string) (Selector, error) {
+	name, rest := cutMetricName(strings.TrimSpace(s))
+	if name == "" {
+		return Selector{}, fmt.Errorf("%w: metric name is required in %q", errParseSelector, s)
+	}
+	labels := map[string]string{}
+	if strings.HasPrefix(rest, "{") {
+		var err error
+		if labels, rest, err = parseLabels(rest); err != nil {
+			return Selector{}, fmt.Errorf("%w: %w", errParseSelector, err)
+		}
+	}
+	if strings.TrimSpace(rest) != "" {
+		return Selector{}, fmt.Errorf("%w: unexpected %q in %q", errParseSelector, rest, s)
+	}
+	return Selector{Name: name, Labels: labels}, nil
+}
+
+func (s Selector) matches(smp sample) bool {
+	if s.Name != smp.name {
+		return false
+	}
+	for k, v := range s.Labels {
+		if smp.labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// sample is the single sample of the scraped metrics.
+type sample struct {
+	name      string
+	labels    map[string]string
+	value     float64
+	timestamp time.Time
+}
+
+// seriesKey returns the unique key of the sample series.
+func (s sample) seriesKey() string {
+	keys := make([]string, 0, len(s.labels))
+	for k := range s.labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	b.WriteString(s.name)
+	for _, k := range keys {
+		fmt.Fprintf(&b, ",%s=%q", k, s.labels[k])
+	}
+	return b.String()
+}
+
+type seriesValue struct {
+	value string
+	step  *int64
+}
+
+// MetricsCollector scrapes the metrics endpoint in the Prometheus text or OpenMetrics format
+// and keeps the collected metrics logs.
+// A sample is recorded only when its value or step is changed since the previous scrape.
+type MetricsCollector struct {
+	url       string
+	headers   http.Header
+	client    *http.Client
+	metrics   []string
+	selectors map[string][]Selector
+
+	mu         sync.Mutex
+	metricLogs []*v1beta1.MetricLog
+	lastValues map[string]seriesValue
+}
+
+// New returns the MetricsCollector for the endpoint url.
+// metrics are the objective and additional metric names. The series of the metric are
+// filtered by its selectors, if the metric doesn't have selectors all series are collected.
+func New(url string, headers http.Header, metrics []string, selectors []Selector, timeout time.Duration) *MetricsCollector {
+	selectorMap := map[string][]Selector{}
+	for _, s := range selectors {
+		selectorMap[s.Name] = append(selectorMap[s.Name], s)
+	}
+	return &MetricsCollector{
+		url:        url,
+		headers:    headers,
+		client:     &http.Client{Timeout: timeout},
+		metrics:    metrics,
+		selectors:  selectorMap,
+		lastValues: map[string]seriesValue{},
+	}
+}
+
+// Scrape scrapes the metrics endpoint once and returns the new metrics logs.
+func (c *MetricsCollector) Scrape(ctx context.Context) ([]*v1beta1.MetricLog, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errScrape, err)
+	}
+	for k, v := range c.headers {
+		req.Header[k] = v
+	}
+	req.Header.Set("Accept", acceptHeader)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errScrape, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: unexpected status code %d", errScrape, resp.StatusCode)
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	samples, err := parseMetrics(resp.Body, mediaType == openMetricsMediaType)
+	if err != nil {
+		return nil, err
+	}
+	return c.record(samples, time.Now()), nil
+}
+
+// record records the samples of the collected metrics which are changed since the previous scrape.
+func (c *MetricsCollector) record(samples []sample, scrapeTime time.Time) []*v1beta1.MetricLog {
+	step := getStep(samples)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	newLogs := []*v1beta1.MetricLog{}
+	for _, smp := range samples {
+		if !c.isCollected(smp) {
+			continue
+		}
+		// NaN and infinite values can't be compared by the Katib controller.
+		if math.IsNaN(smp.value) || math.IsInf(smp.value, 0) {
+			continue
+		}
+		current := seriesValue{value: strconv.FormatFloat(smp.value, 'f', -1, 64), step: step}
+		key := smp.seriesKey()
+		if last, ok := c.lastValues[key]; ok && last.value == current.value && equalStep(last.step, current.step) {
+			continue
+		}
+		c.lastValues[key] = current
+
+		timestamp := scrapeTime
+		if !smp.timestamp.IsZero() {
+			timestamp = smp.timestamp
+		}
+		newLogs = append(newLogs, &v1beta1.MetricLog{
+			TimeStamp: timestamp.UTC().Format(time.RFC3339Nano),
+			Metric: &v1beta1.Metric{
+				Name:  smp.name,
+				Value: current.value,
+			},
+			Step: step,
+		})
+	}
+	c.metricLogs = append(c.metricLogs, newLogs...)
+	return newLogs
+}
+
+func (c *MetricsCollector) isCollected(smp sample) bool {
+	isMetric := false
+	for _, m := range c.metrics {
+		if m == smp.name {
+			isMetric = true
+			break
+		}
+	}
+	if !isMetric {
+		return false
+	}
+	selectors, ok := c.selectors[smp.name]
+	if !ok {
+		return true
+	}
+	for _, s := range selectors {
+		if s.matches(smp) {
+			return true
+		}
+	}
+	return false
+}
+
+// ObservationLog returns the observation log with all collected metrics logs.
+func (c *MetricsCollector) ObservationLog() *v1beta1.ObservationLog {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return common.NewObservationLog(append([]*v1beta1.MetricLog{}, c.metricLogs...), c.metrics)
+}
+
+// getStep returns the step from the step or epoch metric in the scraped samples.
+// Step takes precedence if both are reported.
+func getStep(samples []sample) *int64 {
+	for _, key := range []string{common.StepKey, common.EpochKey} {
+		for _, smp := range samples {
+			if smp.name != key || smp.value != math.Trunc(smp.value) {
+				continue
+			}
+			step := int64(smp.value)
+			return &step
+		}
+	}
+	return nil
+}
+
+func equalStep(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// parseMetrics parses the samples in the Prometheus text or OpenMetrics format.
+// Metadata lines and OpenMetrics exemplars are skipped.
+func parseMetrics(r io.Reader, openMetrics bool) ([]sample, error) {
+	samples := []sample{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		smp, err := parseSample(line, openMetrics)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", errParseMetrics, lineNum, err)
+		}
+		samples = append(samples, smp)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", errParseMetrics, err)
+	}
+	return samples, nil
+}
+
+// parseSample parses the line in the <metric_name>[{<labels>}] <value> [<timestamp>] format.
+// The timestamp is in milliseconds for the Prometheus text format and in seconds for the OpenMetrics format.
+func parseSample(line string, openMetrics bool) (sample, error) {
+	name, rest := cutMetricName(line)
+	if name == "" {
+		return sample{}, fmt.Errorf("%w: metric name is required in %q", errInvalidSamples, line)
+	}
+	smp := sample{name: name, labels: map[string]string{}}
+	if strings.HasPrefix(rest, "{") {
+		var err error
+		if smp.labels, rest, err = parseLabels(rest); err != nil {
+			return sample{}, err
+		}
+	}
+	// OpenMetrics exemplar follows the value and timestamp after the "#" separator.
+	rest, _, _ = strings.Cut(rest, "#")
+
+	fields := strings.Fields(rest)
+	if len(fields) == 0 || len(fields) > 2 {
+		return sample{}, fmt.Errorf("%w: value and optional timestamp are required in %q", errInvalidSamples, line)
+	}
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return sample{}, fmt.Errorf("%w: %w", errInvalidSamples, err)
+	}
+	smp.value = value
+
+	if len(fields) == 2 {
+		if openMetrics {
+			sec, err := strconv.ParseFloat(fields[1], 64)
+			if err != nil {
+				return sample{}, fmt.Errorf("%w: %w", errInvalidSamples, err)
+			}
+			whole, frac := math.Modf(sec)
+			smp.timestamp = time.Unix(int64(whole), int64(frac*1e9))
+		} else {
+			msec, err := strconv.ParseInt(fields[1], 10, 64)
+			if err != nil {
+				return sample{}, fmt.Errorf("%w: %w", errInvalidSamples, err)
+			}
+			smp.timestamp = time.UnixMilli(msec)
+		}
+	}
+	return smp, nil
+}
+
+// cutMetricName returns the metric name and the rest of the string after the name.
+func cutMetricName(s string) (string, string) {
+	end := strings.IndexAny(s, "{ \t")
+	if end < 0 {
+		return s, ""
+	}
+	return s[:end], strings.TrimLeft(s[end:], " \t")
+}
+
+// parseLabels parses the labels in the {<label>="<value>",...} format
+// and returns the rest of the string after the labels.
+func parseLabels(s string) (map[string]string, string, error) {
+	labels := map[string]string{}
+	i := 1
+	for {
+		for i < len(s) && (s[i] == ' ' || s[i] == '\t') {
+			i++
+		}
+		if i >= len(s) {
+			return nil, "", fmt.Errorf("%w: missing closing brace in %q", errInvalidLabels, s)
+		}
+		if s[i] == '}' {
+			return labels, s[i+1:], nil
+		}
+
+		eq := strings.IndexByte(s[i:], '=')
+		if eq < 0 {
+			return nil, "", fmt.Errorf("%w: missing label value in %q", errInvalidLabels, s)
+		}
+		name := strings.TrimSpace(s[i : i+eq])
+		if name == "" {
+			return nil, "", fmt.Errorf("%w: empty label name in %q", errInvalidLabels, s)
+		}
+		i += eq + 1
+		for i < len(s) && (s[i] == ' ' || s[i] == '\t') {
+			i++
+		}
+		if i >= len(s) || s[i] != '"' {
+			return nil, "", fmt.Errorf("%w: label value must be quoted in %q", errInvalidLabels, s)
+		}
+		i++
+
+		var value strings.Builder
+		closed := false
+		for i < len(s) && !closed {
+			switch {
+			case s[i] == '\\' && i+1 < len(s):
+				switch s[i+1] {
+				case 'n':
+					value.WriteByte('\n')
+				default:
+					value.WriteByte(s[i+1])
+				}
+				i += 2
+			case s[i] == '"':
+				closed = true
+				i++
+			default:
+				value.WriteByte(s[i])
+				i++
+			}
+		}
+		if !closed {
+			return nil, "", fmt.Errorf("%w: unterminated label value in %q", errInvalidLabels, s)
+		}
+		labels[name] = value.String()
+
+		for i < len(s) && (s[i] == ' ' || s[i] == '\t') {
+			i++
+		}
+		if i < len(s) && s[i] == ',' {
+			i++
+		}
+	}
+}
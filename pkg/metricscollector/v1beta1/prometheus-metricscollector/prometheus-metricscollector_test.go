@@ -0,0 +1,215 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheusmetricscollector
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+func TestParseMetrics(t *testing.T) {
+	testCases := map[string]struct {
+		testData    string
+		openMetrics bool
+		wantError   error
+		expected    []sample
+	}{
+		"Positive case for metrics in Prometheus text format": {
+			testData: `# HELP accuracy Model accuracy.
+# TYPE accuracy gauge
+accuracy{phase="train"} 0.91
+accuracy{phase="validation",model="a \"b\" c"} 0.87 1638422847000
+loss 3.2e-01
+`,
+			expected: []sample{
+				{name: "accuracy", labels: map[string]string{"phase": "train"}, value: 0.91},
+				{
+					name:      "accuracy",
+					labels:    map[string]string{"phase": "validation", "model": `a "b" c`},
+					value:     0.87,
+					timestamp: time.UnixMilli(1638422847000),
+				},
+				{name: "loss", labels: map[string]string{}, value: 0.32},
+			},
+		},
+		"Positive case for metrics in OpenMetrics format": {
+			testData: `# TYPE accuracy gauge
+# UNIT accuracy ratio
+accuracy{phase="validation"} 0.87 1638422847.5
+# TYPE requests counter
+requests_total 10 # {trace_id="abc"} 1.0
+# EOF
+`,
+			openMetrics: true,
+			expected: []sample{
+				{
+					name:      "accuracy",
+					labels:    map[string]string{"phase": "validation"},
+					value:     0.87,
+					timestamp: time.Unix(1638422847, 5e8),
+				},
+				{name: "requests_total", labels: map[string]string{}, value: 10},
+			},
+		},
+		"Invalid value": {
+			testData:  "accuracy invalid",
+			wantError: errParseMetrics,
+		},
+		"Unterminated labels": {
+			testData:  `accuracy{phase="validation 0.87`,
+			wantError: errParseMetrics,
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := parseMetrics(strings.NewReader(test.testData), test.openMetrics)
+			if diff := cmp.Diff(test.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.expected, actual, cmp.AllowUnexported(sample{})); test.wantError == nil && len(diff) != 0 {
+				t.Errorf("Unexpected samples (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseSelector(t *testing.T) {
+	testCases := map[string]struct {
+		selector  string
+		wantError error
+		expected  Selector
+	}{
+		"Selector without labels": {
+			selector: "accuracy",
+			expected: Selector{Name: "accuracy", Labels: map[string]string{}},
+		},
+		"Selector with labels": {
+			selector: `accuracy{phase="validation", split="test"}`,
+			expected: Selector{Name: "accuracy", Labels: map[string]string{"phase": "validation", "split": "test"}},
+		},
+		"Unquoted label value": {
+			selector:  "accuracy{phase=validation}",
+			wantError: errParseSelector,
+		},
+		"Unexpected suffix": {
+			selector:  `accuracy{phase="validation"} 0.8`,
+			wantError: errParseSelector,
+		},
+		"Empty selector": {
+			selector:  "",
+			wantError: errParseSelector,
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := ParseSelector(test.selector)
+			if diff := cmp.Diff(test.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.expected, actual, cmpopts.EquateEmpty()); test.wantError == nil && len(diff) != 0 {
+				t.Errorf("Unexpected selector (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestScrape(t *testing.T) {
+	responses := []string{
+		`accuracy{phase="train"} 0.5
+accuracy{phase="validation"} 0.4
+loss 0.9
+epoch 1
+`,
+		// Unchanged values are not recorded.
+		`accuracy{phase="train"} 0.5
+accuracy{phase="validation"} 0.4
+loss 0.9
+epoch 1
+`,
+		`accuracy{phase="train"} 0.7
+accuracy{phase="validation"} 0.6
+loss NaN
+epoch 2
+`,
+	}
+	scrapes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		w.Write([]byte(responses[scrapes]))
+		scrapes++
+	}))
+	defer server.Close()
+
+	selector, err := ParseSelector(`accuracy{phase="validation"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	headers := http.Header{}
+	headers.Set("Authorization", "Bearer token")
+	collector := New(server.URL, headers, []string{"accuracy", "loss"}, []Selector{selector}, time.Second)
+
+	wantNewLogs := []int{2, 0, 1}
+	for i := range responses {
+		newLogs, err := collector.Scrape(context.Background())
+		if err != nil {
+			t.Fatalf("Unexpected error from Scrape: %v", err)
+		}
+		if len(newLogs) != wantNewLogs[i] {
+			t.Errorf("Expected %d new metrics logs on scrape %d, got %d", wantNewLogs[i], i, len(newLogs))
+		}
+	}
+
+	step1, step2 := int64(1), int64(2)
+	expected := &v1beta1.ObservationLog{
+		MetricLogs: []*v1beta1.MetricLog{
+			{Metric: &v1beta1.Metric{Name: "accuracy", Value: "0.4"}, Step: &step1},
+			{Metric: &v1beta1.Metric{Name: "loss", Value: "0.9"}, Step: &step1},
+			{Metric: &v1beta1.Metric{Name: "accuracy", Value: "0.6"}, Step: &step2},
+		},
+	}
+	observationLogCmpOpts := []cmp.Option{
+		cmpopts.IgnoreUnexported(v1beta1.ObservationLog{}, v1beta1.MetricLog{}, v1beta1.Metric{}),
+		cmpopts.IgnoreFields(v1beta1.MetricLog{}, "TimeStamp"),
+	}
+	if diff := cmp.Diff(expected, collector.ObservationLog(), observationLogCmpOpts...); len(diff) != 0 {
+		t.Errorf("Unexpected observation log (-want,+got):\n%s", diff)
+	}
+}
+
+func TestObservationLogWithoutObjective(t *testing.T) {
+	collector := New("", nil, []string{"accuracy"}, nil, time.Second)
+	olog := collector.ObservationLog()
+	if len(olog.MetricLogs) != 1 || olog.MetricLogs[0].Metric.Value != consts.UnavailableMetricValue {
+		t.Errorf("Expected unavailable objective metric value, got %v", olog)
+	}
+}
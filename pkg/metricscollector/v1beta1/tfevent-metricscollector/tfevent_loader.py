@@ -70,6 +70,7 @@ class TFEventFileParser:
                         metric=api_pb2.Metric(
                             name=m, value=str(tf.make_ndarray(tensor.tensor_proto))
                         ),
+                        step=tensor.step,
                     )
                     metric_logs.append(ml)
 
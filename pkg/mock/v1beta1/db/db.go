@@ -11,9 +11,12 @@ package mock
 
 import (
 	reflect "reflect"
+	time "time"
 
 	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	common "github.com/kubeflow/katib/pkg/db/v1beta1/common"
 	gomock "go.uber.org/mock/gomock"
+	types "k8s.io/apimachinery/pkg/types"
 )
 
 // MockKatibDBInterface is a mock of KatibDBInterface interface.
@@ -39,6 +42,21 @@ func (m *MockKatibDBInterface) EXPECT() *MockKatibDBInterfaceMockRecorder {
 	return m.recorder
 }
 
+// BackfillObservationLogNamespace mocks base method.
+func (m *MockKatibDBInterface) BackfillObservationLogNamespace(arg0, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillObservationLogNamespace", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackfillObservationLogNamespace indicates an expected call of BackfillObservationLogNamespace.
+func (mr *MockKatibDBInterfaceMockRecorder) BackfillObservationLogNamespace(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillObservationLogNamespace", reflect.TypeOf((*MockKatibDBInterface)(nil).BackfillObservationLogNamespace), arg0, arg1)
+}
+
 // DBInit mocks base method.
 func (m *MockKatibDBInterface) DBInit() {
 	m.ctrl.T.Helper()
@@ -52,46 +70,165 @@ func (mr *MockKatibDBInterfaceMockRecorder) DBInit() *gomock.Call {
 }
 
 // DeleteObservationLog mocks base method.
-func (m *MockKatibDBInterface) DeleteObservationLog(arg0 string) error {
+func (m *MockKatibDBInterface) DeleteObservationLog(arg0, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteObservationLog", arg0)
+	ret := m.ctrl.Call(m, "DeleteObservationLog", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteObservationLog indicates an expected call of DeleteObservationLog.
-func (mr *MockKatibDBInterfaceMockRecorder) DeleteObservationLog(arg0 any) *gomock.Call {
+func (mr *MockKatibDBInterfaceMockRecorder) DeleteObservationLog(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).DeleteObservationLog), arg0, arg1)
+}
+
+// DownsampleObservationLog mocks base method.
+func (m *MockKatibDBInterface) DownsampleObservationLog(arg0 time.Time, arg1 time.Duration, arg2 int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownsampleObservationLog", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownsampleObservationLog indicates an expected call of DownsampleObservationLog.
+func (mr *MockKatibDBInterfaceMockRecorder) DownsampleObservationLog(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownsampleObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).DownsampleObservationLog), arg0, arg1, arg2)
+}
+
+// ExpireObservationLog mocks base method.
+func (m *MockKatibDBInterface) ExpireObservationLog(arg0 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireObservationLog", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireObservationLog indicates an expected call of ExpireObservationLog.
+func (mr *MockKatibDBInterfaceMockRecorder) ExpireObservationLog(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).DeleteObservationLog), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).ExpireObservationLog), arg0)
 }
 
 // GetObservationLog mocks base method.
-func (m *MockKatibDBInterface) GetObservationLog(arg0, arg1, arg2, arg3 string) (*api_v1_beta1.ObservationLog, error) {
+func (m *MockKatibDBInterface) GetObservationLog(arg0 *api_v1_beta1.GetObservationLogRequest) (*api_v1_beta1.ObservationLog, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetObservationLog", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "GetObservationLog", arg0)
 	ret0, _ := ret[0].(*api_v1_beta1.ObservationLog)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetObservationLog indicates an expected call of GetObservationLog.
+func (mr *MockKatibDBInterfaceMockRecorder) GetObservationLog(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).GetObservationLog), arg0)
+}
+
+// GetObservationSummary mocks base method.
+func (m *MockKatibDBInterface) GetObservationSummary(arg0, arg1 string, arg2 []string) ([]*api_v1_beta1.MetricSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObservationSummary", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*api_v1_beta1.MetricSummary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetObservationLog indicates an expected call of GetObservationLog.
-func (mr *MockKatibDBInterfaceMockRecorder) GetObservationLog(arg0, arg1, arg2, arg3 any) *gomock.Call {
+// GetObservationSummary indicates an expected call of GetObservationSummary.
+func (mr *MockKatibDBInterfaceMockRecorder) GetObservationSummary(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObservationSummary", reflect.TypeOf((*MockKatibDBInterface)(nil).GetObservationSummary), arg0, arg1, arg2)
+}
+
+// ListLegacyObservationLogTrials mocks base method.
+func (m *MockKatibDBInterface) ListLegacyObservationLogTrials() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLegacyObservationLogTrials")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLegacyObservationLogTrials indicates an expected call of ListLegacyObservationLogTrials.
+func (mr *MockKatibDBInterfaceMockRecorder) ListLegacyObservationLogTrials() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLegacyObservationLogTrials", reflect.TypeOf((*MockKatibDBInterface)(nil).ListLegacyObservationLogTrials))
+}
+
+// ListObservationLogTrials mocks base method.
+func (m *MockKatibDBInterface) ListObservationLogTrials() ([]types.NamespacedName, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObservationLogTrials")
+	ret0, _ := ret[0].([]types.NamespacedName)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObservationLogTrials indicates an expected call of ListObservationLogTrials.
+func (mr *MockKatibDBInterfaceMockRecorder) ListObservationLogTrials() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObservationLogTrials", reflect.TypeOf((*MockKatibDBInterface)(nil).ListObservationLogTrials))
+}
+
+// MigrateDown mocks base method.
+func (m *MockKatibDBInterface) MigrateDown(arg0 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateDown", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MigrateDown indicates an expected call of MigrateDown.
+func (mr *MockKatibDBInterfaceMockRecorder) MigrateDown(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateDown", reflect.TypeOf((*MockKatibDBInterface)(nil).MigrateDown), arg0)
+}
+
+// MigrateUp mocks base method.
+func (m *MockKatibDBInterface) MigrateUp() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateUp")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MigrateUp indicates an expected call of MigrateUp.
+func (mr *MockKatibDBInterfaceMockRecorder) MigrateUp() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateUp", reflect.TypeOf((*MockKatibDBInterface)(nil).MigrateUp))
+}
+
+// MigrationStatus mocks base method.
+func (m *MockKatibDBInterface) MigrationStatus() (*common.MigrationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrationStatus")
+	ret0, _ := ret[0].(*common.MigrationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrationStatus indicates an expected call of MigrationStatus.
+func (mr *MockKatibDBInterfaceMockRecorder) MigrationStatus() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).GetObservationLog), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrationStatus", reflect.TypeOf((*MockKatibDBInterface)(nil).MigrationStatus))
 }
 
 // RegisterObservationLog mocks base method.
-func (m *MockKatibDBInterface) RegisterObservationLog(arg0 string, arg1 *api_v1_beta1.ObservationLog) error {
+func (m *MockKatibDBInterface) RegisterObservationLog(arg0, arg1, arg2 string, arg3 *api_v1_beta1.ObservationLog) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegisterObservationLog", arg0, arg1)
+	ret := m.ctrl.Call(m, "RegisterObservationLog", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RegisterObservationLog indicates an expected call of RegisterObservationLog.
-func (mr *MockKatibDBInterfaceMockRecorder) RegisterObservationLog(arg0, arg1 any) *gomock.Call {
+func (mr *MockKatibDBInterfaceMockRecorder) RegisterObservationLog(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).RegisterObservationLog), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).RegisterObservationLog), arg0, arg1, arg2, arg3)
 }
 
 // SelectOne mocks base method.
@@ -17,7 +17,9 @@ limitations under the License.
 package suggestion_goptuna_v1beta1
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 	"strconv"
 	"time"
 
@@ -92,6 +94,45 @@ func toGoptunaSampler(algorithm *api_v1_beta1.AlgorithmSpec) (goptuna.Sampler, g
 		return tpe.NewSampler(opts...), nil, nil
 	} else if name == AlgorithmSobol {
 		return nil, sobol.NewSampler(), nil
+	} else if name == AlgorithmNSGA2 {
+		randomOpts := make([]goptuna.RandomSamplerOption, 0, 1)
+		opts := make([]nsga2SamplerOption, 0, len(algorithm.GetAlgorithmSettings()))
+		for _, s := range algorithm.GetAlgorithmSettings() {
+			if s.Name == "random_state" {
+				seed, err := strconv.Atoi(s.Value)
+				if err != nil {
+					return nil, nil, err
+				}
+				randomOpts = append(randomOpts, goptuna.RandomSamplerOptionSeed(int64(seed)))
+				opts = append(opts, nsga2SamplerOptionSeed(int64(seed)))
+			} else if s.Name == "population_size" {
+				n, err := strconv.Atoi(s.Value)
+				if err != nil {
+					return nil, nil, err
+				}
+				if n < 2 {
+					return nil, nil, fmt.Errorf("population_size must be greater than 1: %d", n)
+				}
+				opts = append(opts, nsga2SamplerOptionPopulationSize(n))
+			} else if s.Name == "crossover_prob" || s.Name == "swapping_prob" || s.Name == "mutation_prob" {
+				prob, err := strconv.ParseFloat(s.Value, 64)
+				if err != nil {
+					return nil, nil, err
+				}
+				if prob < 0 || prob > 1 {
+					return nil, nil, fmt.Errorf("%s must be in [0, 1]: %f", s.Name, prob)
+				}
+				switch s.Name {
+				case "crossover_prob":
+					opts = append(opts, nsga2SamplerOptionCrossoverProb(prob))
+				case "swapping_prob":
+					opts = append(opts, nsga2SamplerOptionSwappingProb(prob))
+				case "mutation_prob":
+					opts = append(opts, nsga2SamplerOptionMutationProb(prob))
+				}
+			}
+		}
+		return goptuna.NewRandomSampler(randomOpts...), newNSGA2Sampler(opts...), nil
 	} else {
 		opts := make([]goptuna.RandomSamplerOption, 0, len(algorithm.GetAlgorithmSettings()))
 		for _, s := range algorithm.GetAlgorithmSettings() {
@@ -213,9 +254,58 @@ func getFinalMetric(objectMetricName string, trial *api_v1_beta1.Trial) (float64
 	return 0, fmt.Errorf("No objective metric in Trial %v", trial)
 }
 
+// getObjectiveValues returns the final values of the objective metric and the additional objectives.
+// Values of the maximized objectives are negated, so all returned values are minimized.
+func getObjectiveValues(objective *api_v1_beta1.ObjectiveSpec, trial *api_v1_beta1.Trial) ([]float64, error) {
+	directions := []api_v1_beta1.ObjectiveType{objective.GetType()}
+	names := []string{objective.GetObjectiveMetricName()}
+	for _, o := range objective.GetAdditionalObjectives() {
+		directions = append(directions, o.GetType())
+		names = append(names, o.GetObjectiveMetricName())
+	}
+
+	values := make([]float64, 0, len(names))
+	for i := range names {
+		v, err := getFinalMetric(names[i], trial)
+		if err != nil {
+			return nil, err
+		}
+		if directions[i] == api_v1_beta1.ObjectiveType_MAXIMIZE {
+			v = -v
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// getConstraintViolation returns the total amount of the constraint violations of the Trial.
+// It is zero if the Trial satisfies all constraints, and it is the max float value
+// if any constraint metric is unavailable.
+func getConstraintViolation(objective *api_v1_beta1.ObjectiveSpec, trial *api_v1_beta1.Trial) float64 {
+	violation := 0.0
+	for _, c := range objective.GetConstraints() {
+		v, err := getFinalMetric(c.GetMetricName(), trial)
+		if err != nil {
+			return math.MaxFloat64
+		}
+		var isViolated bool
+		switch c.GetComparison() {
+		case api_v1_beta1.ComparisonType_LESS:
+			isViolated = v >= c.GetBound()
+		case api_v1_beta1.ComparisonType_GREATER:
+			isViolated = v <= c.GetBound()
+		}
+		if isViolated {
+			// The violation is positive even if the metric value is equal to the bound.
+			violation += math.Max(math.Abs(v-c.GetBound()), math.SmallestNonzeroFloat64)
+		}
+	}
+	return violation
+}
+
 func toGoptunaTrials(
 	ktrials []*api_v1_beta1.Trial,
-	objectMetricName string,
+	objective *api_v1_beta1.ObjectiveSpec,
 	study *goptuna.Study,
 	searchSpace map[string]interface{},
 ) (map[string]goptuna.FrozenTrial, error) {
@@ -241,11 +331,26 @@ func toGoptunaTrials(
 		}
 
 		var finalValue float64
+		var systemAttrs map[string]string
 		if state == goptuna.TrialStateComplete {
-			finalValue, err = getFinalMetric(objectMetricName, kt)
+			finalValue, err = getFinalMetric(objective.GetObjectiveMetricName(), kt)
+			if err != nil {
+				return nil, err
+			}
+			// All objective values are stored for the multi-objective samplers.
+			objectiveValues, err := getObjectiveValues(objective, kt)
 			if err != nil {
 				return nil, err
 			}
+			encoded, err := json.Marshal(objectiveValues)
+			if err != nil {
+				return nil, err
+			}
+			systemAttrs = map[string]string{objectiveValuesSystemAttrKey: string(encoded)}
+			if len(objective.GetConstraints()) != 0 {
+				violation := getConstraintViolation(objective, kt)
+				systemAttrs[constraintViolationSystemAttrKey] = strconv.FormatFloat(violation, 'g', -1, 64)
+			}
 		}
 
 		assignments := kt.GetSpec().GetParameterAssignments().GetAssignments()
@@ -267,7 +372,7 @@ func toGoptunaTrials(
 			Params:             externalParams,
 			Distributions:      searchSpace,
 			UserAttrs:          nil,
-			SystemAttrs:        nil,
+			SystemAttrs:        systemAttrs,
 		}
 		gtrials[kt.GetName()] = gt
 	}
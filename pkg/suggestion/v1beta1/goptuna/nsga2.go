@@ -0,0 +1,280 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/c-bata/goptuna"
+
+	"github.com/kubeflow/katib/pkg/util/v1beta1/pareto"
+)
+
+// objectiveValuesSystemAttrKey is the system attribute of Goptuna trial which contains
+// JSON encoded values of all objectives. Values of the maximized objectives are negated.
+const objectiveValuesSystemAttrKey = "katib:objective_values"
+
+// defaultNSGA2PopulationSize is the default number of the completed trials
+// which are collected before the sampler starts to generate the child parameters.
+const defaultNSGA2PopulationSize = 10
+
+// constraintViolationSystemAttrKey is the system attribute of Goptuna trial which contains
+// the total amount of the constraint violations. It is zero if the trial is feasible.
+const constraintViolationSystemAttrKey = "katib:constraint_violation"
+
+var _ goptuna.RelativeSampler = &nsga2Sampler{}
+
+// nsga2Sampler is the multi-objective sampler based on NSGA-II.
+// Since Katib requests suggestions trial by trial, the sampler works in the steady-state manner.
+// The population is selected from all completed trials by the non-dominated sorting and
+// the crowding distance, and the child parameters are generated from two parents which are chosen
+// by the binary tournament. Parameters are not sampled by the sampler until the number of
+// completed trials reaches the population size, and the independent sampler is used instead.
+// If the objective has constraints, the feasible trials dominate the infeasible trials and
+// the infeasible trials are compared by the amount of the constraint violations.
+type nsga2Sampler struct {
+	mu             sync.Mutex
+	rng            *rand.Rand
+	populationSize int
+	crossoverProb  float64
+	swappingProb   float64
+	// mutationProb is 1 / (number of parameters) if it is nil.
+	mutationProb *float64
+}
+
+type nsga2SamplerOption func(*nsga2Sampler)
+
+func nsga2SamplerOptionSeed(seed int64) nsga2SamplerOption {
+	return func(s *nsga2Sampler) {
+		s.rng = rand.New(rand.NewSource(seed))
+	}
+}
+
+func nsga2SamplerOptionPopulationSize(n int) nsga2SamplerOption {
+	return func(s *nsga2Sampler) {
+		s.populationSize = n
+	}
+}
+
+func nsga2SamplerOptionCrossoverProb(prob float64) nsga2SamplerOption {
+	return func(s *nsga2Sampler) {
+		s.crossoverProb = prob
+	}
+}
+
+func nsga2SamplerOptionSwappingProb(prob float64) nsga2SamplerOption {
+	return func(s *nsga2Sampler) {
+		s.swappingProb = prob
+	}
+}
+
+func nsga2SamplerOptionMutationProb(prob float64) nsga2SamplerOption {
+	return func(s *nsga2Sampler) {
+		s.mutationProb = &prob
+	}
+}
+
+func newNSGA2Sampler(opts ...nsga2SamplerOption) *nsga2Sampler {
+	s := &nsga2Sampler{
+		rng:            rand.New(rand.NewSource(time.Now().UnixNano())),
+		populationSize: defaultNSGA2PopulationSize,
+		crossoverProb:  0.9,
+		swappingProb:   0.5,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+type nsga2Individual struct {
+	params    map[string]float64
+	values    []float64
+	violation float64
+	rank      int
+	distance  float64
+}
+
+// SampleRelative samples the child parameters from the current population.
+// Parameters which are not contained in the returned map are sampled by the independent sampler.
+func (s *nsga2Sampler) SampleRelative(study *goptuna.Study, trial goptuna.FrozenTrial, searchSpace map[string]interface{}) (map[string]float64, error) {
+	trials, err := study.GetTrials()
+	if err != nil {
+		return nil, err
+	}
+
+	individuals := make([]*nsga2Individual, 0, len(trials))
+	for _, t := range trials {
+		if t.State != goptuna.TrialStateComplete || !containsAllParams(t.InternalParams, searchSpace) {
+			continue
+		}
+		var values []float64
+		if err := json.Unmarshal([]byte(t.SystemAttrs[objectiveValuesSystemAttrKey]), &values); err != nil {
+			continue
+		}
+		var violation float64
+		if v, ok := t.SystemAttrs[constraintViolationSystemAttrKey]; ok {
+			if violation, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+		individuals = append(individuals, &nsga2Individual{params: t.InternalParams, values: values, violation: violation})
+	}
+	if len(individuals) < s.populationSize {
+		return nil, nil
+	}
+	population := selectPopulation(individuals, s.populationSize)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parent1 := s.tournament(population)
+	parent2 := s.tournament(population)
+	isCrossover := s.rng.Float64() < s.crossoverProb
+	mutationProb := 1.0 / float64(len(searchSpace))
+	if s.mutationProb != nil {
+		mutationProb = *s.mutationProb
+	}
+
+	// Iterate parameters in the sorted order to make the sampling reproducible with the seed.
+	names := make([]string, 0, len(searchSpace))
+	for name := range searchSpace {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	params := make(map[string]float64, len(names))
+	for _, name := range names {
+		value := parent1.params[name]
+		if isCrossover && s.rng.Float64() < s.swappingProb {
+			value = parent2.params[name]
+		}
+		// Mutated parameters are sampled by the independent sampler.
+		if s.rng.Float64() < mutationProb {
+			continue
+		}
+		params[name] = value
+	}
+	return params, nil
+}
+
+// tournament returns the better individual of two randomly chosen individuals.
+func (s *nsga2Sampler) tournament(population []*nsga2Individual) *nsga2Individual {
+	a := population[s.rng.Intn(len(population))]
+	b := population[s.rng.Intn(len(population))]
+	if isBetterIndividual(b, a) {
+		return b
+	}
+	return a
+}
+
+// selectPopulation returns the best populationSize individuals by the non-dominated rank
+// and the crowding distance.
+// The individuals are sorted in the copy, so the order of the given slice is kept.
+func selectPopulation(individuals []*nsga2Individual, populationSize int) []*nsga2Individual {
+	sorted := make([]*nsga2Individual, len(individuals))
+	copy(sorted, individuals)
+	for _, front := range nonDominatedSort(sorted) {
+		setCrowdingDistance(front)
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return isBetterIndividual(sorted[i], sorted[j])
+	})
+	return sorted[:populationSize]
+}
+
+func isBetterIndividual(a, b *nsga2Individual) bool {
+	if a.rank != b.rank {
+		return a.rank < b.rank
+	}
+	return a.distance > b.distance
+}
+
+// nonDominatedSort splits individuals into the fronts and sets the rank of each individual.
+func nonDominatedSort(individuals []*nsga2Individual) [][]*nsga2Individual {
+	var fronts [][]*nsga2Individual
+	remaining := individuals
+	for rank := 0; len(remaining) > 0; rank++ {
+		var front, dominated []*nsga2Individual
+		for i, a := range remaining {
+			isDominated := false
+			for j, b := range remaining {
+				if i != j && constrainedDominates(b, a) {
+					isDominated = true
+					break
+				}
+			}
+			if isDominated {
+				dominated = append(dominated, a)
+			} else {
+				a.rank = rank
+				front = append(front, a)
+			}
+		}
+		fronts = append(fronts, front)
+		remaining = dominated
+	}
+	return fronts
+}
+
+// setCrowdingDistance sets the crowding distance of individuals in the same front.
+// Individuals at the boundaries of the front have the infinite distance.
+func setCrowdingDistance(front []*nsga2Individual) {
+	for _, individual := range front {
+		individual.distance = 0
+	}
+	if len(front) == 0 {
+		return
+	}
+	for objective := range front[0].values {
+		sort.SliceStable(front, func(i, j int) bool {
+			return front[i].values[objective] < front[j].values[objective]
+		})
+		front[0].distance = math.Inf(1)
+		front[len(front)-1].distance = math.Inf(1)
+		width := front[len(front)-1].values[objective] - front[0].values[objective]
+		if width == 0 {
+			continue
+		}
+		for i := 1; i < len(front)-1; i++ {
+			front[i].distance += (front[i+1].values[objective] - front[i-1].values[objective]) / width
+		}
+	}
+}
+
+// constrainedDominates checks if the individual a dominates b with respect to the constraints.
+func constrainedDominates(a, b *nsga2Individual) bool {
+	if a.violation == 0 && b.violation == 0 {
+		return pareto.Dominates(a.values, b.values)
+	}
+	return a.violation < b.violation
+}
+
+func containsAllParams(params map[string]float64, searchSpace map[string]interface{}) bool {
+	for name := range searchSpace {
+		if _, ok := params[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
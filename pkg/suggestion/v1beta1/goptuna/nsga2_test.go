@@ -0,0 +1,197 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/c-bata/goptuna"
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_nonDominatedSort(t *testing.T) {
+	individuals := []*nsga2Individual{
+		{values: []float64{1, 4}},
+		{values: []float64{2, 2}},
+		{values: []float64{3, 3}},
+		{values: []float64{4, 1}},
+		{values: []float64{4, 4}},
+	}
+	fronts := nonDominatedSort(individuals)
+
+	var gotRanks []int
+	for _, individual := range individuals {
+		gotRanks = append(gotRanks, individual.rank)
+	}
+	if diff := cmp.Diff([]int{0, 0, 1, 0, 2}, gotRanks); len(diff) != 0 {
+		t.Errorf("Unexpected ranks from nonDominatedSort (-want,+got):\n%s", diff)
+	}
+	if len(fronts) != 3 {
+		t.Errorf("nonDominatedSort should return 3 fronts, but got %d", len(fronts))
+	}
+
+	setCrowdingDistance(fronts[0])
+	for _, individual := range fronts[0] {
+		wantDistance := math.Inf(1)
+		if individual.values[0] == 2 {
+			// (4 - 1) / 3 + (4 - 1) / 3
+			wantDistance = 2
+		}
+		if individual.distance != wantDistance {
+			t.Errorf("Unexpected crowding distance for %v: want %v, got %v", individual.values, wantDistance, individual.distance)
+		}
+	}
+}
+
+func Test_selectPopulation(t *testing.T) {
+	individuals := []*nsga2Individual{
+		{values: []float64{4, 4}},
+		{values: []float64{3, 3}},
+		{values: []float64{1, 4}},
+		{values: []float64{2, 2}},
+		{values: []float64{4, 1}},
+	}
+	original := make([]*nsga2Individual, len(individuals))
+	copy(original, individuals)
+
+	population := selectPopulation(individuals, 3)
+
+	var gotValues [][]float64
+	for _, individual := range population {
+		gotValues = append(gotValues, individual.values)
+	}
+	// The crowding distance of the front is infinite for the boundaries, so the middle individual is the last one.
+	if diff := cmp.Diff([][]float64{{1, 4}, {4, 1}, {2, 2}}, gotValues); len(diff) != 0 {
+		t.Errorf("Unexpected population from selectPopulation (-want,+got):\n%s", diff)
+	}
+	for i := range individuals {
+		if individuals[i] != original[i] {
+			t.Errorf("selectPopulation should not reorder the given individuals, but got %v at %d", individuals[i].values, i)
+		}
+	}
+}
+
+func Test_constrainedDominates(t *testing.T) {
+	cases := map[string]struct {
+		a, b *nsga2Individual
+		want bool
+	}{
+		"Both individuals are feasible": {
+			a:    &nsga2Individual{values: []float64{1, 1}},
+			b:    &nsga2Individual{values: []float64{2, 2}},
+			want: true,
+		},
+		"Feasible individual dominates infeasible individual": {
+			a:    &nsga2Individual{values: []float64{2, 2}},
+			b:    &nsga2Individual{values: []float64{1, 1}, violation: 0.5},
+			want: true,
+		},
+		"Infeasible individual does not dominate feasible individual": {
+			a:    &nsga2Individual{values: []float64{1, 1}, violation: 0.5},
+			b:    &nsga2Individual{values: []float64{2, 2}},
+			want: false,
+		},
+		"Infeasible individual with less violation dominates": {
+			a:    &nsga2Individual{values: []float64{2, 2}, violation: 0.1},
+			b:    &nsga2Individual{values: []float64{1, 1}, violation: 0.5},
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := constrainedDominates(tc.a, tc.b); got != tc.want {
+				t.Errorf("constrainedDominates() should return %v, but got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func Test_nsga2Sampler_SampleRelative(t *testing.T) {
+	searchSpace := map[string]interface{}{
+		"x": goptuna.UniformDistribution{Low: 0, High: 10},
+		"y": goptuna.UniformDistribution{Low: 0, High: 10},
+	}
+	newStudy := func(completedTrials int) *goptuna.Study {
+		study, err := goptuna.CreateStudy(defaultStudyName,
+			goptuna.StudyOptionDefineSearchSpace(searchSpace), goptuna.StudyOptionLogger(nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < completedTrials; i++ {
+			trialID, err := study.Storage.CreateNewTrial(study.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for name, distribution := range searchSpace {
+				if err = study.Storage.SetTrialParam(trialID, name, float64(i), distribution); err != nil {
+					t.Fatal(err)
+				}
+			}
+			values, _ := json.Marshal([]float64{float64(i), float64(-i)})
+			if err = study.Storage.SetTrialSystemAttr(trialID, objectiveValuesSystemAttrKey, string(values)); err != nil {
+				t.Fatal(err)
+			}
+			if err = study.Storage.SetTrialState(trialID, goptuna.TrialStateComplete); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return study
+	}
+
+	cases := map[string]struct {
+		completedTrials int
+		wantSampled     bool
+	}{
+		"Number of completed trials is less than population size": {
+			completedTrials: 3,
+		},
+		"Number of completed trials reaches population size": {
+			completedTrials: 4,
+			wantSampled:     true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			sampler := newNSGA2Sampler(
+				nsga2SamplerOptionSeed(1),
+				nsga2SamplerOptionPopulationSize(4),
+				nsga2SamplerOptionMutationProb(0),
+			)
+			params, err := sampler.SampleRelative(newStudy(tc.completedTrials), goptuna.FrozenTrial{}, searchSpace)
+			if err != nil {
+				t.Fatalf("Unexpected error from SampleRelative: %v", err)
+			}
+			if !tc.wantSampled {
+				if params != nil {
+					t.Errorf("SampleRelative should not sample parameters, but got %v", params)
+				}
+				return
+			}
+			if len(params) != len(searchSpace) {
+				t.Fatalf("SampleRelative should sample %d parameters, but got %v", len(searchSpace), params)
+			}
+			// All completed trials are Pareto optimal, so the child parameters are inherited from them.
+			for name, value := range params {
+				if value != math.Trunc(value) || value < 0 || value > 3 {
+					t.Errorf("Unexpected parameter %s: %v", name, value)
+				}
+			}
+		})
+	}
+}
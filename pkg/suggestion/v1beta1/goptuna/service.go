@@ -32,6 +32,7 @@ const (
 	AlgorithmTPE    = "tpe"
 	AlgorithmRandom = "random"
 	AlgorithmSobol  = "sobol"
+	AlgorithmNSGA2  = "nsga2"
 
 	defaultStudyName = "Katib"
 )
@@ -60,8 +61,8 @@ func (s *SuggestionService) GetSuggestions(
 		return nil, status.Errorf(codes.Internal, "Failed to create goptuna study and search space: %s", err.Error())
 	}
 
-	objectMetricName := req.GetExperiment().GetSpec().GetObjective().GetObjectiveMetricName()
-	trials, err := toGoptunaTrials(req.GetTrials(), objectMetricName, s.study, s.searchSpace)
+	objective := req.GetExperiment().GetSpec().GetObjective()
+	trials, err := toGoptunaTrials(req.GetTrials(), objective, s.study, s.searchSpace)
 	if err != nil {
 		klog.Errorf("Failed to convert to Goptuna trials: %s", err)
 		return nil, status.Error(codes.Internal, err.Error())
@@ -136,6 +137,12 @@ func (s *SuggestionService) syncTrials(ktrials map[string]goptuna.FrozenTrial) (
 			if err != nil {
 				return err
 			}
+			for key, value := range ktrial.SystemAttrs {
+				err = s.study.Storage.SetTrialSystemAttr(gtrialID, key, value)
+				if err != nil {
+					return err
+				}
+			}
 		}
 
 		err = s.study.Storage.SetTrialState(gtrialID, ktrial.State)
@@ -179,7 +186,8 @@ func (s *SuggestionService) ValidateAlgorithmSettings(
 	}
 
 	algorithmName := req.GetExperiment().GetSpec().GetAlgorithm().GetAlgorithmName()
-	if algorithmName != AlgorithmRandom && algorithmName != AlgorithmCMAES && algorithmName != AlgorithmTPE && algorithmName != AlgorithmSobol {
+	if algorithmName != AlgorithmRandom && algorithmName != AlgorithmCMAES && algorithmName != AlgorithmTPE &&
+		algorithmName != AlgorithmSobol && algorithmName != AlgorithmNSGA2 {
 		return nil, status.Error(codes.InvalidArgument, "unsupported algorithm")
 	}
 
@@ -207,6 +215,15 @@ func (s *SuggestionService) ValidateAlgorithmSettings(
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to create goptuna study and search space: %s", err.Error())
 	}
+
+	// NSGA-II sampler generates the child parameters once the population of completed Trials is collected,
+	// so the population must be smaller than the max Trial count.
+	if maxTrialCount := int(req.GetExperiment().GetSpec().GetMaxTrialCount()); algorithmName == AlgorithmNSGA2 && maxTrialCount > 0 {
+		_, relativeSampler, _ := toGoptunaSampler(req.GetExperiment().GetSpec().GetAlgorithm())
+		if populationSize := relativeSampler.(*nsga2Sampler).populationSize; populationSize >= maxTrialCount {
+			return nil, status.Errorf(codes.InvalidArgument, "population_size: %d must be less than max trial count: %d", populationSize, maxTrialCount)
+		}
+	}
 	return &api_v1_beta1.ValidateAlgorithmSettingsReply{}, nil
 }
 
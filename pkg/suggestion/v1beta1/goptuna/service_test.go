@@ -152,6 +152,42 @@ func TestSuggestionService_GetSuggestions(t *testing.T) {
 				CurrentRequestNumber: 2,
 			},
 		},
+		{
+			name: "NSGA-II request",
+			req: &api_v1_beta1.GetSuggestionsRequest{
+				Experiment: &api_v1_beta1.Experiment{
+					Name: "test",
+					Spec: &api_v1_beta1.ExperimentSpec{
+						Algorithm: &api_v1_beta1.AlgorithmSpec{
+							AlgorithmName: "nsga2",
+							AlgorithmSettings: []*api_v1_beta1.AlgorithmSetting{
+								{
+									Name:  "random_state",
+									Value: "10",
+								},
+								{
+									Name:  "population_size",
+									Value: "4",
+								},
+							},
+						},
+						Objective: &api_v1_beta1.ObjectiveSpec{
+							Type:                  api_v1_beta1.ObjectiveType_MINIMIZE,
+							ObjectiveMetricName:   "metric-1",
+							AdditionalMetricNames: []string{"metric-2"},
+							AdditionalObjectives: []*api_v1_beta1.AdditionalObjective{
+								{
+									ObjectiveMetricName: "metric-2",
+									Type:                api_v1_beta1.ObjectiveType_MAXIMIZE,
+								},
+							},
+						},
+						ParameterSpecs: parameterSpecs,
+					},
+				},
+				CurrentRequestNumber: 2,
+			},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			s := &suggestion_goptuna_v1beta1.SuggestionService{}
@@ -185,3 +221,73 @@ func TestSuggestionService_GetSuggestions(t *testing.T) {
 		})
 	}
 }
+
+func TestSuggestionService_ValidateAlgorithmSettings(t *testing.T) {
+	ctx := context.TODO()
+	parameterSpecs := &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+		Parameters: []*api_v1_beta1.ParameterSpec{
+			{
+				Name:          "param-1",
+				ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+				FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+					Max: "1",
+					Min: "0",
+				},
+			},
+		},
+	}
+	newRequest := func(maxTrialCount int32, settings ...*api_v1_beta1.AlgorithmSetting) *api_v1_beta1.ValidateAlgorithmSettingsRequest {
+		return &api_v1_beta1.ValidateAlgorithmSettingsRequest{
+			Experiment: &api_v1_beta1.Experiment{
+				Name: "test",
+				Spec: &api_v1_beta1.ExperimentSpec{
+					Algorithm: &api_v1_beta1.AlgorithmSpec{
+						AlgorithmName:     "nsga2",
+						AlgorithmSettings: settings,
+					},
+					Objective: &api_v1_beta1.ObjectiveSpec{
+						Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+						ObjectiveMetricName: "metric-1",
+					},
+					ParameterSpecs: parameterSpecs,
+					MaxTrialCount:  maxTrialCount,
+				},
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name         string
+		req          *api_v1_beta1.ValidateAlgorithmSettingsRequest
+		expectedCode codes.Code
+	}{
+		{
+			name:         "NSGA-II population size is less than max trial count",
+			req:          newRequest(12, &api_v1_beta1.AlgorithmSetting{Name: "population_size", Value: "6"}),
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "NSGA-II population size is not less than max trial count",
+			req:          newRequest(6, &api_v1_beta1.AlgorithmSetting{Name: "population_size", Value: "6"}),
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "NSGA-II default population size is not less than max trial count",
+			req:          newRequest(5),
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "NSGA-II without max trial count",
+			req:          newRequest(0),
+			expectedCode: codes.OK,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &suggestion_goptuna_v1beta1.SuggestionService{}
+			_, err := s.ValidateAlgorithmSettings(ctx, tt.req)
+			if c := status.Code(err); tt.expectedCode != c {
+				t.Errorf("ValidateAlgorithmSettings() should return = %v, but got %v", tt.expectedCode, c)
+			}
+		})
+	}
+}
@@ -28,7 +28,6 @@ import (
 	"path/filepath"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	experimentv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
@@ -36,6 +35,7 @@ import (
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	api_pb_v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	consts "github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 	"github.com/kubeflow/katib/pkg/util/v1beta1/katibclient"
 	corev1 "k8s.io/api/core/v1"
 
@@ -88,7 +88,12 @@ func (k *KatibUIHandler) ServeIndex(buildDir string, baseHref string) func(w htt
 }
 
 func (k *KatibUIHandler) connectManager() (*grpc.ClientConn, api_pb_v1beta1.DBManagerClient) {
-	conn, err := grpc.Dial(k.dbManagerAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	credsOpt, err := grpctls.DialOption(grpctls.ServerName)
+	if err != nil {
+		log.Printf("Failed to set up GRPC credentials: %v", err)
+		return nil, nil
+	}
+	conn, err := grpc.Dial(k.dbManagerAddr, credsOpt)
 	if err != nil {
 		log.Printf("Dial to GRPC failed: %v", err)
 		return nil, nil
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"log"
 	"net/http"
 	"strconv"
@@ -119,6 +120,20 @@ func (k *KatibUIHandler) FetchHPJobInfo(w http.ResponseWriter, r *http.Request)
 		resultText += ",KFP Run"
 	}
 
+	completedTrialNames := []string{}
+	for _, t := range trialList.Items {
+		if t.IsSucceeded() || t.IsEarlyStopped() {
+			completedTrialNames = append(completedTrialNames, t.Name)
+		}
+	}
+	metricNames := append([]string{experiment.Spec.Objective.ObjectiveMetricName}, experiment.Spec.Objective.AdditionalMetricNames...)
+	trialLogs, err := getTrialsObservationLogs(c, namespace, completedTrialNames, metricNames)
+	if err != nil {
+		log.Printf("GetObservationLog from HP job failed: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	foundPipelineUID := false
 	for _, t := range trialList.Items {
 		runUid, ok := t.GetAnnotations()[kfpRunIDAnnotation]
@@ -139,21 +154,7 @@ func (k *KatibUIHandler) FetchHPJobInfo(w http.ResponseWriter, r *http.Request)
 		trialResText := make([]string, len(metricsList)+len(paramList))
 
 		if t.IsSucceeded() || t.IsEarlyStopped() {
-			obsLogResp, err := c.GetObservationLog(
-				context.Background(),
-				&api_pb_v1beta1.GetObservationLogRequest{
-					TrialName: t.Name,
-					StartTime: "",
-					EndTime:   "",
-				},
-			)
-			if err != nil {
-				log.Printf("GetObservationLog from HP job failed: %v", err)
-				http.Error(w, err.Error(), http.StatusInternalServerError)
-				return
-			}
-
-			for _, m := range obsLogResp.ObservationLog.MetricLogs {
+			for _, m := range trialLogs[t.Name] {
 				if trialResText[metricsList[m.Metric.Name]] == "" {
 					trialResText[metricsList[m.Metric.Name]] = m.Metric.Value
 				} else {
@@ -239,16 +240,20 @@ func (k *KatibUIHandler) FetchHPJobTrialInfo(w http.ResponseWriter, r *http.Requ
 	// resultArray - array of arrays, where [i][0] - metricName, [i][1] - metricTime, [i][2] - metricValue
 	var resultArray [][]string
 	resultArray = append(resultArray, strings.Split("metricName,time,value", ","))
-	obsLogResp, err := c.GetObservationLog(
+	// Logs are streamed page by page, so huge logs don't have to fit into a single reply.
+	// Only logs of the rank 0 worker are shown for the distributed training.
+	obsLogStream, err := c.StreamObservationLog(
 		context.Background(),
 		&api_pb_v1beta1.GetObservationLogRequest{
-			TrialName: trialName,
-			StartTime: "",
-			EndTime:   "",
+			TrialName:   trialName,
+			Namespace:   namespace,
+			StartTime:   "",
+			EndTime:     "",
+			Rank0Worker: true,
 		},
 	)
 	if err != nil {
-		log.Printf("GetObservationLog failed: %v", err)
+		log.Printf("StreamObservationLog failed: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -256,48 +261,59 @@ func (k *KatibUIHandler) FetchHPJobTrialInfo(w http.ResponseWriter, r *http.Requ
 	// prevMetricTimeValue is the dict, where key = metric name,
 	// value = array, where [0] - Last metric time, [1] - Best metric value for this time
 	prevMetricTimeValue := make(map[string][]string)
-	for _, m := range obsLogResp.ObservationLog.MetricLogs {
-		parsedCurrentTime, _ := time.Parse(time.RFC3339Nano, m.TimeStamp)
-		formatCurrentTime := parsedCurrentTime.Format("2006-01-02T15:04:05")
-		if _, found := prevMetricTimeValue[m.Metric.Name]; !found {
-			prevMetricTimeValue[m.Metric.Name] = []string{"", ""}
-
+	for {
+		obsLogResp, err := obsLogStream.Recv()
+		if err == io.EOF {
+			break
 		}
-
-		newMetricValue, err := strconv.ParseFloat(m.Metric.Value, 64)
 		if err != nil {
-			log.Printf("ParseFloat for new metric value: %v failed: %v", m.Metric.Value, err)
+			log.Printf("StreamObservationLog failed: %v", err)
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
+		for _, m := range obsLogResp.ObservationLog.MetricLogs {
+			parsedCurrentTime, _ := time.Parse(time.RFC3339Nano, m.TimeStamp)
+			formatCurrentTime := parsedCurrentTime.Format("2006-01-02T15:04:05")
+			if _, found := prevMetricTimeValue[m.Metric.Name]; !found {
+				prevMetricTimeValue[m.Metric.Name] = []string{"", ""}
+
+			}
 
-		var prevMetricValue float64
-		if prevMetricTimeValue[m.Metric.Name][1] != "" {
-			prevMetricValue, err = strconv.ParseFloat(prevMetricTimeValue[m.Metric.Name][1], 64)
+			newMetricValue, err := strconv.ParseFloat(m.Metric.Value, 64)
 			if err != nil {
-				log.Printf("ParseFloat for prev metric value: %v failed: %v", prevMetricTimeValue[m.Metric.Name][1], err)
+				log.Printf("ParseFloat for new metric value: %v failed: %v", m.Metric.Value, err)
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 				return
 			}
-		}
 
-		if formatCurrentTime == prevMetricTimeValue[m.Metric.Name][0] &&
-			((objectiveType == commonv1beta1.ObjectiveTypeMinimize &&
-				newMetricValue < prevMetricValue) ||
-				(objectiveType == commonv1beta1.ObjectiveTypeMaximize &&
-					newMetricValue > prevMetricValue)) {
-
-			prevMetricTimeValue[m.Metric.Name][1] = m.Metric.Value
-			for i := len(resultArray) - 1; i >= 0; i-- {
-				if resultArray[i][0] == m.Metric.Name {
-					resultArray[i][2] = m.Metric.Value
-					break
+			var prevMetricValue float64
+			if prevMetricTimeValue[m.Metric.Name][1] != "" {
+				prevMetricValue, err = strconv.ParseFloat(prevMetricTimeValue[m.Metric.Name][1], 64)
+				if err != nil {
+					log.Printf("ParseFloat for prev metric value: %v failed: %v", prevMetricTimeValue[m.Metric.Name][1], err)
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+			}
+
+			if formatCurrentTime == prevMetricTimeValue[m.Metric.Name][0] &&
+				((objectiveType == commonv1beta1.ObjectiveTypeMinimize &&
+					newMetricValue < prevMetricValue) ||
+					(objectiveType == commonv1beta1.ObjectiveTypeMaximize &&
+						newMetricValue > prevMetricValue)) {
+
+				prevMetricTimeValue[m.Metric.Name][1] = m.Metric.Value
+				for i := len(resultArray) - 1; i >= 0; i-- {
+					if resultArray[i][0] == m.Metric.Name {
+						resultArray[i][2] = m.Metric.Value
+						break
+					}
 				}
+			} else if formatCurrentTime != prevMetricTimeValue[m.Metric.Name][0] {
+				resultArray = append(resultArray, []string{m.Metric.Name, formatCurrentTime, m.Metric.Value})
+				prevMetricTimeValue[m.Metric.Name][0] = formatCurrentTime
+				prevMetricTimeValue[m.Metric.Name][1] = m.Metric.Value
 			}
-		} else if formatCurrentTime != prevMetricTimeValue[m.Metric.Name][0] {
-			resultArray = append(resultArray, []string{m.Metric.Name, formatCurrentTime, m.Metric.Value})
-			prevMetricTimeValue[m.Metric.Name][0] = formatCurrentTime
-			prevMetricTimeValue[m.Metric.Name][1] = m.Metric.Value
 		}
 	}
 
@@ -17,14 +17,12 @@ limitations under the License.
 package v1beta1
 
 import (
-	"context"
 	"encoding/json"
 	"log"
 	"net/http"
 	"strconv"
 
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
-	api_pb_v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 )
 
 func (k *KatibUIHandler) FetchNASJobInfo(w http.ResponseWriter, r *http.Request) {
@@ -48,30 +46,28 @@ func (k *KatibUIHandler) FetchNASJobInfo(w http.ResponseWriter, r *http.Request)
 	}
 	log.Printf("Got Trial List")
 
-	for i, t := range trials.Items {
-		succeeded := false
+	succeededTrials := map[string]bool{}
+	succeededTrialNames := []string{}
+	for _, t := range trials.Items {
 		for _, condition := range t.Status.Conditions {
-			if condition.Type == trialsv1beta1.TrialSucceeded {
-				succeeded = true
+			if condition.Type == trialsv1beta1.TrialSucceeded && !succeededTrials[t.Name] {
+				succeededTrials[t.Name] = true
+				succeededTrialNames = append(succeededTrialNames, t.Name)
 			}
 		}
-		if succeeded {
-			obsLogResp, err := c.GetObservationLog(
-				context.Background(),
-				&api_pb_v1beta1.GetObservationLogRequest{
-					TrialName: t.Name,
-					StartTime: "",
-					EndTime:   "",
-				},
-			)
-			if err != nil {
-				log.Printf("GetObservationLog from NAS job failed: %v", err)
-				http.Error(w, err.Error(), http.StatusInternalServerError)
-				return
-			}
+	}
+	trialLogs, err := getTrialsObservationLogs(c, namespace, succeededTrialNames, nil)
+	if err != nil {
+		log.Printf("GetObservationLog from NAS job failed: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	for i, t := range trials.Items {
+		if succeededTrials[t.Name] {
 			metricsName := make([]string, 0)
 			metricsValue := make([]string, 0)
-			for _, m := range obsLogResp.ObservationLog.MetricLogs {
+			for _, m := range trialLogs[t.Name] {
 				metricsName = append(metricsName, m.Metric.Name)
 				metricsValue = append(metricsValue, m.Metric.Value)
 
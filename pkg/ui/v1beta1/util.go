@@ -17,7 +17,9 @@ limitations under the License.
 package v1beta1
 
 import (
+	"context"
 	"encoding/json"
+	"io"
 	"log"
 	"net/http"
 	"sort"
@@ -28,6 +30,7 @@ import (
 
 	gographviz "github.com/awalterschulze/gographviz"
 	trialv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	api_pb_v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -336,3 +339,37 @@ func generateNNImage(architecture string, decoder string) string {
 	s := graph.String()
 	return s
 }
+
+// getTrialsObservationLogs returns the logs of the given Trials grouped by Trial name.
+// The logs of all Trials are streamed in a single call, so huge logs don't have to fit into a single reply.
+// Only logs of the rank 0 worker are returned for the distributed training.
+func getTrialsObservationLogs(c api_pb_v1beta1.DBManagerClient, namespace string, trialNames []string, metricNames []string) (map[string][]*api_pb_v1beta1.MetricLog, error) {
+	trialLogs := map[string][]*api_pb_v1beta1.MetricLog{}
+	if len(trialNames) == 0 {
+		return trialLogs, nil
+	}
+	obsLogStream, err := c.StreamObservationLog(
+		context.Background(),
+		&api_pb_v1beta1.GetObservationLogRequest{
+			Namespace:   namespace,
+			TrialNames:  trialNames,
+			MetricNames: metricNames,
+			Rank0Worker: true,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		obsLogResp, err := obsLogStream.Recv()
+		if err == io.EOF {
+			return trialLogs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range obsLogResp.ObservationLog.MetricLogs {
+			trialLogs[m.TrialName] = append(trialLogs[m.TrialName], m)
+		}
+	}
+}
@@ -0,0 +1,363 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package grpctls configures mutual TLS between the Katib gRPC services and their clients.
+//
+// The Katib control plane (controller, DB manager and UI) shares one certificate which is issued
+// for ServerName. The Katib controller issues a certificate for every namespace with Suggestions,
+// which is used by the Suggestion and Early Stopping services and by the metrics collectors of the
+// namespace. The namespace certificate is issued for NamespaceServerName and it only allows access
+// to the DB manager data of the same namespace, so the control plane key is never mounted into the
+// user Pods. The directory with the certificate is set by SetCertDir or by the KATIB_GRPC_TLS_CERT_DIR
+// env variable. If none of them is set, the services and the clients use plaintext connections.
+package grpctls
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// CertDirEnvName is the env variable with the directory of the gRPC certs.
+	CertDirEnvName = "KATIB_GRPC_TLS_CERT_DIR"
+	// ServerName is the DNS name in the certificate of the Katib control plane.
+	// Clients verify the server certificate against this name instead of the dialed address,
+	// since the same certificate is used by services with different addresses.
+	ServerName = "katib-grpc"
+
+	// CACertFile is the file name of the CA certificate.
+	CACertFile = "ca.crt"
+	// CertFile is the file name of the certificate.
+	CertFile = "tls.crt"
+	// KeyFile is the file name of the private key.
+	KeyFile = "tls.key"
+	// CAKeyFile is the file name of the CA private key. It is required only by the Katib controller
+	// to issue the namespace certificates. If the file is missing, the CA certificate must be
+	// the certificate in CertFile, e.g. a self-signed certificate issued by cert-manager.
+	CAKeyFile = "ca.key"
+
+	// namespaceCertValidity is the max validity of the namespace certificates.
+	// The certificate is valid at most until the CA certificate expires.
+	namespaceCertValidity = 365 * 24 * time.Hour
+)
+
+var certDir string
+
+// SetCertDir sets the directory of the gRPC certs and enables mutual TLS.
+// It takes precedence over the KATIB_GRPC_TLS_CERT_DIR env variable and
+// must be called before any server or connection is created.
+func SetCertDir(dir string) {
+	certDir = dir
+}
+
+// CertDir returns the directory of the gRPC certs or an empty string if mutual TLS is disabled.
+func CertDir() string {
+	if certDir != "" {
+		return certDir
+	}
+	return os.Getenv(CertDirEnvName)
+}
+
+// Enabled returns true if mutual TLS is enabled.
+func Enabled() bool {
+	return CertDir() != ""
+}
+
+// ServerOptions returns the options which set up mutual TLS for a gRPC server.
+// It returns nil if mutual TLS is disabled.
+func ServerOptions() ([]grpc.ServerOption, error) {
+	if !Enabled() {
+		return nil, nil
+	}
+	tlsConfig, err := NewServerTLSConfig(CertDir())
+	if err != nil {
+		return nil, err
+	}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsConfig))}, nil
+}
+
+// NamespaceServerName returns the DNS name in the namespace certificate, which is used by
+// the Suggestion and Early Stopping services in the namespace.
+func NamespaceServerName(namespace string) string {
+	return namespace + "." + ServerName
+}
+
+// DialOption returns the transport credentials option for a gRPC client connection to the server
+// with the certificate for serverName, i.e. ServerName or NamespaceServerName.
+// It returns insecure credentials if mutual TLS is disabled.
+func DialOption(serverName string) (grpc.DialOption, error) {
+	if !Enabled() {
+		return grpc.WithTransportCredentials(insecure.NewCredentials()), nil
+	}
+	tlsConfig, err := NewClientTLSConfig(CertDir(), serverName)
+	if err != nil {
+		return nil, err
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), nil
+}
+
+// NewServerTLSConfig returns the TLS config for a gRPC server which requires client certificates
+// signed by the CA in dir. The certs are read again for every handshake, so rotated certs are
+// picked up without a restart.
+func NewServerTLSConfig(dir string) (*tls.Config, error) {
+	// Fail fast if the certs are missing or invalid.
+	if _, err := loadServerTLSConfig(dir); err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return loadServerTLSConfig(dir)
+		},
+	}, nil
+}
+
+// NewClientTLSConfig returns the TLS config for a gRPC client which presents the certificate in dir
+// and verifies the server certificate for serverName against the CA in dir.
+func NewClientTLSConfig(dir, serverName string) (*tls.Config, error) {
+	caPool, err := loadCAPool(dir)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = loadKeyPair(dir); err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+		RootCAs:    caPool,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return loadKeyPair(dir)
+		},
+	}, nil
+}
+
+func loadServerTLSConfig(dir string) (*tls.Config, error) {
+	caPool, err := loadCAPool(dir)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := loadKeyPair(dir)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{*cert},
+		ClientCAs:    caPool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}, nil
+}
+
+func loadCAPool(dir string) (*x509.CertPool, error) {
+	caFile := filepath.Join(dir, CACertFile)
+	caPEM, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read CA certificate: %w", err)
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("Failed to parse CA certificate %s", caFile)
+	}
+	return caPool, nil
+}
+
+func loadKeyPair(dir string) (*tls.Certificate, error) {
+	cert, err := tls.LoadX509KeyPair(filepath.Join(dir, CertFile), filepath.Join(dir, KeyFile))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to load certificate: %w", err)
+	}
+	return &cert, nil
+}
+
+// NewNamespaceCerts issues the certificate for the namespace signed by the CA in dir.
+// It returns the CA certificate, the certificate and the private key keyed by their file names.
+// The CA private key is never returned.
+func NewNamespaceCerts(dir, namespace string, now time.Time) (map[string][]byte, error) {
+	caPEM, ca, caKey, err := loadCA(dir)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to generate private key: %w", err)
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to generate serial number: %w", err)
+	}
+	notAfter := now.Add(namespaceCertValidity)
+	if ca.NotAfter.Before(notAfter) {
+		notAfter = ca.NotAfter
+	}
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: namespace},
+		DNSNames:     []string{NamespaceServerName(namespace)},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		// Services in the namespace are both gRPC servers and clients of the DB manager.
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create certificate: %w", err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to marshal private key: %w", err)
+	}
+	return map[string][]byte{
+		CACertFile: caPEM,
+		CertFile:   pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+		KeyFile:    pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}),
+	}, nil
+}
+
+// NamespaceCertsValid returns true if the certs are issued for the namespace by the CA in dir
+// and they don't have to be renewed yet. Certs are renewed after 2/3 of their validity.
+func NamespaceCertsValid(certs map[string][]byte, dir, namespace string, now time.Time) bool {
+	caPEM, err := os.ReadFile(filepath.Join(dir, CACertFile))
+	if err != nil || !bytes.Equal(caPEM, certs[CACertFile]) {
+		return false
+	}
+	keyPair, err := tls.X509KeyPair(certs[CertFile], certs[KeyFile])
+	if err != nil {
+		return false
+	}
+	cert, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil || !slices.Contains(cert.DNSNames, NamespaceServerName(namespace)) {
+		return false
+	}
+	renewTime := cert.NotBefore.Add(cert.NotAfter.Sub(cert.NotBefore) * 2 / 3)
+	return now.Before(renewTime)
+}
+
+// loadCA returns the CA certificate in PEM, the parsed CA certificate and the CA private key from dir.
+func loadCA(dir string) ([]byte, *x509.Certificate, crypto.Signer, error) {
+	caPEM, err := os.ReadFile(filepath.Join(dir, CACertFile))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("Failed to read CA certificate: %w", err)
+	}
+	caKeyPEM, err := os.ReadFile(filepath.Join(dir, CAKeyFile))
+	if errors.Is(err, os.ErrNotExist) {
+		caKeyPEM, err = os.ReadFile(filepath.Join(dir, KeyFile))
+	}
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("Failed to read CA private key: %w", err)
+	}
+	// It also checks that the private key matches the CA certificate.
+	keyPair, err := tls.X509KeyPair(caPEM, caKeyPEM)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("Failed to load CA: %w", err)
+	}
+	ca, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("Failed to parse CA certificate: %w", err)
+	}
+	if !ca.IsCA {
+		return nil, nil, nil, fmt.Errorf("Certificate %s is not a CA", filepath.Join(dir, CACertFile))
+	}
+	caKey, ok := keyPair.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("Unsupported CA private key type %T", keyPair.PrivateKey)
+	}
+	return caPEM, ca, caKey, nil
+}
+
+// UnaryServerInterceptor returns the interceptor which allows the clients with the namespace certificate
+// to access only the requests of the same namespace. Clients with the Katib control plane certificate can
+// access all namespaces. Requests without a namespace, e.g. health checks, are allowed for all clients.
+// The interceptor allows all requests if mutual TLS is disabled.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authorizeNamespace(ctx, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns the stream interceptor with the same checks as UnaryServerInterceptor.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &namespaceServerStream{ServerStream: ss})
+	}
+}
+
+// namespaceServerStream checks the namespace of every received request.
+type namespaceServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *namespaceServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return authorizeNamespace(s.Context(), m)
+}
+
+func authorizeNamespace(ctx context.Context, req interface{}) error {
+	if !Enabled() {
+		return nil
+	}
+	namespacedReq, ok := req.(interface{ GetNamespace() string })
+	if !ok {
+		return nil
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "Client certificate is required")
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return status.Error(codes.Unauthenticated, "Client certificate is required")
+	}
+	cert := tlsInfo.State.VerifiedChains[0][0]
+	if slices.Contains(cert.DNSNames, ServerName) {
+		return nil
+	}
+	namespace := namespacedReq.GetNamespace()
+	if namespace == "" || !slices.Contains(cert.DNSNames, NamespaceServerName(namespace)) {
+		return status.Errorf(codes.PermissionDenied, "Client %q is not allowed to access namespace %q",
+			strings.Join(cert.DNSNames, ","), namespace)
+	}
+	return nil
+}
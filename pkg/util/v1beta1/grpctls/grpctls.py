@@ -0,0 +1,65 @@
+
+# Mutual TLS between the Katib gRPC services and their clients.
+# It is enabled when the KATIB_GRPC_TLS_CERT_DIR env variable points to the directory
+# with the certs. See grpctls.go for the details.
+
+import os
+from typing import Optional
+
+import grpc
+
+CERT_DIR_ENV_NAME = "KATIB_GRPC_TLS_CERT_DIR"
+# DNS name in the certificate of the Katib control plane, e.g. DB manager.
+SERVER_NAME = "katib-grpc"
+
+CA_CERT_FILE = "ca.crt"
+CERT_FILE = "tls.crt"
+KEY_FILE = "tls.key"
+
+
+def cert_dir() -> Optional[str]:
+    return os.environ.get(CERT_DIR_ENV_NAME) or None
+
+
+def _read(directory: str, name: str) -> bytes:
+    with open(os.path.join(directory, name), "rb") as f:
+        return f.read()
+
+
+def _server_certificate_configuration(directory: str):
+    return grpc.ssl_server_certificate_configuration(
+        [(_read(directory, KEY_FILE), _read(directory, CERT_FILE))],
+        root_certificates=_read(directory, CA_CERT_FILE),
+    )
+
+
+def add_port(server: grpc.Server, address: str) -> int:
+    """Adds the port to the server. Client certificates are required if mutual TLS is enabled."""
+    directory = cert_dir()
+    if directory is None:
+        return server.add_insecure_port(address)
+
+    # The certs are read again for every new connection, so rotated certs are picked up.
+    credentials = grpc.dynamic_ssl_server_credentials(
+        _server_certificate_configuration(directory),
+        lambda: _server_certificate_configuration(directory),
+        require_client_authentication=True,
+    )
+    return server.add_secure_port(address, credentials)
+
+
+def channel(address: str) -> grpc.Channel:
+    """Returns the channel to the DB manager which uses mutual TLS if it is enabled."""
+    directory = cert_dir()
+    if directory is None:
+        return grpc.insecure_channel(address)
+
+    credentials = grpc.ssl_channel_credentials(
+        root_certificates=_read(directory, CA_CERT_FILE),
+        private_key=_read(directory, KEY_FILE),
+        certificate_chain=_read(directory, CERT_FILE),
+    )
+    # Python clients only dial the DB manager, which has the certificate issued for SERVER_NAME.
+    return grpc.secure_channel(
+        address, credentials, options=[("grpc.ssl_target_name_override", SERVER_NAME)]
+    )
@@ -0,0 +1,350 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpctls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestMutualTLS(t *testing.T) {
+	serverDir := t.TempDir()
+	ca, caKey := writeCerts(t, serverDir, nil, nil)
+	clientDir := t.TempDir()
+	writeCerts(t, clientDir, ca, caKey)
+	otherDir := t.TempDir()
+	writeCerts(t, otherDir, nil, nil)
+
+	addr := startServer(t, serverDir)
+
+	cases := map[string]struct {
+		dialDir string
+		wantErr bool
+	}{
+		"Client with certificate signed by the same CA": {
+			dialDir: clientDir,
+		},
+		"Client with certificate signed by another CA": {
+			dialDir: otherDir,
+			wantErr: true,
+		},
+		"Client without TLS": {
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := checkHealth(addr, tc.dialDir, ServerName)
+			if tc.wantErr && err == nil {
+				t.Error("Expected error, got nil")
+			} else if !tc.wantErr && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestCertDir(t *testing.T) {
+	t.Setenv(CertDirEnvName, "")
+	if Enabled() {
+		t.Error("Expected mutual TLS to be disabled")
+	}
+	dialOpt, err := DialOption(ServerName)
+	if err != nil || dialOpt == nil {
+		t.Errorf("Expected insecure dial option, got %v, %v", dialOpt, err)
+	}
+	serverOpts, err := ServerOptions()
+	if err != nil || serverOpts != nil {
+		t.Errorf("Expected no server options, got %v, %v", serverOpts, err)
+	}
+
+	t.Setenv(CertDirEnvName, "/env")
+	if got := CertDir(); got != "/env" {
+		t.Errorf("Expected cert dir from env, got %q", got)
+	}
+	SetCertDir("/flag")
+	defer SetCertDir("")
+	if got := CertDir(); got != "/flag" {
+		t.Errorf("Expected cert dir set by SetCertDir, got %q", got)
+	}
+	if _, err = ServerOptions(); err == nil {
+		t.Error("Expected error for missing certs, got nil")
+	}
+}
+
+func TestNamespaceCerts(t *testing.T) {
+	now := time.Now()
+	caDir := t.TempDir()
+	writeCerts(t, caDir, nil, nil)
+
+	certs, err := NewNamespaceCerts(caDir, "user-ns", now)
+	if err != nil {
+		t.Fatalf("Failed to issue namespace certs: %v", err)
+	}
+	if len(certs) != 3 || certs[CACertFile] == nil || certs[CertFile] == nil || certs[KeyFile] == nil {
+		t.Errorf("Unexpected certs: %v", certs)
+	}
+	if !NamespaceCertsValid(certs, caDir, "user-ns", now) {
+		t.Error("Expected valid certs for the namespace")
+	}
+	if NamespaceCertsValid(certs, caDir, "other-ns", now) {
+		t.Error("Expected invalid certs for another namespace")
+	}
+	// The test CA expires in an hour, so the certs are renewed after 40 minutes.
+	if NamespaceCertsValid(certs, caDir, "user-ns", now.Add(50*time.Minute)) {
+		t.Error("Expected certs to be renewed")
+	}
+	otherCADir := t.TempDir()
+	writeCerts(t, otherCADir, nil, nil)
+	if NamespaceCertsValid(certs, otherCADir, "user-ns", now) {
+		t.Error("Expected invalid certs after the CA is rotated")
+	}
+
+	// Namespace certs can't be used to issue the certs, since they are not a CA.
+	nsDir := t.TempDir()
+	for name, data := range certs {
+		if err = os.WriteFile(filepath.Join(nsDir, name), data, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err = NewNamespaceCerts(nsDir, "user-ns", now); err == nil {
+		t.Error("Expected error for the certs without the CA key, got nil")
+	}
+
+	// Services in the namespace are verified against the namespace server name.
+	addr := startServer(t, nsDir)
+	for serverName, wantErr := range map[string]bool{NamespaceServerName("user-ns"): false, ServerName: true} {
+		if err = checkHealth(addr, caDir, serverName); wantErr != (err != nil) {
+			t.Errorf("Unexpected error for server name %q: %v", serverName, err)
+		}
+	}
+	if _, err = NewNamespaceCerts(t.TempDir(), "user-ns", now); err == nil {
+		t.Error("Expected error for missing certs, got nil")
+	}
+}
+
+func TestAuthorizeNamespace(t *testing.T) {
+	caDir := t.TempDir()
+	writeCerts(t, caDir, nil, nil)
+	SetCertDir(caDir)
+	defer SetCertDir("")
+
+	controlPlaneCert, err := tls.LoadX509KeyPair(filepath.Join(caDir, CertFile), filepath.Join(caDir, KeyFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	certs, err := NewNamespaceCerts(caDir, "user-ns", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	namespaceCert, err := tls.X509KeyPair(certs[CertFile], certs[KeyFile])
+	if err != nil {
+		t.Fatal(err)
+	}
+	newPeerContext := func(cert *tls.Certificate) context.Context {
+		p := &peer.Peer{}
+		if cert != nil {
+			leaf, err := x509.ParseCertificate(cert.Certificate[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			p.AuthInfo = credentials.TLSInfo{
+				State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{leaf}}},
+			}
+		}
+		return peer.NewContext(context.Background(), p)
+	}
+
+	cases := map[string]struct {
+		ctx      context.Context
+		req      interface{}
+		wantCode codes.Code
+	}{
+		"Control plane accesses another namespace": {
+			ctx:      newPeerContext(&controlPlaneCert),
+			req:      &namespacedRequest{namespace: "other-ns"},
+			wantCode: codes.OK,
+		},
+		"Namespace client accesses the same namespace": {
+			ctx:      newPeerContext(&namespaceCert),
+			req:      &namespacedRequest{namespace: "user-ns"},
+			wantCode: codes.OK,
+		},
+		"Namespace client accesses another namespace": {
+			ctx:      newPeerContext(&namespaceCert),
+			req:      &namespacedRequest{namespace: "other-ns"},
+			wantCode: codes.PermissionDenied,
+		},
+		"Namespace client accesses all namespaces": {
+			ctx:      newPeerContext(&namespaceCert),
+			req:      &namespacedRequest{},
+			wantCode: codes.PermissionDenied,
+		},
+		"Namespace client sends request without namespace": {
+			ctx:      newPeerContext(&namespaceCert),
+			req:      &healthpb.HealthCheckRequest{},
+			wantCode: codes.OK,
+		},
+		"Client without certificate": {
+			ctx:      newPeerContext(nil),
+			req:      &namespacedRequest{namespace: "user-ns"},
+			wantCode: codes.Unauthenticated,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := authorizeNamespace(tc.ctx, tc.req)
+			if got := status.Code(err); got != tc.wantCode {
+				t.Errorf("Unexpected code: want %v, got %v (%v)", tc.wantCode, got, err)
+			}
+		})
+	}
+}
+
+type namespacedRequest struct {
+	namespace string
+}
+
+func (r *namespacedRequest) GetNamespace() string {
+	return r.namespace
+}
+
+func startServer(t *testing.T, dir string) string {
+	t.Helper()
+	SetCertDir(dir)
+	defer SetCertDir("")
+	opts, err := ServerOptions()
+	if err != nil {
+		t.Fatalf("Failed to get server options: %v", err)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	s := grpc.NewServer(opts...)
+	healthpb.RegisterHealthServer(s, health.NewServer())
+	go s.Serve(listener)
+	t.Cleanup(s.Stop)
+	return listener.Addr().String()
+}
+
+// checkHealth calls the health check of the server with the certs in dir.
+func checkHealth(addr, dir, serverName string) error {
+	SetCertDir(dir)
+	defer SetCertDir("")
+	dialOpt, err := DialOption(serverName)
+	if err != nil {
+		return err
+	}
+	conn, err := grpc.Dial(addr, dialOpt)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	return err
+}
+
+// writeCerts writes a certificate for ServerName signed by the given CA to dir.
+// If the CA is nil, a new CA is generated and its key is written to dir. It returns the CA and its key.
+func writeCerts(t *testing.T, dir string, ca *x509.Certificate, caKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	if ca == nil {
+		var err error
+		if caKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader); err != nil {
+			t.Fatal(err)
+		}
+		caTemplate := &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: "katib-grpc-ca"},
+			NotBefore:             time.Now().Add(-time.Hour),
+			NotAfter:              time.Now().Add(time.Hour),
+			IsCA:                  true,
+			KeyUsage:              x509.KeyUsageCertSign,
+			BasicConstraintsValid: true,
+		}
+		caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ca, err = x509.ParseCertificate(caDER); err != nil {
+			t.Fatal(err)
+		}
+		caKeyDER, err := x509.MarshalECPrivateKey(caKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		caKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: caKeyDER})
+		if err = os.WriteFile(filepath.Join(dir, CAKeyFile), caKeyPEM, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: ServerName},
+		DNSNames:     []string{ServerName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string][]byte{
+		CACertFile: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw}),
+		CertFile:   pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+		KeyFile:    pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+	for name, data := range files {
+		if err = os.WriteFile(filepath.Join(dir, name), data, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return ca, caKey
+}
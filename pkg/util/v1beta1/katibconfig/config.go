@@ -134,6 +134,19 @@ func GetMetricsCollectorConfigData(cKind common.CollectorKind, client client.Cli
 		return configv1beta1.MetricsCollectorConfig{}, fmt.Errorf("required value for image configuration of metrics collector kind: %s", kind)
 	}
 
+	// Check injection mode from config
+	switch metricsCollectorConfigData.InjectionMode {
+	case "", configv1beta1.ContainerInjectionMode, configv1beta1.NativeSidecarInjectionMode:
+	default:
+		return configv1beta1.MetricsCollectorConfig{}, fmt.Errorf("invalid injection mode: %s of metrics collector kind: %s", metricsCollectorConfigData.InjectionMode, kind)
+	}
+	// StdOut metrics collector redirects the training output to the metrics file, so it's hidden from the container logs,
+	// but native sidecar can collect the same metrics from the container logs with KubernetesLogs metrics collector.
+	if metricsCollectorConfigData.InjectionMode == configv1beta1.NativeSidecarInjectionMode && cKind == common.StdOutCollector {
+		return configv1beta1.MetricsCollectorConfig{}, fmt.Errorf("metrics collector kind: %s can't be injected in %s mode, use %s metrics collector instead",
+			kind, configv1beta1.NativeSidecarInjectionMode, common.KubernetesLogsCollector)
+	}
+
 	return *metricsCollectorConfigData, nil
 }
 
@@ -350,6 +350,38 @@ func TestGetMetricsCollectorConfigData(t *testing.T) {
 			inputCollectorKind: testCollectorKind,
 			err:                true,
 		},
+		{
+			testDescription: "InjectionMode field is invalid in katib-config configMap",
+			katibConfig: func() *configv1beta1.KatibConfig {
+				kc := &configv1beta1.KatibConfig{
+					RuntimeConfig: configv1beta1.RuntimeConfig{
+						MetricsCollectorConfigs: []configv1beta1.MetricsCollectorConfig{
+							*newFakeMetricsCollectorConfig(testCollectorKind),
+						},
+					},
+				}
+				kc.RuntimeConfig.MetricsCollectorConfigs[0].InjectionMode = "invalid"
+				return kc
+			}(),
+			inputCollectorKind: testCollectorKind,
+			err:                true,
+		},
+		{
+			testDescription: "StdOut metrics collector is injected as native sidecar in katib-config configMap",
+			katibConfig: func() *configv1beta1.KatibConfig {
+				kc := &configv1beta1.KatibConfig{
+					RuntimeConfig: configv1beta1.RuntimeConfig{
+						MetricsCollectorConfigs: []configv1beta1.MetricsCollectorConfig{
+							*newFakeMetricsCollectorConfig(commonv1beta1.StdOutCollector),
+						},
+					},
+				}
+				kc.RuntimeConfig.MetricsCollectorConfigs[0].InjectionMode = configv1beta1.NativeSidecarInjectionMode
+				return kc
+			}(),
+			inputCollectorKind: commonv1beta1.StdOutCollector,
+			err:                true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.testDescription, func(t *testing.T) {
@@ -554,6 +586,7 @@ func newFakeMetricsCollectorConfig(collectorKind commonv1beta1.CollectorKind) *c
 		Image:           "metrics-collector-image",
 		ImagePullPolicy: configv1beta1.DefaultImagePullPolicy,
 		Resource:        *setFakeResourceRequirements(),
+		InjectionMode:   configv1beta1.ContainerInjectionMode,
 	}
 }
 
@@ -0,0 +1,59 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pareto
+
+import "testing"
+
+func TestDominates(t *testing.T) {
+	cases := map[string]struct {
+		a, b []float64
+		want bool
+	}{
+		"Better in all objectives": {
+			a:    []float64{1, 1},
+			b:    []float64{2, 2},
+			want: true,
+		},
+		"Better in one objective": {
+			a:    []float64{1, 2},
+			b:    []float64{2, 2},
+			want: true,
+		},
+		"Equal values": {
+			a:    []float64{1, 2},
+			b:    []float64{1, 2},
+			want: false,
+		},
+		"Worse in one objective": {
+			a:    []float64{1, 3},
+			b:    []float64{2, 2},
+			want: false,
+		},
+		"Different number of objectives": {
+			a:    []float64{1},
+			b:    []float64{2, 2},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := Dominates(tc.a, tc.b); got != tc.want {
+				t.Errorf("Dominates(%v, %v) should return %v, but got %v", tc.a, tc.b, tc.want, got)
+			}
+		})
+	}
+}
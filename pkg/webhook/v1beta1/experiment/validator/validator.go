@@ -40,6 +40,8 @@ import (
 	experimentutil "github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/util"
 	util "github.com/kubeflow/katib/pkg/controller.v1beta1/util"
 	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+	filemc "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/file-metricscollector"
+	promc "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/prometheus-metricscollector"
 )
 
 var (
@@ -50,6 +52,8 @@ var (
 	algorithmPath        = specPath.Child("algorithm")
 	earlyStoppingPath    = specPath.Child("earlyStopping")
 	resumePolicyPath     = specPath.Child("resumePolicy")
+	suspendPolicyPath    = specPath.Child("suspendPolicy")
+	budgetPolicyPath     = specPath.Child("budgetPolicy")
 	parametersPath       = specPath.Child("parameters")
 	trialTemplatePath    = specPath.Child("trialTemplate")
 	trialParametersPath  = trialTemplatePath.Child("trialParameters")
@@ -114,6 +118,10 @@ func (g *DefaultValidator) ValidateExperiment(instance, oldInst *experimentsv1be
 	}
 
 	if oldInst != nil {
+		// Suspending or resuming experiment doesn't restart it.
+		oldInst.Spec.Suspend = instance.Spec.Suspend
+		oldInst.Spec.SuspendPolicy = instance.Spec.SuspendPolicy
+
 		// We should validate restart only if appropriate fields are changed.
 		// Otherwise check below is triggered when experiment is deleted.
 		isRestarting := false
@@ -137,7 +145,8 @@ func (g *DefaultValidator) ValidateExperiment(instance, oldInst *experimentsv1be
 		oldInst.Spec.MaxTrialCount = instance.Spec.MaxTrialCount
 		oldInst.Spec.ParallelTrialCount = instance.Spec.ParallelTrialCount
 		if !equality.Semantic.DeepEqual(instance.Spec, oldInst.Spec) {
-			allErrs = append(allErrs, field.Forbidden(specPath, "only spec.parallelTrialCount, spec.maxTrialCount and spec.maxFailedTrialCount are editable"))
+			allErrs = append(allErrs, field.Forbidden(specPath, "only spec.parallelTrialCount, spec.maxTrialCount, spec.maxFailedTrialCount, "+
+				"spec.suspend and spec.suspendPolicy are editable"))
 		}
 	}
 	if err := g.validateObjective(instance.Spec.Objective); err != nil {
@@ -153,6 +162,12 @@ func (g *DefaultValidator) ValidateExperiment(instance, oldInst *experimentsv1be
 	if err := g.validateResumePolicy(instance.Spec.ResumePolicy); err != nil {
 		allErrs = append(allErrs, err...)
 	}
+	if err := g.validateSuspendPolicy(instance.Spec.SuspendPolicy); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+	if err := g.validateBudget(instance); err != nil {
+		allErrs = append(allErrs, err...)
+	}
 
 	if err := g.validateTrialTemplate(instance); err != nil {
 		allErrs = append(allErrs, err...)
@@ -197,6 +212,38 @@ func (g *DefaultValidator) validateObjective(obj *commonapiv1beta1.ObjectiveSpec
 		allErrs = append(allErrs, field.Invalid(objectivePath.Child("additionalMetricNames"),
 			obj.AdditionalMetricNames, "should not contain spec.objective.objectiveMetricName"))
 	}
+	objectiveNames := map[string]bool{obj.ObjectiveMetricName: true}
+	for i, objective := range obj.AdditionalObjectives {
+		objectivePath := objectivePath.Child("additionalObjectives").Index(i)
+		if objective.Type != commonapiv1beta1.ObjectiveTypeMinimize && objective.Type != commonapiv1beta1.ObjectiveTypeMaximize {
+			allErrs = append(allErrs, field.Invalid(objectivePath.Child("type"), objective.Type,
+				fmt.Sprintf("must be %s or %s", commonapiv1beta1.ObjectiveTypeMinimize, commonapiv1beta1.ObjectiveTypeMaximize)))
+		}
+		if objective.ObjectiveMetricName == "" {
+			allErrs = append(allErrs, field.Required(objectivePath.Child("objectiveMetricName"), "must be specified"))
+		} else if objectiveNames[objective.ObjectiveMetricName] {
+			allErrs = append(allErrs, field.Duplicate(objectivePath.Child("objectiveMetricName"), objective.ObjectiveMetricName))
+		}
+		objectiveNames[objective.ObjectiveMetricName] = true
+	}
+	for i, constraint := range obj.Constraints {
+		constraintPath := objectivePath.Child("constraints").Index(i)
+		if constraint.MetricName == "" {
+			allErrs = append(allErrs, field.Required(constraintPath.Child("metricName"), "must be specified"))
+		}
+		if constraint.Comparison != commonapiv1beta1.ComparisonTypeLess && constraint.Comparison != commonapiv1beta1.ComparisonTypeGreater {
+			allErrs = append(allErrs, field.NotSupported(constraintPath.Child("comparison"), constraint.Comparison,
+				[]string{string(commonapiv1beta1.ComparisonTypeLess), string(commonapiv1beta1.ComparisonTypeGreater)}))
+		}
+	}
+	switch obj.WorkerAggregation {
+	case "", commonapiv1beta1.WorkerAggregationRank0, commonapiv1beta1.WorkerAggregationMean,
+		commonapiv1beta1.WorkerAggregationMin, commonapiv1beta1.WorkerAggregationMax:
+	default:
+		allErrs = append(allErrs, field.NotSupported(objectivePath.Child("workerAggregation"), obj.WorkerAggregation,
+			[]string{string(commonapiv1beta1.WorkerAggregationRank0), string(commonapiv1beta1.WorkerAggregationMean),
+				string(commonapiv1beta1.WorkerAggregationMin), string(commonapiv1beta1.WorkerAggregationMax)}))
+	}
 	return allErrs
 }
 
@@ -251,6 +298,42 @@ func (g *DefaultValidator) validateResumePolicy(resume experimentsv1beta1.Resume
 	return allErrs
 }
 
+func (g *DefaultValidator) validateSuspendPolicy(suspend experimentsv1beta1.SuspendPolicyType) field.ErrorList {
+	var allErrs field.ErrorList
+	validTypes := map[experimentsv1beta1.SuspendPolicyType]string{
+		"":                              "",
+		experimentsv1beta1.DrainSuspend: "",
+		experimentsv1beta1.KillSuspend:  "",
+	}
+	if _, ok := validTypes[suspend]; !ok {
+		allErrs = append(allErrs, field.Invalid(suspendPolicyPath, suspend, "invalid SuspendPolicyType"))
+	}
+	return allErrs
+}
+
+func (g *DefaultValidator) validateBudget(instance *experimentsv1beta1.Experiment) field.ErrorList {
+	var allErrs field.ErrorList
+	if instance.Spec.MaxDuration != nil && instance.Spec.MaxDuration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("maxDuration"), instance.Spec.MaxDuration.Duration.String(),
+			"must be greater than 0"))
+	}
+	for name, budget := range instance.Spec.ResourceBudget {
+		if budget.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("resourceBudget").Key(string(name)), budget.String(),
+				"must be greater than 0"))
+		}
+	}
+	validTypes := map[experimentsv1beta1.BudgetPolicyType]string{
+		"":                             "",
+		experimentsv1beta1.DrainBudget: "",
+		experimentsv1beta1.KillBudget:  "",
+	}
+	if _, ok := validTypes[instance.Spec.BudgetPolicy]; !ok {
+		allErrs = append(allErrs, field.Invalid(budgetPolicyPath, instance.Spec.BudgetPolicy, "invalid BudgetPolicyType"))
+	}
+	return allErrs
+}
+
 func (g *DefaultValidator) validateParameters(parameters []experimentsv1beta1.ParameterSpec) field.ErrorList {
 	var allErrs field.ErrorList
 	for i, param := range parameters {
@@ -320,6 +403,20 @@ func (g *DefaultValidator) validateTrialTemplate(instance *experimentsv1beta1.Ex
 		allErrs = append(allErrs, field.Required(trialTemplatePath, "successCondition and failureCondition must be specified"))
 	}
 
+	// Check if retryPolicy is valid
+	if retryPolicy := trialTemplate.RetryPolicy; retryPolicy != nil {
+		retryPolicyPath := trialTemplatePath.Child("retryPolicy")
+		if retryPolicy.MaxRetries < 0 {
+			allErrs = append(allErrs, field.Invalid(retryPolicyPath.Child("maxRetries"), retryPolicy.MaxRetries, "should not be less than 0"))
+		} else if retryPolicy.MaxRetries > consts.MaxTrialRetries {
+			allErrs = append(allErrs, field.Invalid(retryPolicyPath.Child("maxRetries"), retryPolicy.MaxRetries,
+				fmt.Sprintf("should not be greater than %d", consts.MaxTrialRetries)))
+		}
+		if retryPolicy.BackoffSeconds < 0 {
+			allErrs = append(allErrs, field.Invalid(retryPolicyPath.Child("backoffSeconds"), retryPolicy.BackoffSeconds, "should not be less than 0"))
+		}
+	}
+
 	// Check if trialParameters exists
 	if trialTemplate.TrialParameters == nil {
 		return append(allErrs, field.Required(trialTemplatePath.Child("trialParameters"), "must be specified"))
@@ -497,10 +594,49 @@ func (g *DefaultValidator) validateMetricsCollector(inst *experimentsv1beta1.Exp
 		}
 		break
 	}
+	var fileFormat commonapiv1beta1.FileFormat
+	if mcKind == commonapiv1beta1.FileCollector && mcSpec.Source != nil && mcSpec.Source.FileSystemPath != nil {
+		fileFormat = mcSpec.Source.FileSystemPath.Format
+	}
+	if mcSpec.Source != nil && mcSpec.Source.Filter != nil {
+		filter := mcSpec.Source.Filter
+		if len(filter.MetricsSelectors) > 0 && (fileFormat == "" || fileFormat == commonapiv1beta1.TextFormat) {
+			allErrs = append(allErrs, field.Invalid(metricsSourcePath.Child("filter").Child("metricsSelectors"),
+				"", "metricsSelectors can be set only when format of metrics file is JSON, JSONDocument, YAML or CSV"))
+		}
+		if filter.TimestampColumn != "" && fileFormat != commonapiv1beta1.CsvFormat {
+			allErrs = append(allErrs, field.Invalid(metricsSourcePath.Child("filter").Child("timestampColumn"),
+				filter.TimestampColumn, "timestampColumn can be set only when format of metrics file is CSV"))
+		}
+		if filter.StepColumn != "" && fileFormat != commonapiv1beta1.CsvFormat {
+			allErrs = append(allErrs, field.Invalid(metricsSourcePath.Child("filter").Child("stepColumn"),
+				filter.StepColumn, "stepColumn can be set only when format of metrics file is CSV"))
+		}
+		if filter.TimestampFormat != "" {
+			if mcKind != commonapiv1beta1.StdOutCollector && mcKind != commonapiv1beta1.KubernetesLogsCollector &&
+				fileFormat != commonapiv1beta1.TextFormat {
+				allErrs = append(allErrs, field.Invalid(metricsSourcePath.Child("filter").Child("timestampFormat"),
+					filter.TimestampFormat, "timestampFormat can be set only when format of metrics file is TEXT"))
+			} else if _, err := filemc.NewTimestampParser(filter.TimestampFormat); err != nil {
+				allErrs = append(allErrs, field.Invalid(metricsSourcePath.Child("filter").Child("timestampFormat"),
+					filter.TimestampFormat, err.Error()))
+			}
+		}
+	}
 	// TODO(hougangliu): log warning message if some field will not be used for the metricsCollector kind
 	switch mcKind {
 	case commonapiv1beta1.PushCollector, commonapiv1beta1.StdOutCollector:
 		return allErrs
+	case commonapiv1beta1.KubernetesLogsCollector:
+		if mcSpec.Source != nil && (mcSpec.Source.FileSystemPath != nil || mcSpec.Source.HttpGet != nil) {
+			allErrs = append(allErrs, field.Invalid(metricsSourcePath, "",
+				fmt.Sprintf("only filter can be set for metrics collector kind: %v", mcKind)))
+		}
+		// Trial controller doesn't evaluate the early stopping rules.
+		if inst.Spec.EarlyStopping != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("earlyStopping"), "",
+				fmt.Sprintf("early stopping is not supported for metrics collector kind: %v", mcKind)))
+		}
 	case commonapiv1beta1.FileCollector:
 		if mcSpec.Source == nil || mcSpec.Source.FileSystemPath == nil ||
 			mcSpec.Source.FileSystemPath.Kind != commonapiv1beta1.FileKind || !filepath.IsAbs(mcSpec.Source.FileSystemPath.Path) {
@@ -508,14 +644,19 @@ func (g *DefaultValidator) validateMetricsCollector(inst *experimentsv1beta1.Exp
 				"file path where metrics file exists is required"))
 		}
 		// Format
-		fileFormat := mcSpec.Source.FileSystemPath.Format
-		if fileFormat != commonapiv1beta1.TextFormat && fileFormat != commonapiv1beta1.JsonFormat {
+		switch fileFormat {
+		case commonapiv1beta1.TextFormat, commonapiv1beta1.JsonFormat, commonapiv1beta1.CsvFormat,
+			commonapiv1beta1.JsonDocumentFormat, commonapiv1beta1.YamlFormat:
+		default:
 			allErrs = append(allErrs, field.Required(metricsSourcePath.Child("fileSystemPath").Child("format"),
 				"format of metrics file is required for metrics collector"))
 		}
-		if fileFormat == commonapiv1beta1.JsonFormat && mcSpec.Source.Filter != nil {
-			allErrs = append(allErrs, field.Invalid(metricsSourcePath.Child("filter"),
-				"", "filter must be nil when format of metrics file is json"))
+		if fileFormat != commonapiv1beta1.TextFormat && mcSpec.Source.Filter != nil && len(mcSpec.Source.Filter.MetricsFormat) != 0 {
+			allErrs = append(allErrs, field.Invalid(metricsSourcePath.Child("filter").Child("metricsFormat"),
+				"", fmt.Sprintf("metricsFormat must be empty when format of metrics file is %v", fileFormat)))
+		}
+		if fileFormat != commonapiv1beta1.TextFormat && mcSpec.Source.Filter != nil {
+			allErrs = append(allErrs, validateMetricsSelectors(inst, mcSpec.Source.Filter.MetricsSelectors, fileFormat, metricsSourcePath.Child("filter").Child("metricsSelectors"))...)
 		}
 	case commonapiv1beta1.TfEventCollector:
 		if mcSpec.Source == nil || mcSpec.Source.FileSystemPath == nil ||
@@ -537,6 +678,21 @@ func (g *DefaultValidator) validateMetricsCollector(inst *experimentsv1beta1.Exp
 			allErrs = append(allErrs, field.Invalid(metricsSourcePath.Child("httpGet").Child("path"),
 				mcSpec.Source.HttpGet.Path, fmt.Sprintf("path is invalid for metrics collector kind: %v", mcKind)))
 		}
+		// For the Prometheus metrics collector filter contains the selectors of the metric series.
+		if mcSpec.Source.Filter != nil {
+			metricNames := append([]string{inst.Spec.Objective.ObjectiveMetricName}, inst.Spec.Objective.AdditionalMetricNames...)
+			for _, s := range mcSpec.Source.Filter.MetricsFormat {
+				selector, err := promc.ParseSelector(s)
+				if err != nil {
+					allErrs = append(allErrs, field.Invalid(metricsSourcePath.Child("filter").Child("metricsFormat"),
+						s, fmt.Sprintf("invalid selector: %v", err)))
+				} else if !contains(metricNames, selector.Name) {
+					allErrs = append(allErrs, field.Invalid(metricsSourcePath.Child("filter").Child("metricsFormat"),
+						s, "selector must be set for the objective or additional metric"))
+				}
+			}
+		}
+		return allErrs
 	case commonapiv1beta1.CustomCollector:
 		if mcSpec.Collector.CustomCollector == nil {
 			allErrs = append(allErrs, field.Required(metricsCollectorPath.Child("collector").Child("customCollector"),
@@ -572,6 +728,32 @@ func (g *DefaultValidator) validateMetricsCollector(inst *experimentsv1beta1.Exp
 	return allErrs
 }
 
+// validateMetricsSelectors validates the selectors of the metric values in the metrics file.
+// Selector is the column name for the CSV format and the JSON selector for the other formats.
+func validateMetricsSelectors(inst *experimentsv1beta1.Experiment, selectors []commonapiv1beta1.MetricSelector, fileFormat commonapiv1beta1.FileFormat, selectorsPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	metricNames := append([]string{inst.Spec.Objective.ObjectiveMetricName}, inst.Spec.Objective.AdditionalMetricNames...)
+	selectedMetrics := map[string]bool{}
+	for i, selector := range selectors {
+		if !contains(metricNames, selector.Name) {
+			allErrs = append(allErrs, field.Invalid(selectorsPath.Index(i).Child("name"),
+				selector.Name, "selector must be set for the objective or additional metric"))
+		} else if selectedMetrics[selector.Name] {
+			allErrs = append(allErrs, field.Duplicate(selectorsPath.Index(i).Child("name"), selector.Name))
+		}
+		selectedMetrics[selector.Name] = true
+		if fileFormat == commonapiv1beta1.CsvFormat {
+			if strings.TrimSpace(selector.Path) == "" {
+				allErrs = append(allErrs, field.Required(selectorsPath.Index(i).Child("path"), "column name is required"))
+			}
+		} else if _, err := filemc.ParseJSONSelector(selector.Path); err != nil {
+			allErrs = append(allErrs, field.Invalid(selectorsPath.Index(i).Child("path"),
+				selector.Path, err.Error()))
+		}
+	}
+	return allErrs
+}
+
 func isMetaKey(parameter string) bool {
 	// Check if parameter is trial metadata reference as ${trailSpec.Name}, ${trialSpec.Labels[label]}, etc. used for substitution
 	match := regexp.MustCompile(consts.TrialTemplateMetaReplaceFormatRegex).FindStringSubmatch(parameter)
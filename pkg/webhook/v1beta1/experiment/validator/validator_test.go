@@ -19,6 +19,7 @@ package validator
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -29,6 +30,7 @@ import (
 	"go.uber.org/mock/gomock"
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -37,6 +39,7 @@ import (
 	configv1beta1 "github.com/kubeflow/katib/pkg/apis/config/v1beta1"
 	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
 
 	manifestmock "github.com/kubeflow/katib/pkg/mock/v1beta1/experiment/manifest"
@@ -132,6 +135,95 @@ func TestValidateExperiment(t *testing.T) {
 			},
 			testDescription: "additionalMetricNames should not contain objective metric name",
 		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.WorkerAggregation = "median"
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.NotSupported(field.NewPath("spec").Child("objective").Child("workerAggregation"), "", []string{}),
+			},
+			testDescription: "Worker aggregation is not supported",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.AdditionalObjectives = []commonv1beta1.AdditionalObjective{
+					{ObjectiveMetricName: "latency", Type: commonv1beta1.ObjectiveTypeMinimize},
+				}
+				return i
+			}(),
+			testDescription: "Valid additional objectives",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.AdditionalObjectives = []commonv1beta1.AdditionalObjective{
+					{ObjectiveMetricName: "latency", Type: commonv1beta1.ObjectiveTypeUnknown},
+					{ObjectiveMetricName: "", Type: commonv1beta1.ObjectiveTypeMinimize},
+					{ObjectiveMetricName: "testme", Type: commonv1beta1.ObjectiveTypeMaximize},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("objective").Child("additionalObjectives").Index(0).Child("type"), "", ""),
+				field.Required(field.NewPath("spec").Child("objective").Child("additionalObjectives").Index(1).Child("objectiveMetricName"), ""),
+				field.Duplicate(field.NewPath("spec").Child("objective").Child("additionalObjectives").Index(2).Child("objectiveMetricName"), ""),
+			},
+			testDescription: "Invalid additional objectives",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.Constraints = []commonv1beta1.ObjectiveConstraint{
+					{MetricName: "latency", Comparison: commonv1beta1.ComparisonTypeLess, Bound: 20},
+				}
+				return i
+			}(),
+			testDescription: "Valid constraints",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.Constraints = []commonv1beta1.ObjectiveConstraint{
+					{MetricName: "", Comparison: commonv1beta1.ComparisonTypeLess, Bound: 20},
+					{MetricName: "size", Comparison: commonv1beta1.ComparisonTypeEqual, Bound: 50},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Required(field.NewPath("spec").Child("objective").Child("constraints").Index(0).Child("metricName"), ""),
+				field.NotSupported(field.NewPath("spec").Child("objective").Child("constraints").Index(1).Child("comparison"), "", []string{}),
+			},
+			testDescription: "Invalid constraints",
+		},
+		// Budget
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MaxDuration = &metav1.Duration{Duration: time.Hour}
+				i.Spec.ResourceBudget = v1.ResourceList{v1.ResourceCPU: resource.MustParse("100")}
+				i.Spec.BudgetPolicy = experimentsv1beta1.KillBudget
+				return i
+			}(),
+			testDescription: "Valid budget",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MaxDuration = &metav1.Duration{Duration: -time.Hour}
+				i.Spec.ResourceBudget = v1.ResourceList{v1.ResourceCPU: resource.MustParse("0")}
+				i.Spec.BudgetPolicy = "invalid"
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("maxDuration"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("resourceBudget").Key("cpu"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("budgetPolicy"), "", ""),
+			},
+			testDescription: "Invalid budget",
+		},
 		// Algorithm
 		{
 			instance: func() *experimentsv1beta1.Experiment {
@@ -272,6 +364,55 @@ func TestValidateExperiment(t *testing.T) {
 			},
 			testDescription: "Invalid resume policy",
 		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Suspend = true
+				i.Spec.SuspendPolicy = experimentsv1beta1.KillSuspend
+				return i
+			}(),
+			oldInstance:     newFakeInstance(),
+			testDescription: "Suspend running experiment",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.SuspendPolicy = "invalid-policy"
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("suspendPolicy"), "", ""),
+			},
+			testDescription: "Invalid suspend policy",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.TrialTemplate.RetryPolicy = &commonv1beta1.RetryPolicy{
+					MaxRetries:     -1,
+					BackoffSeconds: -10,
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("trialTemplate").Child("retryPolicy").Child("maxRetries"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("trialTemplate").Child("retryPolicy").Child("backoffSeconds"), "", ""),
+			},
+			testDescription: "Invalid retry policy",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.TrialTemplate.RetryPolicy = &commonv1beta1.RetryPolicy{
+					MaxRetries: consts.MaxTrialRetries + 1,
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("trialTemplate").Child("retryPolicy").Child("maxRetries"), "", ""),
+			},
+			testDescription: "Too many retries in retry policy",
+		},
 		// Validate NAS Config
 		{
 			instance: func() *experimentsv1beta1.Experiment {
@@ -1116,6 +1257,38 @@ func TestValidateMetricsCollector(t *testing.T) {
 			},
 			testDescription: "Invalid path for Prometheus metrics collector",
 		},
+		// PrometheusMetricCollector invalid selectors
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.PrometheusMetricCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						HttpGet: &v1.HTTPGetAction{
+							Port: intstr.IntOrString{
+								IntVal: 8888,
+							},
+							Path: "/metrics",
+						},
+						Filter: &commonv1beta1.FilterSpec{
+							MetricsFormat: []string{
+								`testme{phase="validation"}`,
+								`testme{phase=validation}`,
+								`unknown{phase="validation"}`,
+							},
+						},
+					},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("filter").Child("metricsFormat"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("filter").Child("metricsFormat"), "", ""),
+			},
+			testDescription: "Invalid selectors for Prometheus metrics collector",
+		},
 		//  CustomCollector empty CustomCollector
 		{
 			instance: func() *experimentsv1beta1.Experiment {
@@ -1244,7 +1417,9 @@ func TestValidateMetricsCollector(t *testing.T) {
 						Kind: commonv1beta1.FileCollector,
 					},
 					Source: &commonv1beta1.SourceSpec{
-						Filter: &commonv1beta1.FilterSpec{},
+						Filter: &commonv1beta1.FilterSpec{
+							MetricsFormat: []string{"{metricName: ([\\w|-]+), metricValue: ((-?\\d+)(\\.\\d+)?)}"},
+						},
 						FileSystemPath: &commonv1beta1.FileSystemPath{
 							Path:   "/absolute/path",
 							Kind:   commonv1beta1.FileKind,
@@ -1255,10 +1430,314 @@ func TestValidateMetricsCollector(t *testing.T) {
 				return i
 			}(),
 			wantErr: field.ErrorList{
-				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("filter"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("filter").Child("metricsFormat"), "", ""),
 			},
 			testDescription: "Invalid metrics filer for File metrics collector when file format is `JSON`",
 		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						Filter: &commonv1beta1.FilterSpec{
+							MetricsSelectors: []commonv1beta1.MetricSelector{
+								{Name: "testme", Path: "$.eval.acc"},
+							},
+						},
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:   "/absolute/path",
+							Kind:   commonv1beta1.FileKind,
+							Format: commonv1beta1.JsonFormat,
+						},
+					},
+				}
+				return i
+			}(),
+			testDescription: "Run validator for correct metrics selectors",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						Filter: &commonv1beta1.FilterSpec{
+							MetricsSelectors: []commonv1beta1.MetricSelector{
+								{Name: "testme", Path: "$..acc"},
+								{Name: "unknown", Path: "acc"},
+								{Name: "testme", Path: "acc"},
+							},
+						},
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:   "/absolute/path",
+							Kind:   commonv1beta1.FileKind,
+							Format: commonv1beta1.JsonFormat,
+						},
+					},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("filter").Child("metricsSelectors").Index(0).Child("path"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("filter").Child("metricsSelectors").Index(1).Child("name"), "", ""),
+				field.Duplicate(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("filter").Child("metricsSelectors").Index(2).Child("name"), ""),
+			},
+			testDescription: "Invalid metrics selectors for File metrics collector",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						Filter: &commonv1beta1.FilterSpec{
+							MetricsSelectors: []commonv1beta1.MetricSelector{
+								{Name: "testme", Path: "acc"},
+							},
+						},
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:   "/absolute/path",
+							Kind:   commonv1beta1.FileKind,
+							Format: commonv1beta1.TextFormat,
+						},
+					},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("filter").Child("metricsSelectors"), "", ""),
+			},
+			testDescription: "Invalid metrics selectors for File metrics collector when file format is `TEXT`",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						Filter: &commonv1beta1.FilterSpec{
+							MetricsSelectors: []commonv1beta1.MetricSelector{
+								{Name: "testme", Path: "val_accuracy"},
+							},
+							TimestampColumn: "wall_time",
+							StepColumn:      "global_step",
+						},
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:   "/absolute/path",
+							Kind:   commonv1beta1.FileKind,
+							Format: commonv1beta1.CsvFormat,
+						},
+					},
+				}
+				return i
+			}(),
+			testDescription: "Run validator for correct metrics selectors and columns when file format is `CSV`",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						Filter: &commonv1beta1.FilterSpec{
+							MetricsSelectors: []commonv1beta1.MetricSelector{
+								{Name: "testme", Path: ""},
+							},
+						},
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:   "/absolute/path",
+							Kind:   commonv1beta1.FileKind,
+							Format: commonv1beta1.CsvFormat,
+						},
+					},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Required(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("filter").Child("metricsSelectors").Index(0).Child("path"), ""),
+			},
+			testDescription: "Invalid metrics selectors for File metrics collector when file format is `CSV`",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						Filter: &commonv1beta1.FilterSpec{
+							MetricsSelectors: []commonv1beta1.MetricSelector{
+								{Name: "testme", Path: "$['metrics']['acc']"},
+							},
+						},
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:   "/absolute/path",
+							Kind:   commonv1beta1.FileKind,
+							Format: commonv1beta1.YamlFormat,
+						},
+					},
+				}
+				return i
+			}(),
+			testDescription: "Run validator for correct metrics selectors when file format is `YAML`",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						Filter: &commonv1beta1.FilterSpec{
+							TimestampColumn: "wall_time",
+							StepColumn:      "global_step",
+						},
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:   "/absolute/path",
+							Kind:   commonv1beta1.FileKind,
+							Format: commonv1beta1.JsonFormat,
+						},
+					},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("filter").Child("timestampColumn"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("filter").Child("stepColumn"), "", ""),
+			},
+			testDescription: "Invalid columns for File metrics collector when file format is `JSON`",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						Filter: &commonv1beta1.FilterSpec{
+							TimestampFormat: "2006-01-02 15:04:05",
+						},
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:   "/absolute/path",
+							Kind:   commonv1beta1.FileKind,
+							Format: commonv1beta1.TextFormat,
+						},
+					},
+				}
+				return i
+			}(),
+			testDescription: "Run validator for correct timestamp format when file format is `TEXT`",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						Filter: &commonv1beta1.FilterSpec{
+							TimestampFormat: `time=(\S+`,
+						},
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:   "/absolute/path",
+							Kind:   commonv1beta1.FileKind,
+							Format: commonv1beta1.TextFormat,
+						},
+					},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("filter").Child("timestampFormat"), "", ""),
+			},
+			testDescription: "Invalid timestamp format for File metrics collector",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						Filter: &commonv1beta1.FilterSpec{
+							TimestampFormat: "Python",
+						},
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:   "/absolute/path",
+							Kind:   commonv1beta1.FileKind,
+							Format: commonv1beta1.JsonFormat,
+						},
+					},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("filter").Child("timestampFormat"), "", ""),
+			},
+			testDescription: "Invalid timestamp format for File metrics collector when file format is `JSON`",
+		},
+		// KubernetesLogsCollector
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.EarlyStopping = nil
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.KubernetesLogsCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						Filter: &commonv1beta1.FilterSpec{
+							MetricsFormat:   []string{`(\w+):\s*([\d.]+)`},
+							TimestampFormat: "Python",
+						},
+					},
+				}
+				return i
+			}(),
+			testDescription: "Run validator for correct KubernetesLogs metrics collector",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.KubernetesLogsCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						Filter: &commonv1beta1.FilterSpec{
+							MetricsFormat: []string{"invalid"},
+						},
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path: "/absolute/path",
+							Kind: commonv1beta1.FileKind,
+						},
+					},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("earlyStopping"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("filter").Child("metricsFormat"), "", ""),
+			},
+			testDescription: "Invalid source, early stopping and metrics format for KubernetesLogs metrics collector",
+		},
 		// Valid FileMetricCollector
 		{
 			instance: func() *experimentsv1beta1.Experiment {
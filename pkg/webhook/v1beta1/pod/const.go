@@ -17,6 +17,8 @@ limitations under the License.
 package pod
 
 import (
+	"time"
+
 	common "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 )
 
@@ -27,6 +29,9 @@ const (
 	TrialKind = "Trial"
 	// TrialAPIVersion is the name of Trial API Version
 	TrialAPIVersion = "kubeflow.org/v1beta1"
+	// nativeSidecarExitMargin is the part of the Pod's termination grace period
+	// which is left for the native sidecar metrics collector to exit after the report timeout.
+	nativeSidecarExitMargin = 5 * time.Second
 )
 
 var (
@@ -34,5 +39,6 @@ var (
 		common.StdOutCollector,
 		common.TfEventCollector,
 		common.FileCollector,
+		common.PrometheusMetricCollector,
 	}
 )
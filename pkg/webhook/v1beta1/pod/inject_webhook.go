@@ -43,6 +43,7 @@ import (
 	katibmanagerv1beta1 "github.com/kubeflow/katib/pkg/common/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
+	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
 	"github.com/kubeflow/katib/pkg/util/v1beta1/katibconfig"
 )
 
@@ -63,15 +64,23 @@ type SidecarInjector struct {
 	// injectSecurityContext indicates if we should inject the security
 	// context into the metrics collector sidecar.
 	injectSecurityContext bool
+
+	// grpcTLSSecretName is the name of the secret with the certs for mutual TLS
+	// between the Katib gRPC services. It is empty if mutual TLS is disabled.
+	grpcTLSSecretName string
 }
 
 // NewSidecarInjector returns a new sidecar injector with the given client.
 func NewSidecarInjector(c client.Client, d *admission.Decoder) *SidecarInjector {
-	return &SidecarInjector{
+	s := &SidecarInjector{
 		injectSecurityContext: viper.GetBool(consts.ConfigInjectSecurityContext),
 		client:                c,
 		decoder:               d,
 	}
+	if viper.GetBool(consts.ConfigGRPCTLSEnable) {
+		s.grpcTLSSecretName = consts.GRPCNamespaceCertSecretName
+	}
+	return s
 }
 
 func (s *SidecarInjector) Handle(ctx context.Context, req admission.Request) admission.Response {
@@ -125,6 +134,13 @@ func (s *SidecarInjector) MutationRequired(pod *v1.Pod, ns string) (bool, error)
 		return false, err
 	}
 
+	// If Metrics Collector is KubernetesLogs, metrics are collected by Trial controller
+	// from the pod logs, so the pod isn't mutated at all.
+	if trial.Spec.MetricsCollector.Collector != nil &&
+		trial.Spec.MetricsCollector.Collector.Kind == common.KubernetesLogsCollector {
+		return false, nil
+	}
+
 	return true, nil
 }
 
@@ -163,7 +179,13 @@ func (s *SidecarInjector) Mutate(pod *v1.Pod, namespace string) (*v1.Pod, error)
 	}
 
 	// If Metrics Collector is Push, skip the mutation.
+	// The primary container reports metrics to the DB manager, so it needs the certs if mutual TLS is enabled.
 	if trial.Spec.MetricsCollector.Collector.Kind == common.PushCollector {
+		if s.grpcTLSSecretName != "" {
+			if err := mutateGRPCCertVolume(mutatedPod, trial.Spec.PrimaryContainerName, s.grpcTLSSecretName); err != nil {
+				return nil, err
+			}
+		}
 		return mutatedPod, nil
 	}
 
@@ -174,13 +196,26 @@ func (s *SidecarInjector) Mutate(pod *v1.Pod, namespace string) (*v1.Pod, error)
 	}
 	mutatedPod.Spec.Containers = append(mutatedPod.Spec.Containers, *injectContainer)
 
+	// Mount the certs for mutual TLS between the metrics collector and the Katib gRPC services.
+	// Custom collectors manage their own connections.
+	if s.grpcTLSSecretName != "" && trial.Spec.MetricsCollector.Collector.Kind != common.CustomCollector {
+		if err = mutateGRPCCertVolume(mutatedPod, injectContainer.Name, s.grpcTLSSecretName); err != nil {
+			return nil, err
+		}
+	}
+
 	// Enable shared volume between suggestion <> trial
 	if err = s.mutateSuggestionVolume(mutatedPod, injectContainer.Name, trial); err != nil {
 		return nil, err
 	}
 
-	isShareProcessNamespace := true
-	mutatedPod.Spec.ShareProcessNamespace = &isShareProcessNamespace
+	// Native sidecar doesn't share the process namespace, the training completion is signaled by
+	// the completed marker which the wrapped primary container writes to the metrics volume.
+	nativeSidecar := isNativeSidecar(injectContainer)
+	if !nativeSidecar {
+		isShareProcessNamespace := true
+		mutatedPod.Spec.ShareProcessNamespace = &isShareProcessNamespace
+	}
 
 	mountPath, pathKind := getMountPath(trial.Spec.MetricsCollector)
 	if mountPath != "" {
@@ -189,7 +224,15 @@ func (s *SidecarInjector) Mutate(pod *v1.Pod, namespace string) (*v1.Pod, error)
 		}
 	}
 	if needWrapWorkerContainer(trial.Spec.MetricsCollector) {
-		if err = wrapWorkerContainer(trial, mutatedPod, namespace, mountPath, pathKind); err != nil {
+		if err = wrapWorkerContainer(trial, mutatedPod, namespace, mountPath, pathKind, nativeSidecar); err != nil {
+			return nil, err
+		}
+	}
+
+	// Native sidecar is moved to the init containers after the volumes are mounted,
+	// so it's started before the training containers and terminated once they are completed.
+	if nativeSidecar {
+		if err = moveToInitContainers(mutatedPod, injectContainer.Name); err != nil {
 			return nil, err
 		}
 	}
@@ -216,10 +259,10 @@ func (s *SidecarInjector) getMetricsCollectorContainer(trial *trialsv1beta1.Tria
 
 	// Convert rules to flag value with name;value;comparison;startStep order, e.g. accuracy;0.8;less;4.
 	// If start step is empty, we apply rule from the first recorded metrics and flag is equal to accuracy;0.8;less;0.
+	// The type, patience and group follow the start step if they are set, e.g. loss;;less;0;patience;5;overfit.
 	earlyStoppingRules := []string{}
 	for _, rule := range trial.Spec.EarlyStoppingRules {
-		newRule := rule.Name + ";" + rule.Value + ";" + string(rule.Comparison) + ";" + strconv.Itoa(rule.StartStep)
-		earlyStoppingRules = append(earlyStoppingRules, newRule)
+		earlyStoppingRules = append(earlyStoppingRules, mccommon.FormatStopRule(rule))
 	}
 	metricsCollectorConfigData, err := katibconfig.GetMetricsCollectorConfigData(mc.Collector.Kind, s.client)
 	if err != nil {
@@ -230,6 +273,11 @@ func (s *SidecarInjector) getMetricsCollectorContainer(trial *trialsv1beta1.Tria
 	if err != nil {
 		return nil, err
 	}
+	// Every worker of the distributed training reports the metrics with its name,
+	// so the metrics of the workers are aggregated by the Experiment's worker aggregation.
+	if worker := mccommon.GetWorkerName(originalPod.Labels); worker != "" {
+		args = append(args, "-worker", worker)
+	}
 
 	sidecarContainerName := getSidecarContainerName(trial.Spec.MetricsCollector.Collector.Kind)
 
@@ -241,6 +289,15 @@ func (s *SidecarInjector) getMetricsCollectorContainer(trial *trialsv1beta1.Tria
 		Resources:       metricsCollectorConfigData.Resource,
 	}
 
+	// Native sidecar is the init container which keeps running alongside the training containers.
+	// It's terminated once the training containers are completed, so the rest of the metrics are reported
+	// within the Pod's termination grace period.
+	if metricsCollectorConfigData.InjectionMode == configv1beta1.NativeSidecarInjectionMode {
+		restartPolicy := v1.ContainerRestartPolicyAlways
+		injectContainer.RestartPolicy = &restartPolicy
+		injectContainer.Args = append(injectContainer.Args, "-report-timeout", getNativeSidecarReportTimeout(originalPod, mc.Collector.Kind))
+	}
+
 	// Inject the security context when the flag is enabled.
 	if s.injectSecurityContext {
 		if len(originalPod.Spec.Containers) != 0 &&
@@ -307,17 +364,43 @@ func (s *SidecarInjector) getKatibJob(object *unstructured.Unstructured, namespa
 }
 
 func (s *SidecarInjector) getMetricsCollectorArgs(trial *trialsv1beta1.Trial, metricNames string, mc common.MetricsCollectorSpec, metricsCollectorConfigData configv1beta1.MetricsCollectorConfig, esRules []string) ([]string, error) {
-	args := []string{"-t", trial.Name, "-m", metricNames, "-o-type", string(trial.Spec.Objective.Type), "-s-db", katibmanagerv1beta1.GetDBManagerAddr()}
+	args := []string{"-t", trial.Name, "-t-ns", trial.Namespace, "-m", metricNames, "-o-type", string(trial.Spec.Objective.Type), "-s-db", katibmanagerv1beta1.GetDBManagerAddr()}
 	if mountPath, _ := getMountPath(mc); mountPath != "" {
 		args = append(args, "-path", mountPath)
 	}
-	if mc.Source != nil && mc.Source.Filter != nil && len(mc.Source.Filter.MetricsFormat) > 0 {
+	if mc.Collector.Kind != common.PrometheusMetricCollector && mc.Source != nil && mc.Source.Filter != nil && len(mc.Source.Filter.MetricsFormat) > 0 {
 		args = append(args, "-f", strings.Join(mc.Source.Filter.MetricsFormat, ";"))
 	}
+	if mc.Collector.Kind != common.PrometheusMetricCollector && mc.Source != nil && mc.Source.Filter != nil && mc.Source.Filter.TimestampFormat != "" {
+		args = append(args, "-timestamp-format", mc.Source.Filter.TimestampFormat)
+	}
+	if mc.Collector.Kind == common.PrometheusMetricCollector && mc.Source != nil && mc.Source.HttpGet != nil {
+		args = append(args, "-url", getPrometheusMetricsURL(mc.Source.HttpGet))
+		for _, h := range mc.Source.HttpGet.HTTPHeaders {
+			args = append(args, "-header", h.Name+": "+h.Value)
+		}
+		// For the Prometheus metrics collector filter contains the selectors of the metric series.
+		if mc.Source.Filter != nil {
+			for _, selector := range mc.Source.Filter.MetricsFormat {
+				args = append(args, "-selector", selector)
+			}
+		}
+	}
 	if mc.Collector.Kind == common.FileCollector && mc.Source != nil {
 		if mc.Source.FileSystemPath != nil {
 			args = append(args, "-format", string(mc.Source.FileSystemPath.Format))
 		}
+		if mc.Source.Filter != nil {
+			for _, selector := range mc.Source.Filter.MetricsSelectors {
+				args = append(args, "-selector", selector.Name+"="+selector.Path)
+			}
+			if mc.Source.Filter.TimestampColumn != "" {
+				args = append(args, "-timestamp-column", mc.Source.Filter.TimestampColumn)
+			}
+			if mc.Source.Filter.StepColumn != "" {
+				args = append(args, "-step-column", mc.Source.Filter.StepColumn)
+			}
+		}
 	}
 	if mc.Collector.Kind == common.StdOutCollector {
 		args = append(args, "-format", string(common.TextFormat))
@@ -325,6 +408,9 @@ func (s *SidecarInjector) getMetricsCollectorArgs(trial *trialsv1beta1.Trial, me
 	if metricsCollectorConfigData.WaitAllProcesses != nil {
 		args = append(args, "-w", strconv.FormatBool(*metricsCollectorConfigData.WaitAllProcesses))
 	}
+	if metricsCollectorConfigData.InjectionMode == configv1beta1.NativeSidecarInjectionMode {
+		args = append(args, "-native-sidecar")
+	}
 	// Add stop rules and service endpoint for Early Stopping
 	if len(esRules) > 0 {
 		for _, rule := range esRules {
@@ -34,6 +34,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -49,6 +50,7 @@ import (
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
 	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/grpctls"
 )
 
 var (
@@ -77,12 +79,13 @@ func TestWrapWorkerContainer(t *testing.T) {
 	metricsFile := "metric.log"
 
 	cases := map[string]struct {
-		trial       *trialsv1beta1.Trial
-		pod         *v1.Pod
-		metricsFile string
-		pathKind    common.FileSystemKind
-		wantPod     *v1.Pod
-		wantError   error
+		trial         *trialsv1beta1.Trial
+		pod           *v1.Pod
+		metricsFile   string
+		pathKind      common.FileSystemKind
+		nativeSidecar bool
+		wantPod       *v1.Pod
+		wantError     error
 	}{
 		"Tensorflow container without sh -c": {
 			trial: trial,
@@ -218,11 +221,55 @@ func TestWrapWorkerContainer(t *testing.T) {
 				},
 			},
 		},
+		"Native sidecar container writes completed marker to metrics volume": {
+			trial: func() *trialsv1beta1.Trial {
+				t := trial.DeepCopy()
+				t.Spec.MetricsCollector.Collector.Kind = common.FileCollector
+				t.Spec.EarlyStoppingRules = []common.EarlyStoppingRule{
+					{
+						Name:       "accuracy",
+						Value:      "0.6",
+						Comparison: common.ComparisonTypeLess,
+					},
+				}
+				return t
+			}(),
+			pod: &v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name: primaryContainer,
+							Command: []string{
+								"python main.py",
+							},
+						},
+					},
+				},
+			},
+			metricsFile:   common.DefaultFilePath,
+			pathKind:      common.FileKind,
+			nativeSidecar: true,
+			wantPod: &v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name: primaryContainer,
+							Command: []string{
+								"sh", "-c",
+							},
+							Args: []string{
+								"python main.py && echo completed > /var/log/katib/$$$$.pid",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			err := wrapWorkerContainer(tc.trial, tc.pod, tc.trial.Namespace, tc.metricsFile, tc.pathKind)
+			err := wrapWorkerContainer(tc.trial, tc.pod, tc.trial.Namespace, tc.metricsFile, tc.pathKind, tc.nativeSidecar)
 			if diff := cmp.Diff(tc.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
 				t.Errorf("Unexpected error from wrapWorkerContainer (-want,+got):\n%s", diff)
 			}
@@ -339,6 +386,7 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 			},
 			wantArgs: []string{
 				"-t", testTrialName,
+				"-t-ns", testNamespace,
 				"-m", testMetricName,
 				"-o-type", string(testObjective),
 				"-s-db", katibDBAddress,
@@ -347,6 +395,59 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				"-w", "false",
 			},
 		},
+		"StdOut MC with Timestamp Format": {
+			trial:       testTrial,
+			metricNames: testMetricName,
+			mCSpec: common.MetricsCollectorSpec{
+				Collector: &common.CollectorSpec{
+					Kind: common.StdOutCollector,
+				},
+				Source: &common.SourceSpec{
+					Filter: &common.FilterSpec{
+						TimestampFormat: "Python",
+					},
+				},
+			},
+			katibConfig: configv1beta1.MetricsCollectorConfig{},
+			wantArgs: []string{
+				"-t", testTrialName,
+				"-t-ns", testNamespace,
+				"-m", testMetricName,
+				"-o-type", string(testObjective),
+				"-s-db", katibDBAddress,
+				"-path", common.DefaultFilePath,
+				"-timestamp-format", "Python",
+				"-format", string(common.TextFormat),
+			},
+		},
+		"File MC injected as native sidecar": {
+			trial:       testTrial,
+			metricNames: testMetricName,
+			mCSpec: common.MetricsCollectorSpec{
+				Collector: &common.CollectorSpec{
+					Kind: common.FileCollector,
+				},
+				Source: &common.SourceSpec{
+					FileSystemPath: &common.FileSystemPath{
+						Path:   testPath,
+						Format: common.TextFormat,
+					},
+				},
+			},
+			katibConfig: configv1beta1.MetricsCollectorConfig{
+				InjectionMode: configv1beta1.NativeSidecarInjectionMode,
+			},
+			wantArgs: []string{
+				"-t", testTrialName,
+				"-t-ns", testNamespace,
+				"-m", testMetricName,
+				"-o-type", string(testObjective),
+				"-s-db", katibDBAddress,
+				"-path", testPath,
+				"-format", string(common.TextFormat),
+				"-native-sidecar",
+			},
+		},
 		"File MC with Filter": {
 			trial:       testTrial,
 			metricNames: testMetricName,
@@ -370,6 +471,7 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 			katibConfig: configv1beta1.MetricsCollectorConfig{},
 			wantArgs: []string{
 				"-t", testTrialName,
+				"-t-ns", testNamespace,
 				"-m", testMetricName,
 				"-o-type", string(testObjective),
 				"-s-db", katibDBAddress,
@@ -395,6 +497,7 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 			katibConfig: configv1beta1.MetricsCollectorConfig{},
 			wantArgs: []string{
 				"-t", testTrialName,
+				"-t-ns", testNamespace,
 				"-m", testMetricName,
 				"-o-type", string(testObjective),
 				"-s-db", katibDBAddress,
@@ -402,6 +505,72 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				"-format", string(common.JsonFormat),
 			},
 		},
+		"File MC with Json Format and Selectors": {
+			trial:       testTrial,
+			metricNames: testMetricName,
+			mCSpec: common.MetricsCollectorSpec{
+				Collector: &common.CollectorSpec{
+					Kind: common.FileCollector,
+				},
+				Source: &common.SourceSpec{
+					FileSystemPath: &common.FileSystemPath{
+						Path:   testPath,
+						Format: common.JsonFormat,
+					},
+					Filter: &common.FilterSpec{
+						MetricsSelectors: []common.MetricSelector{
+							{Name: testMetricName, Path: "$.eval.acc"},
+						},
+					},
+				},
+			},
+			katibConfig: configv1beta1.MetricsCollectorConfig{},
+			wantArgs: []string{
+				"-t", testTrialName,
+				"-t-ns", testNamespace,
+				"-m", testMetricName,
+				"-o-type", string(testObjective),
+				"-s-db", katibDBAddress,
+				"-path", testPath,
+				"-format", string(common.JsonFormat),
+				"-selector", testMetricName + "=$.eval.acc",
+			},
+		},
+		"File MC with CSV Format and Columns": {
+			trial:       testTrial,
+			metricNames: testMetricName,
+			mCSpec: common.MetricsCollectorSpec{
+				Collector: &common.CollectorSpec{
+					Kind: common.FileCollector,
+				},
+				Source: &common.SourceSpec{
+					FileSystemPath: &common.FileSystemPath{
+						Path:   testPath,
+						Format: common.CsvFormat,
+					},
+					Filter: &common.FilterSpec{
+						MetricsSelectors: []common.MetricSelector{
+							{Name: testMetricName, Path: "val_accuracy"},
+						},
+						TimestampColumn: "wall_time",
+						StepColumn:      "global_step",
+					},
+				},
+			},
+			katibConfig: configv1beta1.MetricsCollectorConfig{},
+			wantArgs: []string{
+				"-t", testTrialName,
+				"-t-ns", testNamespace,
+				"-m", testMetricName,
+				"-o-type", string(testObjective),
+				"-s-db", katibDBAddress,
+				"-path", testPath,
+				"-format", string(common.CsvFormat),
+				"-selector", testMetricName + "=val_accuracy",
+				"-timestamp-column", "wall_time",
+				"-step-column", "global_step",
+			},
+		},
 		"Tf Event MC": {
 			trial:       testTrial,
 			metricNames: testMetricName,
@@ -418,6 +587,7 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 			katibConfig: configv1beta1.MetricsCollectorConfig{},
 			wantArgs: []string{
 				"-t", testTrialName,
+				"-t-ns", testNamespace,
 				"-m", testMetricName,
 				"-o-type", string(testObjective),
 				"-s-db", katibDBAddress,
@@ -435,6 +605,7 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 			katibConfig: configv1beta1.MetricsCollectorConfig{},
 			wantArgs: []string{
 				"-t", testTrialName,
+				"-t-ns", testNamespace,
 				"-m", testMetricName,
 				"-o-type", string(testObjective),
 				"-s-db", katibDBAddress,
@@ -456,26 +627,46 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 			katibConfig: configv1beta1.MetricsCollectorConfig{},
 			wantArgs: []string{
 				"-t", testTrialName,
+				"-t-ns", testNamespace,
 				"-m", testMetricName,
 				"-o-type", string(testObjective),
 				"-s-db", katibDBAddress,
 				"-path", testPath,
 			},
 		},
-		"Prometheus MC without Path": {
+		"Prometheus MC": {
 			trial:       testTrial,
 			metricNames: testMetricName,
 			mCSpec: common.MetricsCollectorSpec{
 				Collector: &common.CollectorSpec{
 					Kind: common.PrometheusMetricCollector,
 				},
+				Source: &common.SourceSpec{
+					HttpGet: &v1.HTTPGetAction{
+						Path: common.DefaultPrometheusPath,
+						Port: intstr.FromInt(common.DefaultPrometheusPort),
+						HTTPHeaders: []v1.HTTPHeader{
+							{Name: "Authorization", Value: "Bearer token"},
+						},
+					},
+					Filter: &common.FilterSpec{
+						MetricsFormat: []string{
+							`accuracy{phase="validation"}`,
+						},
+					},
+				},
 			},
 			katibConfig: configv1beta1.MetricsCollectorConfig{},
 			wantArgs: []string{
 				"-t", testTrialName,
+				"-t-ns", testNamespace,
 				"-m", testMetricName,
 				"-o-type", string(testObjective),
 				"-s-db", katibDBAddress,
+				"-path", "/var/log/katib",
+				"-url", "http://localhost:8080/metrics",
+				"-header", "Authorization: Bearer token",
+				"-selector", `accuracy{phase="validation"}`,
 			},
 		},
 		"Trial with EarlyStopping rules": {
@@ -490,6 +681,7 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 			katibConfig:        configv1beta1.MetricsCollectorConfig{},
 			wantArgs: []string{
 				"-t", testTrialName,
+				"-t-ns", testNamespace,
 				"-m", testMetricName,
 				"-o-type", string(testObjective),
 				"-s-db", katibDBAddress,
@@ -550,6 +742,14 @@ func TestNeedWrapWorkerContainer(t *testing.T) {
 			},
 			needWrap: true,
 		},
+		"Prometheus metrics collector needs wrap": {
+			mCSpec: common.MetricsCollectorSpec{
+				Collector: &common.CollectorSpec{
+					Kind: common.PrometheusMetricCollector,
+				},
+			},
+			needWrap: true,
+		},
 		"Valid case with needWrap false": {
 			mCSpec: common.MetricsCollectorSpec{
 				Collector: &common.CollectorSpec{
@@ -570,6 +770,113 @@ func TestNeedWrapWorkerContainer(t *testing.T) {
 	}
 }
 
+func TestGetNativeSidecarReportTimeout(t *testing.T) {
+	longGracePeriod := int64(120)
+	shortGracePeriod := int64(6)
+	testCases := map[string]struct {
+		gracePeriodSeconds *int64
+		cKind              common.CollectorKind
+		want               string
+	}{
+		"Default grace period": {
+			cKind: common.FileCollector,
+			want:  "25s",
+		},
+		"Grace period is set": {
+			gracePeriodSeconds: &longGracePeriod,
+			cKind:              common.PrometheusMetricCollector,
+			want:               "1m55s",
+		},
+		"Short grace period": {
+			gracePeriodSeconds: &shortGracePeriod,
+			cKind:              common.FileCollector,
+			want:               "3s",
+		},
+		"TfEvent MC accepts seconds": {
+			cKind: common.TfEventCollector,
+			want:  "25",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			pod := &corev1.Pod{
+				Spec: corev1.PodSpec{
+					TerminationGracePeriodSeconds: tc.gracePeriodSeconds,
+				},
+			}
+			if got := getNativeSidecarReportTimeout(pod, tc.cKind); got != tc.want {
+				t.Errorf("Unexpected report timeout, want: %v, got: %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestMoveToInitContainers(t *testing.T) {
+	restartPolicy := corev1.ContainerRestartPolicyAlways
+	sidecar := corev1.Container{
+		Name:          mccommon.MetricLoggerCollectorContainerName,
+		RestartPolicy: &restartPolicy,
+	}
+	testCases := map[string]struct {
+		pod       *corev1.Pod
+		wantPod   *corev1.Pod
+		wantError error
+	}{
+		"Sidecar is moved to the end of init containers": {
+			pod: &corev1.Pod{
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{
+						{Name: "init-container"},
+					},
+					Containers: []corev1.Container{
+						{Name: "training-container"},
+						sidecar,
+					},
+				},
+			},
+			wantPod: &corev1.Pod{
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{
+						{Name: "init-container"},
+						sidecar,
+					},
+					Containers: []corev1.Container{
+						{Name: "training-container"},
+					},
+				},
+			},
+		},
+		"Sidecar is not found": {
+			pod: &corev1.Pod{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "training-container"},
+					},
+				},
+			},
+			wantError: errContainerNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := moveToInitContainers(tc.pod, sidecar.Name)
+			if diff := cmp.Diff(tc.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+			if err == nil {
+				if diff := cmp.Diff(tc.wantPod, tc.pod); len(diff) != 0 {
+					t.Errorf("Unexpected pod (-want,+got):\n%s", diff)
+				}
+				if !isNativeSidecar(&tc.pod.Spec.InitContainers[len(tc.pod.Spec.InitContainers)-1]) {
+					t.Errorf("Init container is not native sidecar")
+				}
+			}
+		})
+	}
+}
+
 func TestMutateMetricsCollectorVolume(t *testing.T) {
 	testCases := map[string]struct {
 		pod                  v1.Pod
@@ -1157,3 +1464,135 @@ func TestMutatePodEnv(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPrometheusMetricsURL(t *testing.T) {
+	testCases := map[string]struct {
+		httpGet *v1.HTTPGetAction
+		wantURL string
+	}{
+		"Default scheme and host": {
+			httpGet: &v1.HTTPGetAction{
+				Path: "/metrics",
+				Port: intstr.FromInt(8080),
+			},
+			wantURL: "http://localhost:8080/metrics",
+		},
+		"HTTPS scheme and custom host": {
+			httpGet: &v1.HTTPGetAction{
+				Path:   "/custom/metrics",
+				Port:   intstr.FromInt(9090),
+				Host:   "127.0.0.1",
+				Scheme: v1.URISchemeHTTPS,
+			},
+			wantURL: "https://127.0.0.1:9090/custom/metrics",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := getPrometheusMetricsURL(tc.httpGet); got != tc.wantURL {
+				t.Errorf("Expected URL %v, got %v", tc.wantURL, got)
+			}
+		})
+	}
+}
+
+func TestMutateGRPCCertVolume(t *testing.T) {
+	certVolume := v1.Volume{
+		Name: consts.GRPCCertVolumeName,
+		VolumeSource: v1.VolumeSource{
+			Secret: &v1.SecretVolumeSource{
+				SecretName: consts.GRPCNamespaceCertSecretName,
+			},
+		},
+	}
+	mutatedContainer := v1.Container{
+		Name: mccommon.MetricLoggerCollectorContainerName,
+		VolumeMounts: []v1.VolumeMount{
+			{
+				Name:      consts.GRPCCertVolumeName,
+				MountPath: consts.GRPCCertDir,
+				ReadOnly:  true,
+			},
+		},
+		Env: []v1.EnvVar{
+			{
+				Name:  grpctls.CertDirEnvName,
+				Value: consts.GRPCCertDir,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		pod           *v1.Pod
+		containerName string
+		mutatedPod    *v1.Pod
+		wantError     error
+	}{
+		"Mount certs to the metrics collector container": {
+			pod: &v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{Name: "training-container"},
+						{Name: mccommon.MetricLoggerCollectorContainerName},
+					},
+				},
+			},
+			containerName: mccommon.MetricLoggerCollectorContainerName,
+			mutatedPod: &v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{Name: "training-container"},
+						mutatedContainer,
+					},
+					Volumes: []v1.Volume{certVolume},
+				},
+			},
+		},
+		"Certs are already mounted": {
+			pod: &v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{*mutatedContainer.DeepCopy()},
+					Volumes:    []v1.Volume{*certVolume.DeepCopy()},
+				},
+			},
+			containerName: mccommon.MetricLoggerCollectorContainerName,
+			mutatedPod: &v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{mutatedContainer},
+					Volumes:    []v1.Volume{certVolume},
+				},
+			},
+		},
+		"Container doesn't exist": {
+			pod: &v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{Name: "training-container"},
+					},
+				},
+			},
+			containerName: mccommon.MetricLoggerCollectorContainerName,
+			mutatedPod: &v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{Name: "training-container"},
+					},
+				},
+			},
+			wantError: errContainerNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := mutateGRPCCertVolume(tc.pod, tc.containerName, consts.GRPCNamespaceCertSecretName)
+			if diff := cmp.Diff(tc.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.mutatedPod, tc.pod); len(diff) != 0 {
+				t.Errorf("Unexpected mutated result (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
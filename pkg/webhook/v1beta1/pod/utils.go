@@ -20,8 +20,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
+	"net/url"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/authn/k8schain"
@@ -33,10 +37,14 @@ import (
 	common "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
 	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
 )
 
-var errPrimaryContainerNotFound = errors.New("unable to find primary container in mutated pod containers")
+var (
+	errPrimaryContainerNotFound = errors.New("unable to find primary container in mutated pod containers")
+	errContainerNotFound        = errors.New("unable to find container in mutated pod containers")
+)
 
 func isPrimaryPod(podLabels, primaryLabels map[string]string) bool {
 
@@ -132,6 +140,9 @@ func getMountPath(mc common.MetricsCollectorSpec) (string, common.FileSystemKind
 		return mc.Source.FileSystemPath.Path, common.FileKind
 	} else if mc.Collector.Kind == common.TfEventCollector {
 		return mc.Source.FileSystemPath.Path, common.DirectoryKind
+	} else if mc.Collector.Kind == common.PrometheusMetricCollector {
+		// Prometheus metrics collector shares the directory only for the completed marker of the main process.
+		return filepath.Dir(common.DefaultFilePath), common.DirectoryKind
 	} else if mc.Collector.Kind == common.CustomCollector {
 		if mc.Source == nil || mc.Source.FileSystemPath == nil {
 			return "", common.InvalidKind
@@ -142,6 +153,25 @@ func getMountPath(mc common.MetricsCollectorSpec) (string, common.FileSystemKind
 	}
 }
 
+// getPrometheusMetricsURL returns the URL of the metrics endpoint in the Pod.
+// Host defaults to localhost since the metrics collector shares the network with the worker.
+func getPrometheusMetricsURL(httpGet *v1.HTTPGetAction) string {
+	scheme := strings.ToLower(string(httpGet.Scheme))
+	if scheme == "" {
+		scheme = "http"
+	}
+	host := httpGet.Host
+	if host == "" {
+		host = "localhost"
+	}
+	u := url.URL{
+		Scheme: scheme,
+		Host:   net.JoinHostPort(host, httpGet.Port.String()),
+		Path:   httpGet.Path,
+	}
+	return u.String()
+}
+
 func needWrapWorkerContainer(mc common.MetricsCollectorSpec) bool {
 	mcKind := mc.Collector.Kind
 	for _, kind := range NeedWrapWorkerMetricsCollectorList {
@@ -153,7 +183,7 @@ func needWrapWorkerContainer(mc common.MetricsCollectorSpec) bool {
 }
 
 func wrapWorkerContainer(trial *trialsv1beta1.Trial, pod *v1.Pod, namespace,
-	metricsFile string, pathKind common.FileSystemKind) error {
+	metricsFile string, pathKind common.FileSystemKind, nativeSidecar bool) error {
 	// Search for primary container.
 	index := getPrimaryContainerIndex(pod.Spec.Containers, trial.Spec.PrimaryContainerName)
 	if index >= 0 {
@@ -181,8 +211,9 @@ func wrapWorkerContainer(trial *trialsv1beta1.Trial, pod *v1.Pod, namespace,
 			metricsFileDir = filepath.Dir(metricsFile)
 		}
 
-		// If early stopping is set add appropriate command
-		if trial.Spec.EarlyStoppingRules != nil {
+		// If early stopping is set add appropriate command.
+		// Native sidecar can't stop the training process, the early stopped Trial's job is deleted instead.
+		if trial.Spec.EarlyStoppingRules != nil && !nativeSidecar {
 			args = append(args, "||", getEarlyStoppingCommand(metricsFileDir, pathKind))
 		}
 		// Add completed command to run without early stopping
@@ -220,6 +251,38 @@ func getMarkCompletedCommand(metricsFileDir string, pathKind common.FileSystemKi
 	return fmt.Sprintf("echo %s > %s", mccommon.TrainingCompleted, pidFile)
 }
 
+// isNativeSidecar returns true if the container is the Kubernetes native sidecar.
+func isNativeSidecar(c *v1.Container) bool {
+	return c.RestartPolicy != nil && *c.RestartPolicy == v1.ContainerRestartPolicyAlways
+}
+
+// getNativeSidecarReportTimeout returns the report timeout of the native sidecar metrics collector,
+// so the rest of the metrics are reported before the sidecar is killed at the end of the Pod's termination grace period.
+// TfEvent metrics collector accepts the timeout in seconds, the others accept the duration.
+func getNativeSidecarReportTimeout(pod *v1.Pod, cKind common.CollectorKind) string {
+	gracePeriod := time.Duration(v1.DefaultTerminationGracePeriodSeconds) * time.Second
+	if pod.Spec.TerminationGracePeriodSeconds != nil {
+		gracePeriod = time.Duration(*pod.Spec.TerminationGracePeriodSeconds) * time.Second
+	}
+	// The margin is left for the metrics collector to exit, but at least half of the grace period is used for the report.
+	reportTimeout := max(gracePeriod-nativeSidecarExitMargin, gracePeriod/2).Truncate(time.Second)
+	if cKind == common.TfEventCollector {
+		return strconv.Itoa(int(reportTimeout.Seconds()))
+	}
+	return reportTimeout.String()
+}
+
+// moveToInitContainers moves the container to the end of the pod init containers.
+func moveToInitContainers(pod *v1.Pod, containerName string) error {
+	index := getPrimaryContainerIndex(pod.Spec.Containers, containerName)
+	if index < 0 {
+		return fmt.Errorf("%w: container: %v, mutated pod containers: %v", errContainerNotFound, containerName, pod.Spec.Containers)
+	}
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, pod.Spec.Containers[index])
+	pod.Spec.Containers = append(pod.Spec.Containers[:index], pod.Spec.Containers[index+1:]...)
+	return nil
+}
+
 func addContainerVolumeMount(c *v1.Container, vm *v1.VolumeMount) {
 	if c.VolumeMounts == nil {
 		c.VolumeMounts = make([]v1.VolumeMount, 0)
@@ -263,6 +326,23 @@ func mutateMetricsCollectorVolume(pod *v1.Pod, mountPath, sidecarContainerName,
 	return nil
 }
 
+// mutateGRPCCertVolume mounts the certs for mutual TLS between the Katib gRPC services
+// to the container which sends requests to the DB manager or the Early Stopping service.
+func mutateGRPCCertVolume(pod *v1.Pod, containerName, secretName string) error {
+	index := getPrimaryContainerIndex(pod.Spec.Containers, containerName)
+	if index < 0 {
+		return fmt.Errorf("%w: container: %v, mutated pod containers: %v", errContainerNotFound, containerName, pod.Spec.Containers)
+	}
+	util.AddGRPCCertToContainer(&pod.Spec.Containers[index])
+	for _, vol := range pod.Spec.Volumes {
+		if vol.Name == consts.GRPCCertVolumeName {
+			return nil
+		}
+	}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, util.GRPCCertVolume(secretName))
+	return nil
+}
+
 func mutatePodMetadata(pod *v1.Pod, trial *trialsv1beta1.Trial) {
 	podLabels := map[string]string{}
 
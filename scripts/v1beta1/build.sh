@@ -72,6 +72,9 @@ docker buildx build --platform "${ARCH}" -t "${REGISTRY}/katib-ui:${TAG}" -f ${C
 echo -e "\nBuilding file metrics collector image...\n"
 docker buildx build --platform "${ARCH}" -t "${REGISTRY}/file-metrics-collector:${TAG}" -f ${CMD_PREFIX}/metricscollector/${VERSION}/file-metricscollector/Dockerfile .
 
+echo -e "\nBuilding Prometheus metrics collector image...\n"
+docker buildx build --platform "${ARCH}" -t "${REGISTRY}/prometheus-metrics-collector:${TAG}" -f ${CMD_PREFIX}/metricscollector/${VERSION}/prometheus-metricscollector/Dockerfile .
+
 echo -e "\nBuilding TF Event metrics collector image...\n"
 if [ "${ARCH}" == "ppc64le" ]; then
   docker buildx build --platform "${ARCH}" -t "${REGISTRY}/tfevent-metrics-collector:${TAG}" -f ${CMD_PREFIX}/metricscollector/${VERSION}/tfevent-metricscollector/Dockerfile.ppc64le .
@@ -23,11 +23,13 @@ cd "${SCRIPT_ROOT}"
 
 WITH_DATABASE_TYPE=${1:-mysql}
 
-# if mysql, use below kustomize, else use postgres
+# if mysql, use below kustomize, else use postgres or sqlite
 if [ "$WITH_DATABASE_TYPE" == "mysql" ]; then
     kustomize build manifests/v1beta1/installs/katib-standalone | kubectl apply -f -
 elif [ "$WITH_DATABASE_TYPE" == "postgres" ]; then
     kustomize build manifests/v1beta1/installs/katib-standalone-postgres | kubectl apply -f -
+elif [ "$WITH_DATABASE_TYPE" == "sqlite" ]; then
+    kustomize build manifests/v1beta1/installs/katib-standalone-sqlite | kubectl apply -f -
 else
     echo "Unknown database type: $WITH_DATABASE_TYPE"
     exit 1
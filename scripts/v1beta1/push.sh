@@ -47,6 +47,9 @@ docker push "${REGISTRY}/katib-ui:${TAG}"
 echo -e "\nPushing file metrics collector image...\n"
 docker push "${REGISTRY}/file-metrics-collector:${TAG}"
 
+echo -e "\nPushing Prometheus metrics collector image...\n"
+docker push "${REGISTRY}/prometheus-metrics-collector:${TAG}"
+
 echo -e "\nPushing TF Event metrics collector image...\n"
 docker push "${REGISTRY}/tfevent-metrics-collector:${TAG}"
 
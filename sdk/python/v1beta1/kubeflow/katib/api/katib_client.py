@@ -18,7 +18,6 @@ import multiprocessing
 import time
 from typing import Any, Callable, Dict, List, Optional, Union
 
-import grpc
 import kubeflow.katib.katib_api_pb2 as katib_api_pb2
 import kubeflow.katib.katib_api_pb2_grpc as katib_api_pb2_grpc
 from kubeflow.katib import models
@@ -1577,13 +1576,15 @@ class KatibClient(object):
 
         namespace = namespace or self.namespace
 
-        channel = grpc.insecure_channel(db_manager_address)
+        channel = utils.get_grpc_channel(db_manager_address)
 
         client = katib_api_pb2_grpc.DBManagerStub(channel)
         try:
             # When metric name is empty, we select all logs from the Katib DB.
             observation_logs = client.GetObservationLog(
-                katib_api_pb2.GetObservationLogRequest(trial_name=name),
+                katib_api_pb2.GetObservationLogRequest(
+                    trial_name=name, namespace=namespace
+                ),
                 timeout=timeout,
             )
         except Exception as e:
@@ -16,7 +16,6 @@ import os
 from datetime import datetime, timezone
 from typing import Any, Dict
 
-import grpc
 import kubeflow.katib.katib_api_pb2 as katib_api_pb2
 import kubeflow.katib.katib_api_pb2_grpc as katib_api_pb2_grpc
 from kubeflow.katib.constants import constants
@@ -51,7 +50,7 @@ def report_metrics(
         raise ValueError("The Trial name is not passed to environment variables")
 
     # Get channel for grpc call to db manager
-    channel = grpc.insecure_channel(db_manager_address)
+    channel = utils.get_grpc_channel(db_manager_address)
 
     # Validate metrics value in dict
     for value in metrics.values():
@@ -64,6 +63,7 @@ def report_metrics(
         client.ReportObservationLog(
             request=katib_api_pb2.ReportObservationLogRequest(
                 trial_name=name,
+                namespace=namespace,
                 observation_log=katib_api_pb2.ObservationLog(
                     metric_logs=[
                         katib_api_pb2.MetricLog(
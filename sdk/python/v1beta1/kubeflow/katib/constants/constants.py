@@ -61,6 +61,12 @@ BASE_IMAGE_MXNET = "docker.io/mxnet/python:1.9.1_native_py3"
 
 DEFAULT_DB_MANAGER_ADDRESS = "katib-db-manager.kubeflow:6789"
 
+# Env variable with the directory of the certs for mutual TLS with the Katib gRPC services.
+# Katib sets it in the Trial's primary container when mutual TLS is enabled.
+GRPC_TLS_CERT_DIR_ENV = "KATIB_GRPC_TLS_CERT_DIR"
+# DNS name in the certificate of the Katib DB manager.
+GRPC_TLS_SERVER_NAME = "katib-grpc"
+
 # The default value for dataset and model storage PVC.
 PVC_DEFAULT_SIZE = "10Gi"
 # The default value for PVC access modes.
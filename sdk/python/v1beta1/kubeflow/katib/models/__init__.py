@@ -14,6 +14,7 @@
 from __future__ import absolute_import
 
 # import models into model package
+from kubeflow.katib.models.v1beta1_additional_objective import V1beta1AdditionalObjective
 from kubeflow.katib.models.v1beta1_algorithm_setting import V1beta1AlgorithmSetting
 from kubeflow.katib.models.v1beta1_algorithm_spec import V1beta1AlgorithmSpec
 from kubeflow.katib.models.v1beta1_collector_spec import V1beta1CollectorSpec
@@ -31,15 +32,18 @@ from kubeflow.katib.models.v1beta1_file_system_path import V1beta1FileSystemPath
 from kubeflow.katib.models.v1beta1_filter_spec import V1beta1FilterSpec
 from kubeflow.katib.models.v1beta1_graph_config import V1beta1GraphConfig
 from kubeflow.katib.models.v1beta1_metric import V1beta1Metric
+from kubeflow.katib.models.v1beta1_metric_selector import V1beta1MetricSelector
 from kubeflow.katib.models.v1beta1_metric_strategy import V1beta1MetricStrategy
 from kubeflow.katib.models.v1beta1_metrics_collector_spec import V1beta1MetricsCollectorSpec
 from kubeflow.katib.models.v1beta1_nas_config import V1beta1NasConfig
+from kubeflow.katib.models.v1beta1_objective_constraint import V1beta1ObjectiveConstraint
 from kubeflow.katib.models.v1beta1_objective_spec import V1beta1ObjectiveSpec
 from kubeflow.katib.models.v1beta1_observation import V1beta1Observation
 from kubeflow.katib.models.v1beta1_operation import V1beta1Operation
 from kubeflow.katib.models.v1beta1_optimal_trial import V1beta1OptimalTrial
 from kubeflow.katib.models.v1beta1_parameter_assignment import V1beta1ParameterAssignment
 from kubeflow.katib.models.v1beta1_parameter_spec import V1beta1ParameterSpec
+from kubeflow.katib.models.v1beta1_retry_policy import V1beta1RetryPolicy
 from kubeflow.katib.models.v1beta1_source_spec import V1beta1SourceSpec
 from kubeflow.katib.models.v1beta1_suggestion import V1beta1Suggestion
 from kubeflow.katib.models.v1beta1_suggestion_condition import V1beta1SuggestionCondition
@@ -48,6 +52,7 @@ from kubeflow.katib.models.v1beta1_suggestion_spec import V1beta1SuggestionSpec
 from kubeflow.katib.models.v1beta1_suggestion_status import V1beta1SuggestionStatus
 from kubeflow.katib.models.v1beta1_trial import V1beta1Trial
 from kubeflow.katib.models.v1beta1_trial_assignment import V1beta1TrialAssignment
+from kubeflow.katib.models.v1beta1_trial_attempt import V1beta1TrialAttempt
 from kubeflow.katib.models.v1beta1_trial_condition import V1beta1TrialCondition
 from kubeflow.katib.models.v1beta1_trial_list import V1beta1TrialList
 from kubeflow.katib.models.v1beta1_trial_parameter_spec import V1beta1TrialParameterSpec
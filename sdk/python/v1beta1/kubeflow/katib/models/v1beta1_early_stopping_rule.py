@@ -34,36 +34,51 @@ class V1beta1EarlyStoppingRule(object):
     """
     openapi_types = {
         'comparison': 'str',
+        'group': 'str',
         'name': 'str',
+        'patience': 'int',
         'start_step': 'int',
+        'type': 'str',
         'value': 'str'
     }
 
     attribute_map = {
         'comparison': 'comparison',
+        'group': 'group',
         'name': 'name',
+        'patience': 'patience',
         'start_step': 'startStep',
+        'type': 'type',
         'value': 'value'
     }
 
-    def __init__(self, comparison=None, name=None, start_step=None, value=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, comparison=None, group=None, name=None, patience=None, start_step=None, type=None, value=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1EarlyStoppingRule - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
         self.local_vars_configuration = local_vars_configuration
 
         self._comparison = None
+        self._group = None
         self._name = None
+        self._patience = None
         self._start_step = None
+        self._type = None
         self._value = None
         self.discriminator = None
 
         if comparison is not None:
             self.comparison = comparison
+        if group is not None:
+            self.group = group
         if name is not None:
             self.name = name
+        if patience is not None:
+            self.patience = patience
         if start_step is not None:
             self.start_step = start_step
+        if type is not None:
+            self.type = type
         if value is not None:
             self.value = value
 
@@ -71,7 +86,7 @@ class V1beta1EarlyStoppingRule(object):
     def comparison(self):
         """Gets the comparison of this V1beta1EarlyStoppingRule.  # noqa: E501
 
-        Comparison defines correlation between name and value.  # noqa: E501
+        Comparison defines correlation between name and value. For the patience rule, comparison defines the direction of the improvement, if it is not set, the objective metric improves in the objective type direction.  # noqa: E501
 
         :return: The comparison of this V1beta1EarlyStoppingRule.  # noqa: E501
         :rtype: str
@@ -82,7 +97,7 @@ class V1beta1EarlyStoppingRule(object):
     def comparison(self, comparison):
         """Sets the comparison of this V1beta1EarlyStoppingRule.
 
-        Comparison defines correlation between name and value.  # noqa: E501
+        Comparison defines correlation between name and value. For the patience rule, comparison defines the direction of the improvement, if it is not set, the objective metric improves in the objective type direction.  # noqa: E501
 
         :param comparison: The comparison of this V1beta1EarlyStoppingRule.  # noqa: E501
         :type: str
@@ -90,11 +105,34 @@ class V1beta1EarlyStoppingRule(object):
 
         self._comparison = comparison
 
+    @property
+    def group(self):
+        """Gets the group of this V1beta1EarlyStoppingRule.  # noqa: E501
+
+        Group of the rule. Rules in the same group are reached together, and training is early stopped once all rules in any group are reached. Rules without group belong to the same default group.  # noqa: E501
+
+        :return: The group of this V1beta1EarlyStoppingRule.  # noqa: E501
+        :rtype: str
+        """
+        return self._group
+
+    @group.setter
+    def group(self, group):
+        """Sets the group of this V1beta1EarlyStoppingRule.
+
+        Group of the rule. Rules in the same group are reached together, and training is early stopped once all rules in any group are reached. Rules without group belong to the same default group.  # noqa: E501
+
+        :param group: The group of this V1beta1EarlyStoppingRule.  # noqa: E501
+        :type: str
+        """
+
+        self._group = group
+
     @property
     def name(self):
         """Gets the name of this V1beta1EarlyStoppingRule.  # noqa: E501
 
-        Name contains metric name for the rule.  # noqa: E501
+        Name contains metric name for the rule. Rule can be set for the objective or any additional metric.  # noqa: E501
 
         :return: The name of this V1beta1EarlyStoppingRule.  # noqa: E501
         :rtype: str
@@ -105,7 +143,7 @@ class V1beta1EarlyStoppingRule(object):
     def name(self, name):
         """Sets the name of this V1beta1EarlyStoppingRule.
 
-        Name contains metric name for the rule.  # noqa: E501
+        Name contains metric name for the rule. Rule can be set for the objective or any additional metric.  # noqa: E501
 
         :param name: The name of this V1beta1EarlyStoppingRule.  # noqa: E501
         :type: str
@@ -113,6 +151,29 @@ class V1beta1EarlyStoppingRule(object):
 
         self._name = name
 
+    @property
+    def patience(self):
+        """Gets the patience of this V1beta1EarlyStoppingRule.  # noqa: E501
+
+        Patience is the number of the reported metric values without improvement after which the patience rule is reached.  # noqa: E501
+
+        :return: The patience of this V1beta1EarlyStoppingRule.  # noqa: E501
+        :rtype: int
+        """
+        return self._patience
+
+    @patience.setter
+    def patience(self, patience):
+        """Sets the patience of this V1beta1EarlyStoppingRule.
+
+        Patience is the number of the reported metric values without improvement after which the patience rule is reached.  # noqa: E501
+
+        :param patience: The patience of this V1beta1EarlyStoppingRule.  # noqa: E501
+        :type: int
+        """
+
+        self._patience = patience
+
     @property
     def start_step(self):
         """Gets the start_step of this V1beta1EarlyStoppingRule.  # noqa: E501
@@ -136,11 +197,34 @@ class V1beta1EarlyStoppingRule(object):
 
         self._start_step = start_step
 
+    @property
+    def type(self):
+        """Gets the type of this V1beta1EarlyStoppingRule.  # noqa: E501
+
+        Type of the rule, one of threshold, patience or divergence. Defaults to threshold.  # noqa: E501
+
+        :return: The type of this V1beta1EarlyStoppingRule.  # noqa: E501
+        :rtype: str
+        """
+        return self._type
+
+    @type.setter
+    def type(self, type):
+        """Sets the type of this V1beta1EarlyStoppingRule.
+
+        Type of the rule, one of threshold, patience or divergence. Defaults to threshold.  # noqa: E501
+
+        :param type: The type of this V1beta1EarlyStoppingRule.  # noqa: E501
+        :type: str
+        """
+
+        self._type = type
+
     @property
     def value(self):
         """Gets the value of this V1beta1EarlyStoppingRule.  # noqa: E501
 
-        Value contains metric value for the rule.  # noqa: E501
+        Value contains metric value for the rule. For the patience rule, value is the minimum change of the metric counted as improvement. For the divergence rule, value is the optional bound of the metric.  # noqa: E501
 
         :return: The value of this V1beta1EarlyStoppingRule.  # noqa: E501
         :rtype: str
@@ -151,7 +235,7 @@ class V1beta1EarlyStoppingRule(object):
     def value(self, value):
         """Sets the value of this V1beta1EarlyStoppingRule.
 
-        Value contains metric value for the rule.  # noqa: E501
+        Value contains metric value for the rule. For the patience rule, value is the minimum change of the metric counted as improvement. For the divergence rule, value is the optional bound of the metric.  # noqa: E501
 
         :param value: The value of this V1beta1EarlyStoppingRule.  # noqa: E501
         :type: str
@@ -34,7 +34,9 @@ class V1beta1ExperimentSpec(object):
     """
     openapi_types = {
         'algorithm': 'V1beta1AlgorithmSpec',
+        'budget_policy': 'str',
         'early_stopping': 'V1beta1EarlyStoppingSpec',
+        'max_duration': 'str',
         'max_failed_trial_count': 'int',
         'max_trial_count': 'int',
         'metrics_collector_spec': 'V1beta1MetricsCollectorSpec',
@@ -42,13 +44,18 @@ class V1beta1ExperimentSpec(object):
         'objective': 'V1beta1ObjectiveSpec',
         'parallel_trial_count': 'int',
         'parameters': 'list[V1beta1ParameterSpec]',
+        'resource_budget': 'dict(str, str)',
         'resume_policy': 'str',
+        'suspend': 'bool',
+        'suspend_policy': 'str',
         'trial_template': 'V1beta1TrialTemplate'
     }
 
     attribute_map = {
         'algorithm': 'algorithm',
+        'budget_policy': 'budgetPolicy',
         'early_stopping': 'earlyStopping',
+        'max_duration': 'maxDuration',
         'max_failed_trial_count': 'maxFailedTrialCount',
         'max_trial_count': 'maxTrialCount',
         'metrics_collector_spec': 'metricsCollectorSpec',
@@ -56,18 +63,23 @@ class V1beta1ExperimentSpec(object):
         'objective': 'objective',
         'parallel_trial_count': 'parallelTrialCount',
         'parameters': 'parameters',
+        'resource_budget': 'resourceBudget',
         'resume_policy': 'resumePolicy',
+        'suspend': 'suspend',
+        'suspend_policy': 'suspendPolicy',
         'trial_template': 'trialTemplate'
     }
 
-    def __init__(self, algorithm=None, early_stopping=None, max_failed_trial_count=None, max_trial_count=None, metrics_collector_spec=None, nas_config=None, objective=None, parallel_trial_count=None, parameters=None, resume_policy=None, trial_template=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, algorithm=None, budget_policy=None, early_stopping=None, max_duration=None, max_failed_trial_count=None, max_trial_count=None, metrics_collector_spec=None, nas_config=None, objective=None, parallel_trial_count=None, parameters=None, resource_budget=None, resume_policy=None, suspend=None, suspend_policy=None, trial_template=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1ExperimentSpec - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
         self.local_vars_configuration = local_vars_configuration
 
         self._algorithm = None
+        self._budget_policy = None
         self._early_stopping = None
+        self._max_duration = None
         self._max_failed_trial_count = None
         self._max_trial_count = None
         self._metrics_collector_spec = None
@@ -75,14 +87,21 @@ class V1beta1ExperimentSpec(object):
         self._objective = None
         self._parallel_trial_count = None
         self._parameters = None
+        self._resource_budget = None
         self._resume_policy = None
+        self._suspend = None
+        self._suspend_policy = None
         self._trial_template = None
         self.discriminator = None
 
         if algorithm is not None:
             self.algorithm = algorithm
+        if budget_policy is not None:
+            self.budget_policy = budget_policy
         if early_stopping is not None:
             self.early_stopping = early_stopping
+        if max_duration is not None:
+            self.max_duration = max_duration
         if max_failed_trial_count is not None:
             self.max_failed_trial_count = max_failed_trial_count
         if max_trial_count is not None:
@@ -97,8 +116,14 @@ class V1beta1ExperimentSpec(object):
             self.parallel_trial_count = parallel_trial_count
         if parameters is not None:
             self.parameters = parameters
+        if resource_budget is not None:
+            self.resource_budget = resource_budget
         if resume_policy is not None:
             self.resume_policy = resume_policy
+        if suspend is not None:
+            self.suspend = suspend
+        if suspend_policy is not None:
+            self.suspend_policy = suspend_policy
         if trial_template is not None:
             self.trial_template = trial_template
 
@@ -123,6 +148,29 @@ class V1beta1ExperimentSpec(object):
 
         self._algorithm = algorithm
 
+    @property
+    def budget_policy(self):
+        """Gets the budget_policy of this V1beta1ExperimentSpec.  # noqa: E501
+
+        Describes what happens to the active trials when maxDuration is reached or resourceBudget is exhausted. Default value is Drain if maxDuration or resourceBudget is set.  # noqa: E501
+
+        :return: The budget_policy of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._budget_policy
+
+    @budget_policy.setter
+    def budget_policy(self, budget_policy):
+        """Sets the budget_policy of this V1beta1ExperimentSpec.
+
+        Describes what happens to the active trials when maxDuration is reached or resourceBudget is exhausted. Default value is Drain if maxDuration or resourceBudget is set.  # noqa: E501
+
+        :param budget_policy: The budget_policy of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._budget_policy = budget_policy
+
     @property
     def early_stopping(self):
         """Gets the early_stopping of this V1beta1ExperimentSpec.  # noqa: E501
@@ -144,6 +192,27 @@ class V1beta1ExperimentSpec(object):
 
         self._early_stopping = early_stopping
 
+    @property
+    def max_duration(self):
+        """Gets the max_duration of this V1beta1ExperimentSpec.  # noqa: E501
+
+
+        :return: The max_duration of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._max_duration
+
+    @max_duration.setter
+    def max_duration(self, max_duration):
+        """Sets the max_duration of this V1beta1ExperimentSpec.
+
+
+        :param max_duration: The max_duration of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._max_duration = max_duration
+
     @property
     def max_failed_trial_count(self):
         """Gets the max_failed_trial_count of this V1beta1ExperimentSpec.  # noqa: E501
@@ -299,6 +368,29 @@ class V1beta1ExperimentSpec(object):
 
         self._parameters = parameters
 
+    @property
+    def resource_budget(self):
+        """Gets the resource_budget of this V1beta1ExperimentSpec.  # noqa: E501
+
+        Max total resource usage of the trials in resource-hours, for example cpu: 100 means 100 CPU-hours. Resource usage of the trial is the resource requests of the pod templates in the trial run spec multiplied by the trial runtime. Experiment is succeeded when any resource budget is exhausted.  # noqa: E501
+
+        :return: The resource_budget of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: dict(str, str)
+        """
+        return self._resource_budget
+
+    @resource_budget.setter
+    def resource_budget(self, resource_budget):
+        """Sets the resource_budget of this V1beta1ExperimentSpec.
+
+        Max total resource usage of the trials in resource-hours, for example cpu: 100 means 100 CPU-hours. Resource usage of the trial is the resource requests of the pod templates in the trial run spec multiplied by the trial runtime. Experiment is succeeded when any resource budget is exhausted.  # noqa: E501
+
+        :param resource_budget: The resource_budget of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: dict(str, str)
+        """
+
+        self._resource_budget = resource_budget
+
     @property
     def resume_policy(self):
         """Gets the resume_policy of this V1beta1ExperimentSpec.  # noqa: E501
@@ -322,6 +414,52 @@ class V1beta1ExperimentSpec(object):
 
         self._resume_policy = resume_policy
 
+    @property
+    def suspend(self):
+        """Gets the suspend of this V1beta1ExperimentSpec.  # noqa: E501
+
+        Suspend indicates whether the experiment should stop creating new trials. Unsuspending the experiment resumes it from the point where it was suspended. Defaults to false.  # noqa: E501
+
+        :return: The suspend of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: bool
+        """
+        return self._suspend
+
+    @suspend.setter
+    def suspend(self, suspend):
+        """Sets the suspend of this V1beta1ExperimentSpec.
+
+        Suspend indicates whether the experiment should stop creating new trials. Unsuspending the experiment resumes it from the point where it was suspended. Defaults to false.  # noqa: E501
+
+        :param suspend: The suspend of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: bool
+        """
+
+        self._suspend = suspend
+
+    @property
+    def suspend_policy(self):
+        """Gets the suspend_policy of this V1beta1ExperimentSpec.  # noqa: E501
+
+        Describes what happens to the active trials when experiment is suspended. Default value is Drain.  # noqa: E501
+
+        :return: The suspend_policy of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._suspend_policy
+
+    @suspend_policy.setter
+    def suspend_policy(self, suspend_policy):
+        """Sets the suspend_policy of this V1beta1ExperimentSpec.
+
+        Describes what happens to the active trials when experiment is suspended. Default value is Drain.  # noqa: E501
+
+        :param suspend_policy: The suspend_policy of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._suspend_policy = suspend_policy
+
     @property
     def trial_template(self):
         """Gets the trial_template of this V1beta1ExperimentSpec.  # noqa: E501
@@ -41,7 +41,10 @@ class V1beta1ExperimentStatus(object):
         'killed_trial_list': 'list[str]',
         'last_reconcile_time': 'datetime',
         'metrics_unavailable_trial_list': 'list[str]',
+        'pareto_optimal_trials': 'list[V1beta1OptimalTrial]',
         'pending_trial_list': 'list[str]',
+        'resource_usage': 'dict(str, str)',
+        'resource_usage_last_update_time': 'datetime',
         'running_trial_list': 'list[str]',
         'start_time': 'datetime',
         'succeeded_trial_list': 'list[str]',
@@ -64,7 +67,10 @@ class V1beta1ExperimentStatus(object):
         'killed_trial_list': 'killedTrialList',
         'last_reconcile_time': 'lastReconcileTime',
         'metrics_unavailable_trial_list': 'metricsUnavailableTrialList',
+        'pareto_optimal_trials': 'paretoOptimalTrials',
         'pending_trial_list': 'pendingTrialList',
+        'resource_usage': 'resourceUsage',
+        'resource_usage_last_update_time': 'resourceUsageLastUpdateTime',
         'running_trial_list': 'runningTrialList',
         'start_time': 'startTime',
         'succeeded_trial_list': 'succeededTrialList',
@@ -78,7 +84,7 @@ class V1beta1ExperimentStatus(object):
         'trials_succeeded': 'trialsSucceeded'
     }
 
-    def __init__(self, completion_time=None, conditions=None, current_optimal_trial=None, early_stopped_trial_list=None, failed_trial_list=None, killed_trial_list=None, last_reconcile_time=None, metrics_unavailable_trial_list=None, pending_trial_list=None, running_trial_list=None, start_time=None, succeeded_trial_list=None, trial_metrics_unavailable=None, trials=None, trials_early_stopped=None, trials_failed=None, trials_killed=None, trials_pending=None, trials_running=None, trials_succeeded=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, completion_time=None, conditions=None, current_optimal_trial=None, early_stopped_trial_list=None, failed_trial_list=None, killed_trial_list=None, last_reconcile_time=None, metrics_unavailable_trial_list=None, pareto_optimal_trials=None, pending_trial_list=None, resource_usage=None, resource_usage_last_update_time=None, running_trial_list=None, start_time=None, succeeded_trial_list=None, trial_metrics_unavailable=None, trials=None, trials_early_stopped=None, trials_failed=None, trials_killed=None, trials_pending=None, trials_running=None, trials_succeeded=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1ExperimentStatus - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
@@ -92,7 +98,10 @@ class V1beta1ExperimentStatus(object):
         self._killed_trial_list = None
         self._last_reconcile_time = None
         self._metrics_unavailable_trial_list = None
+        self._pareto_optimal_trials = None
         self._pending_trial_list = None
+        self._resource_usage = None
+        self._resource_usage_last_update_time = None
         self._running_trial_list = None
         self._start_time = None
         self._succeeded_trial_list = None
@@ -122,8 +131,14 @@ class V1beta1ExperimentStatus(object):
             self.last_reconcile_time = last_reconcile_time
         if metrics_unavailable_trial_list is not None:
             self.metrics_unavailable_trial_list = metrics_unavailable_trial_list
+        if pareto_optimal_trials is not None:
+            self.pareto_optimal_trials = pareto_optimal_trials
         if pending_trial_list is not None:
             self.pending_trial_list = pending_trial_list
+        if resource_usage is not None:
+            self.resource_usage = resource_usage
+        if resource_usage_last_update_time is not None:
+            self.resource_usage_last_update_time = resource_usage_last_update_time
         if running_trial_list is not None:
             self.running_trial_list = running_trial_list
         if start_time is not None:
@@ -325,6 +340,29 @@ class V1beta1ExperimentStatus(object):
 
         self._metrics_unavailable_trial_list = metrics_unavailable_trial_list
 
+    @property
+    def pareto_optimal_trials(self):
+        """Gets the pareto_optimal_trials of this V1beta1ExperimentStatus.  # noqa: E501
+
+        List of the Pareto optimal trials, which are succeeded and not dominated by any other succeeded trial. It is set only when experiment has additional objectives.  # noqa: E501
+
+        :return: The pareto_optimal_trials of this V1beta1ExperimentStatus.  # noqa: E501
+        :rtype: list[V1beta1OptimalTrial]
+        """
+        return self._pareto_optimal_trials
+
+    @pareto_optimal_trials.setter
+    def pareto_optimal_trials(self, pareto_optimal_trials):
+        """Sets the pareto_optimal_trials of this V1beta1ExperimentStatus.
+
+        List of the Pareto optimal trials, which are succeeded and not dominated by any other succeeded trial. It is set only when experiment has additional objectives.  # noqa: E501
+
+        :param pareto_optimal_trials: The pareto_optimal_trials of this V1beta1ExperimentStatus.  # noqa: E501
+        :type: list[V1beta1OptimalTrial]
+        """
+
+        self._pareto_optimal_trials = pareto_optimal_trials
+
     @property
     def pending_trial_list(self):
         """Gets the pending_trial_list of this V1beta1ExperimentStatus.  # noqa: E501
@@ -348,6 +386,50 @@ class V1beta1ExperimentStatus(object):
 
         self._pending_trial_list = pending_trial_list
 
+    @property
+    def resource_usage(self):
+        """Gets the resource_usage of this V1beta1ExperimentStatus.  # noqa: E501
+
+        Total resource usage of the trials in resource-hours. It is set only when experiment has the resource budget. Usage is accumulated while experiment is not completed, including usage of the deleted trials.  # noqa: E501
+
+        :return: The resource_usage of this V1beta1ExperimentStatus.  # noqa: E501
+        :rtype: dict(str, str)
+        """
+        return self._resource_usage
+
+    @resource_usage.setter
+    def resource_usage(self, resource_usage):
+        """Sets the resource_usage of this V1beta1ExperimentStatus.
+
+        Total resource usage of the trials in resource-hours. It is set only when experiment has the resource budget. Usage is accumulated while experiment is not completed, including usage of the deleted trials.  # noqa: E501
+
+        :param resource_usage: The resource_usage of this V1beta1ExperimentStatus.  # noqa: E501
+        :type: dict(str, str)
+        """
+
+        self._resource_usage = resource_usage
+
+    @property
+    def resource_usage_last_update_time(self):
+        """Gets the resource_usage_last_update_time of this V1beta1ExperimentStatus.  # noqa: E501
+
+
+        :return: The resource_usage_last_update_time of this V1beta1ExperimentStatus.  # noqa: E501
+        :rtype: datetime
+        """
+        return self._resource_usage_last_update_time
+
+    @resource_usage_last_update_time.setter
+    def resource_usage_last_update_time(self, resource_usage_last_update_time):
+        """Sets the resource_usage_last_update_time of this V1beta1ExperimentStatus.
+
+
+        :param resource_usage_last_update_time: The resource_usage_last_update_time of this V1beta1ExperimentStatus.  # noqa: E501
+        :type: datetime
+        """
+
+        self._resource_usage_last_update_time = resource_usage_last_update_time
+
     @property
     def running_trial_list(self):
         """Gets the running_trial_list of this V1beta1ExperimentStatus.  # noqa: E501
@@ -33,24 +33,44 @@ class V1beta1FilterSpec(object):
                             and the value is json key in definition.
     """
     openapi_types = {
-        'metrics_format': 'list[str]'
+        'metrics_format': 'list[str]',
+        'metrics_selectors': 'list[V1beta1MetricSelector]',
+        'step_column': 'str',
+        'timestamp_column': 'str',
+        'timestamp_format': 'str'
     }
 
     attribute_map = {
-        'metrics_format': 'metricsFormat'
+        'metrics_format': 'metricsFormat',
+        'metrics_selectors': 'metricsSelectors',
+        'step_column': 'stepColumn',
+        'timestamp_column': 'timestampColumn',
+        'timestamp_format': 'timestampFormat'
     }
 
-    def __init__(self, metrics_format=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, metrics_format=None, metrics_selectors=None, step_column=None, timestamp_column=None, timestamp_format=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1FilterSpec - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
         self.local_vars_configuration = local_vars_configuration
 
         self._metrics_format = None
+        self._metrics_selectors = None
+        self._step_column = None
+        self._timestamp_column = None
+        self._timestamp_format = None
         self.discriminator = None
 
         if metrics_format is not None:
             self.metrics_format = metrics_format
+        if metrics_selectors is not None:
+            self.metrics_selectors = metrics_selectors
+        if step_column is not None:
+            self.step_column = step_column
+        if timestamp_column is not None:
+            self.timestamp_column = timestamp_column
+        if timestamp_format is not None:
+            self.timestamp_format = timestamp_format
 
     @property
     def metrics_format(self):
@@ -75,6 +95,98 @@ class V1beta1FilterSpec(object):
 
         self._metrics_format = metrics_format
 
+    @property
+    def metrics_selectors(self):
+        """Gets the metrics_selectors of this V1beta1FilterSpec.  # noqa: E501
+
+        Selectors of the metric values when format of metrics file is JSON, JSONDocument, YAML or CSV. If selector is not set for the metric, metric value is taken from the top-level key or the column with the metric name.  # noqa: E501
+
+        :return: The metrics_selectors of this V1beta1FilterSpec.  # noqa: E501
+        :rtype: list[V1beta1MetricSelector]
+        """
+        return self._metrics_selectors
+
+    @metrics_selectors.setter
+    def metrics_selectors(self, metrics_selectors):
+        """Sets the metrics_selectors of this V1beta1FilterSpec.
+
+        Selectors of the metric values when format of metrics file is JSON, JSONDocument, YAML or CSV. If selector is not set for the metric, metric value is taken from the top-level key or the column with the metric name.  # noqa: E501
+
+        :param metrics_selectors: The metrics_selectors of this V1beta1FilterSpec.  # noqa: E501
+        :type: list[V1beta1MetricSelector]
+        """
+
+        self._metrics_selectors = metrics_selectors
+
+    @property
+    def step_column(self):
+        """Gets the step_column of this V1beta1FilterSpec.  # noqa: E501
+
+        Column with the training step of the metrics when format of metrics file is CSV. By default, the step or epoch column is used if it exists.  # noqa: E501
+
+        :return: The step_column of this V1beta1FilterSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._step_column
+
+    @step_column.setter
+    def step_column(self, step_column):
+        """Sets the step_column of this V1beta1FilterSpec.
+
+        Column with the training step of the metrics when format of metrics file is CSV. By default, the step or epoch column is used if it exists.  # noqa: E501
+
+        :param step_column: The step_column of this V1beta1FilterSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._step_column = step_column
+
+    @property
+    def timestamp_column(self):
+        """Gets the timestamp_column of this V1beta1FilterSpec.  # noqa: E501
+
+        Column with the timestamp of the metrics when format of metrics file is CSV. By default, the timestamp column is used if it exists.  # noqa: E501
+
+        :return: The timestamp_column of this V1beta1FilterSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._timestamp_column
+
+    @timestamp_column.setter
+    def timestamp_column(self, timestamp_column):
+        """Sets the timestamp_column of this V1beta1FilterSpec.
+
+        Column with the timestamp of the metrics when format of metrics file is CSV. By default, the timestamp column is used if it exists.  # noqa: E501
+
+        :param timestamp_column: The timestamp_column of this V1beta1FilterSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._timestamp_column = timestamp_column
+
+    @property
+    def timestamp_format(self):
+        """Gets the timestamp_format of this V1beta1FilterSpec.  # noqa: E501
+
+        Format of the timestamp in the metrics lines when format of metrics file is TEXT. It can be the Go time layout of the timestamp at the beginning of the line, for example: 2006-01-02 15:04:05,000, the preset: RFC3339, RFC3339Nano, Python, Glog, Unix or UnixMilli, or the regular expression with the capture group of the timestamp, for example: ts=([0-9.]+). The captured timestamp must be in RFC3339Nano, Python or Unix format. Timestamp without the time zone is in UTC. Defaults to RFC3339Nano.  # noqa: E501
+
+        :return: The timestamp_format of this V1beta1FilterSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._timestamp_format
+
+    @timestamp_format.setter
+    def timestamp_format(self, timestamp_format):
+        """Sets the timestamp_format of this V1beta1FilterSpec.
+
+        Format of the timestamp in the metrics lines when format of metrics file is TEXT. It can be the Go time layout of the timestamp at the beginning of the line, for example: 2006-01-02 15:04:05,000, the preset: RFC3339, RFC3339Nano, Python, Glog, Unix or UnixMilli, or the regular expression with the capture group of the timestamp, for example: ts=([0-9.]+). The captured timestamp must be in RFC3339Nano, Python or Unix format. Timestamp without the time zone is in UTC. Defaults to RFC3339Nano.  # noqa: E501
+
+        :param timestamp_format: The timestamp_format of this V1beta1FilterSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._timestamp_format = timestamp_format
+
     def to_dict(self):
         """Returns the model properties as a dict"""
         result = {}
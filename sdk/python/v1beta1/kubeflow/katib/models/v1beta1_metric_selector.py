@@ -0,0 +1,150 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    The version of the OpenAPI document: v1beta1-0.1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.configuration import Configuration
+
+
+class V1beta1MetricSelector(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'name': 'str',
+        'path': 'str'
+    }
+
+    attribute_map = {
+        'name': 'name',
+        'path': 'path'
+    }
+
+    def __init__(self, name=None, path=None, local_vars_configuration=None):  # noqa: E501
+        """V1beta1MetricSelector - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._name = None
+        self._path = None
+        self.discriminator = None
+
+        if name is not None:
+            self.name = name
+        if path is not None:
+            self.path = path
+
+    @property
+    def name(self):
+        """Gets the name of this V1beta1MetricSelector.  # noqa: E501
+
+        Name of the objective or additional metric  # noqa: E501
+
+        :return: The name of this V1beta1MetricSelector.  # noqa: E501
+        :rtype: str
+        """
+        return self._name
+
+    @name.setter
+    def name(self, name):
+        """Sets the name of this V1beta1MetricSelector.
+
+        Name of the objective or additional metric  # noqa: E501
+
+        :param name: The name of this V1beta1MetricSelector.  # noqa: E501
+        :type: str
+        """
+
+        self._name = name
+
+    @property
+    def path(self):
+        """Gets the path of this V1beta1MetricSelector.  # noqa: E501
+
+        Path of the metric value in the JSON object. Path can be in GJSON format, ref https://github.com/tidwall/gjson, for example: eval.acc or in JSONPath format, for example: $.eval.acc When format of metrics file is CSV, path is the column name.  # noqa: E501
+
+        :return: The path of this V1beta1MetricSelector.  # noqa: E501
+        :rtype: str
+        """
+        return self._path
+
+    @path.setter
+    def path(self, path):
+        """Sets the path of this V1beta1MetricSelector.
+
+        Path of the metric value in the JSON object. Path can be in GJSON format, ref https://github.com/tidwall/gjson, for example: eval.acc or in JSONPath format, for example: $.eval.acc When format of metrics file is CSV, path is the column name.  # noqa: E501
+
+        :param path: The path of this V1beta1MetricSelector.  # noqa: E501
+        :type: str
+        """
+
+        self._path = path
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1MetricSelector):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V1beta1MetricSelector):
+            return True
+
+        return self.to_dict() != other.to_dict()
@@ -0,0 +1,179 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    The version of the OpenAPI document: v1beta1-0.1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.configuration import Configuration
+
+
+class V1beta1ObjectiveConstraint(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'bound': 'float',
+        'comparison': 'str',
+        'metric_name': 'str'
+    }
+
+    attribute_map = {
+        'bound': 'bound',
+        'comparison': 'comparison',
+        'metric_name': 'metricName'
+    }
+
+    def __init__(self, bound=0, comparison=None, metric_name=None, local_vars_configuration=None):  # noqa: E501
+        """V1beta1ObjectiveConstraint - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._bound = None
+        self._comparison = None
+        self._metric_name = None
+        self.discriminator = None
+
+        self.bound = bound
+        if comparison is not None:
+            self.comparison = comparison
+        if metric_name is not None:
+            self.metric_name = metric_name
+
+    @property
+    def bound(self):
+        """Gets the bound of this V1beta1ObjectiveConstraint.  # noqa: E501
+
+        Bound of the metric value.  # noqa: E501
+
+        :return: The bound of this V1beta1ObjectiveConstraint.  # noqa: E501
+        :rtype: float
+        """
+        return self._bound
+
+    @bound.setter
+    def bound(self, bound):
+        """Sets the bound of this V1beta1ObjectiveConstraint.
+
+        Bound of the metric value.  # noqa: E501
+
+        :param bound: The bound of this V1beta1ObjectiveConstraint.  # noqa: E501
+        :type: float
+        """
+        if self.local_vars_configuration.client_side_validation and bound is None:  # noqa: E501
+            raise ValueError("Invalid value for `bound`, must not be `None`")  # noqa: E501
+
+        self._bound = bound
+
+    @property
+    def comparison(self):
+        """Gets the comparison of this V1beta1ObjectiveConstraint.  # noqa: E501
+
+        Comparison between the metric value and the bound, one of less or greater.  # noqa: E501
+
+        :return: The comparison of this V1beta1ObjectiveConstraint.  # noqa: E501
+        :rtype: str
+        """
+        return self._comparison
+
+    @comparison.setter
+    def comparison(self, comparison):
+        """Sets the comparison of this V1beta1ObjectiveConstraint.
+
+        Comparison between the metric value and the bound, one of less or greater.  # noqa: E501
+
+        :param comparison: The comparison of this V1beta1ObjectiveConstraint.  # noqa: E501
+        :type: str
+        """
+
+        self._comparison = comparison
+
+    @property
+    def metric_name(self):
+        """Gets the metric_name of this V1beta1ObjectiveConstraint.  # noqa: E501
+
+        MetricName represents the constrained metric.  # noqa: E501
+
+        :return: The metric_name of this V1beta1ObjectiveConstraint.  # noqa: E501
+        :rtype: str
+        """
+        return self._metric_name
+
+    @metric_name.setter
+    def metric_name(self, metric_name):
+        """Sets the metric_name of this V1beta1ObjectiveConstraint.
+
+        MetricName represents the constrained metric.  # noqa: E501
+
+        :param metric_name: The metric_name of this V1beta1ObjectiveConstraint.  # noqa: E501
+        :type: str
+        """
+
+        self._metric_name = metric_name
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1ObjectiveConstraint):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V1beta1ObjectiveConstraint):
+            return True
+
+        return self.to_dict() != other.to_dict()
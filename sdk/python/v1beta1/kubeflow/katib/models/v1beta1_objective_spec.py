@@ -34,35 +34,48 @@ class V1beta1ObjectiveSpec(object):
     """
     openapi_types = {
         'additional_metric_names': 'list[str]',
+        'additional_objectives': 'list[V1beta1AdditionalObjective]',
+        'constraints': 'list[V1beta1ObjectiveConstraint]',
         'goal': 'float',
         'metric_strategies': 'list[V1beta1MetricStrategy]',
         'objective_metric_name': 'str',
-        'type': 'str'
+        'type': 'str',
+        'worker_aggregation': 'str'
     }
 
     attribute_map = {
         'additional_metric_names': 'additionalMetricNames',
+        'additional_objectives': 'additionalObjectives',
+        'constraints': 'constraints',
         'goal': 'goal',
         'metric_strategies': 'metricStrategies',
         'objective_metric_name': 'objectiveMetricName',
-        'type': 'type'
+        'type': 'type',
+        'worker_aggregation': 'workerAggregation'
     }
 
-    def __init__(self, additional_metric_names=None, goal=None, metric_strategies=None, objective_metric_name=None, type=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, additional_metric_names=None, additional_objectives=None, constraints=None, goal=None, metric_strategies=None, objective_metric_name=None, type=None, worker_aggregation=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1ObjectiveSpec - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
         self.local_vars_configuration = local_vars_configuration
 
         self._additional_metric_names = None
+        self._additional_objectives = None
+        self._constraints = None
         self._goal = None
         self._metric_strategies = None
         self._objective_metric_name = None
         self._type = None
+        self._worker_aggregation = None
         self.discriminator = None
 
         if additional_metric_names is not None:
             self.additional_metric_names = additional_metric_names
+        if additional_objectives is not None:
+            self.additional_objectives = additional_objectives
+        if constraints is not None:
+            self.constraints = constraints
         if goal is not None:
             self.goal = goal
         if metric_strategies is not None:
@@ -71,6 +84,8 @@ class V1beta1ObjectiveSpec(object):
             self.objective_metric_name = objective_metric_name
         if type is not None:
             self.type = type
+        if worker_aggregation is not None:
+            self.worker_aggregation = worker_aggregation
 
     @property
     def additional_metric_names(self):
@@ -95,6 +110,52 @@ class V1beta1ObjectiveSpec(object):
 
         self._additional_metric_names = additional_metric_names
 
+    @property
+    def additional_objectives(self):
+        """Gets the additional_objectives of this V1beta1ObjectiveSpec.  # noqa: E501
+
+        AdditionalObjectives represents metrics that are optimized together with the objective metric. If it is set, Experiment is the multi-objective optimization and the Pareto optimal Trials are reported in the Experiment status. The objective goal is still checked for the objective metric only. Metrics of the additional objectives are collected as the additional metrics.  # noqa: E501
+
+        :return: The additional_objectives of this V1beta1ObjectiveSpec.  # noqa: E501
+        :rtype: list[V1beta1AdditionalObjective]
+        """
+        return self._additional_objectives
+
+    @additional_objectives.setter
+    def additional_objectives(self, additional_objectives):
+        """Sets the additional_objectives of this V1beta1ObjectiveSpec.
+
+        AdditionalObjectives represents metrics that are optimized together with the objective metric. If it is set, Experiment is the multi-objective optimization and the Pareto optimal Trials are reported in the Experiment status. The objective goal is still checked for the objective metric only. Metrics of the additional objectives are collected as the additional metrics.  # noqa: E501
+
+        :param additional_objectives: The additional_objectives of this V1beta1ObjectiveSpec.  # noqa: E501
+        :type: list[V1beta1AdditionalObjective]
+        """
+
+        self._additional_objectives = additional_objectives
+
+    @property
+    def constraints(self):
+        """Gets the constraints of this V1beta1ObjectiveSpec.  # noqa: E501
+
+        Constraints represents the constraints on the metric values which the feasible Trial must satisfy. Trials which violate any constraint are marked as infeasible and they are not considered for the optimal Trial and the objective goal. Only the nsga2 algorithm takes the constraints into account when it samples the parameters, other algorithms don't avoid the infeasible parameters. Metrics of the constraints are collected as the additional metrics.  # noqa: E501
+
+        :return: The constraints of this V1beta1ObjectiveSpec.  # noqa: E501
+        :rtype: list[V1beta1ObjectiveConstraint]
+        """
+        return self._constraints
+
+    @constraints.setter
+    def constraints(self, constraints):
+        """Sets the constraints of this V1beta1ObjectiveSpec.
+
+        Constraints represents the constraints on the metric values which the feasible Trial must satisfy. Trials which violate any constraint are marked as infeasible and they are not considered for the optimal Trial and the objective goal. Only the nsga2 algorithm takes the constraints into account when it samples the parameters, other algorithms don't avoid the infeasible parameters. Metrics of the constraints are collected as the additional metrics.  # noqa: E501
+
+        :param constraints: The constraints of this V1beta1ObjectiveSpec.  # noqa: E501
+        :type: list[V1beta1ObjectiveConstraint]
+        """
+
+        self._constraints = constraints
+
     @property
     def goal(self):
         """Gets the goal of this V1beta1ObjectiveSpec.  # noqa: E501
@@ -187,6 +248,29 @@ class V1beta1ObjectiveSpec(object):
 
         self._type = type
 
+    @property
+    def worker_aggregation(self):
+        """Gets the worker_aggregation of this V1beta1ObjectiveSpec.  # noqa: E501
+
+        WorkerAggregation defines how the metrics reported by the workers of the distributed training are aggregated, one of rank0, mean, min or max. For rank0, metrics of the first worker in the rank order which reported the metric are used. For mean, min and max, the min, max and latest values of the workers are aggregated. This field is allowed to missing, experiment defaulter (webhook) will set it to rank0.  # noqa: E501
+
+        :return: The worker_aggregation of this V1beta1ObjectiveSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._worker_aggregation
+
+    @worker_aggregation.setter
+    def worker_aggregation(self, worker_aggregation):
+        """Sets the worker_aggregation of this V1beta1ObjectiveSpec.
+
+        WorkerAggregation defines how the metrics reported by the workers of the distributed training are aggregated, one of rank0, mean, min or max. For rank0, metrics of the first worker in the rank order which reported the metric are used. For mean, min and max, the min, max and latest values of the workers are aggregated. This field is allowed to missing, experiment defaulter (webhook) will set it to rank0.  # noqa: E501
+
+        :param worker_aggregation: The worker_aggregation of this V1beta1ObjectiveSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._worker_aggregation = worker_aggregation
+
     def to_dict(self):
         """Returns the model properties as a dict"""
         result = {}
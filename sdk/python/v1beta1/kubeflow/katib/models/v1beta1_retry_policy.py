@@ -0,0 +1,178 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    The version of the OpenAPI document: v1beta1-0.1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.configuration import Configuration
+
+
+class V1beta1RetryPolicy(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'backoff_seconds': 'int',
+        'max_retries': 'int',
+        'retryable_reasons': 'list[str]'
+    }
+
+    attribute_map = {
+        'backoff_seconds': 'backoffSeconds',
+        'max_retries': 'maxRetries',
+        'retryable_reasons': 'retryableReasons'
+    }
+
+    def __init__(self, backoff_seconds=None, max_retries=None, retryable_reasons=None, local_vars_configuration=None):  # noqa: E501
+        """V1beta1RetryPolicy - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._backoff_seconds = None
+        self._max_retries = None
+        self._retryable_reasons = None
+        self.discriminator = None
+
+        if backoff_seconds is not None:
+            self.backoff_seconds = backoff_seconds
+        if max_retries is not None:
+            self.max_retries = max_retries
+        if retryable_reasons is not None:
+            self.retryable_reasons = retryable_reasons
+
+    @property
+    def backoff_seconds(self):
+        """Gets the backoff_seconds of this V1beta1RetryPolicy.  # noqa: E501
+
+        Number of seconds to wait before the failed Trial job is created again. The delay is doubled for each subsequent retry up to 1 hour.  # noqa: E501
+
+        :return: The backoff_seconds of this V1beta1RetryPolicy.  # noqa: E501
+        :rtype: int
+        """
+        return self._backoff_seconds
+
+    @backoff_seconds.setter
+    def backoff_seconds(self, backoff_seconds):
+        """Sets the backoff_seconds of this V1beta1RetryPolicy.
+
+        Number of seconds to wait before the failed Trial job is created again. The delay is doubled for each subsequent retry up to 1 hour.  # noqa: E501
+
+        :param backoff_seconds: The backoff_seconds of this V1beta1RetryPolicy.  # noqa: E501
+        :type: int
+        """
+
+        self._backoff_seconds = backoff_seconds
+
+    @property
+    def max_retries(self):
+        """Gets the max_retries of this V1beta1RetryPolicy.  # noqa: E501
+
+        Max number of retries of the failed Trial job, at most 100. Defaults to 0, the failed Trial job is not retried.  # noqa: E501
+
+        :return: The max_retries of this V1beta1RetryPolicy.  # noqa: E501
+        :rtype: int
+        """
+        return self._max_retries
+
+    @max_retries.setter
+    def max_retries(self, max_retries):
+        """Sets the max_retries of this V1beta1RetryPolicy.
+
+        Max number of retries of the failed Trial job, at most 100. Defaults to 0, the failed Trial job is not retried.  # noqa: E501
+
+        :param max_retries: The max_retries of this V1beta1RetryPolicy.  # noqa: E501
+        :type: int
+        """
+
+        self._max_retries = max_retries
+
+    @property
+    def retryable_reasons(self):
+        """Gets the retryable_reasons of this V1beta1RetryPolicy.  # noqa: E501
+
+        List of the retryable failure reasons, e.g. Evicted, OOMKilled, ErrImagePull or PreemptionByScheduler. Reasons are matched against the Trial job failure reason and the status of the Trial job's pods. If it is empty, all failures are retryable.  # noqa: E501
+
+        :return: The retryable_reasons of this V1beta1RetryPolicy.  # noqa: E501
+        :rtype: list[str]
+        """
+        return self._retryable_reasons
+
+    @retryable_reasons.setter
+    def retryable_reasons(self, retryable_reasons):
+        """Sets the retryable_reasons of this V1beta1RetryPolicy.
+
+        List of the retryable failure reasons, e.g. Evicted, OOMKilled, ErrImagePull or PreemptionByScheduler. Reasons are matched against the Trial job failure reason and the status of the Trial job's pods. If it is empty, all failures are retryable.  # noqa: E501
+
+        :param retryable_reasons: The retryable_reasons of this V1beta1RetryPolicy.  # noqa: E501
+        :type: list[str]
+        """
+
+        self._retryable_reasons = retryable_reasons
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1RetryPolicy):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V1beta1RetryPolicy):
+            return True
+
+        return self.to_dict() != other.to_dict()
@@ -0,0 +1,202 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    The version of the OpenAPI document: v1beta1-0.1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.configuration import Configuration
+
+
+class V1beta1TrialAttempt(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'completion_time': 'datetime',
+        'message': 'str',
+        'reason': 'str',
+        'start_time': 'datetime'
+    }
+
+    attribute_map = {
+        'completion_time': 'completionTime',
+        'message': 'message',
+        'reason': 'reason',
+        'start_time': 'startTime'
+    }
+
+    def __init__(self, completion_time=None, message=None, reason=None, start_time=None, local_vars_configuration=None):  # noqa: E501
+        """V1beta1TrialAttempt - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._completion_time = None
+        self._message = None
+        self._reason = None
+        self._start_time = None
+        self.discriminator = None
+
+        if completion_time is not None:
+            self.completion_time = completion_time
+        if message is not None:
+            self.message = message
+        if reason is not None:
+            self.reason = reason
+        if start_time is not None:
+            self.start_time = start_time
+
+    @property
+    def completion_time(self):
+        """Gets the completion_time of this V1beta1TrialAttempt.  # noqa: E501
+
+
+        :return: The completion_time of this V1beta1TrialAttempt.  # noqa: E501
+        :rtype: datetime
+        """
+        return self._completion_time
+
+    @completion_time.setter
+    def completion_time(self, completion_time):
+        """Sets the completion_time of this V1beta1TrialAttempt.
+
+
+        :param completion_time: The completion_time of this V1beta1TrialAttempt.  # noqa: E501
+        :type: datetime
+        """
+
+        self._completion_time = completion_time
+
+    @property
+    def message(self):
+        """Gets the message of this V1beta1TrialAttempt.  # noqa: E501
+
+        A human readable message indicating details about the failure.  # noqa: E501
+
+        :return: The message of this V1beta1TrialAttempt.  # noqa: E501
+        :rtype: str
+        """
+        return self._message
+
+    @message.setter
+    def message(self, message):
+        """Sets the message of this V1beta1TrialAttempt.
+
+        A human readable message indicating details about the failure.  # noqa: E501
+
+        :param message: The message of this V1beta1TrialAttempt.  # noqa: E501
+        :type: str
+        """
+
+        self._message = message
+
+    @property
+    def reason(self):
+        """Gets the reason of this V1beta1TrialAttempt.  # noqa: E501
+
+        The retryable failure reason of the attempt.  # noqa: E501
+
+        :return: The reason of this V1beta1TrialAttempt.  # noqa: E501
+        :rtype: str
+        """
+        return self._reason
+
+    @reason.setter
+    def reason(self, reason):
+        """Sets the reason of this V1beta1TrialAttempt.
+
+        The retryable failure reason of the attempt.  # noqa: E501
+
+        :param reason: The reason of this V1beta1TrialAttempt.  # noqa: E501
+        :type: str
+        """
+
+        self._reason = reason
+
+    @property
+    def start_time(self):
+        """Gets the start_time of this V1beta1TrialAttempt.  # noqa: E501
+
+
+        :return: The start_time of this V1beta1TrialAttempt.  # noqa: E501
+        :rtype: datetime
+        """
+        return self._start_time
+
+    @start_time.setter
+    def start_time(self, start_time):
+        """Sets the start_time of this V1beta1TrialAttempt.
+
+
+        :param start_time: The start_time of this V1beta1TrialAttempt.  # noqa: E501
+        :type: datetime
+        """
+
+        self._start_time = start_time
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1TrialAttempt):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V1beta1TrialAttempt):
+            return True
+
+        return self.to_dict() != other.to_dict()
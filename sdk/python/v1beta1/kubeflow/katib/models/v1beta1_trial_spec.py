@@ -42,6 +42,7 @@ class V1beta1TrialSpec(object):
         'primary_container_name': 'str',
         'primary_pod_labels': 'dict(str, str)',
         'retain_run': 'bool',
+        'retry_policy': 'V1beta1RetryPolicy',
         'run_spec': 'object',
         'success_condition': 'str'
     }
@@ -56,11 +57,12 @@ class V1beta1TrialSpec(object):
         'primary_container_name': 'primaryContainerName',
         'primary_pod_labels': 'primaryPodLabels',
         'retain_run': 'retainRun',
+        'retry_policy': 'retryPolicy',
         'run_spec': 'runSpec',
         'success_condition': 'successCondition'
     }
 
-    def __init__(self, early_stopping_rules=None, failure_condition=None, labels=None, metrics_collector=None, objective=None, parameter_assignments=None, primary_container_name=None, primary_pod_labels=None, retain_run=None, run_spec=None, success_condition=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, early_stopping_rules=None, failure_condition=None, labels=None, metrics_collector=None, objective=None, parameter_assignments=None, primary_container_name=None, primary_pod_labels=None, retain_run=None, retry_policy=None, run_spec=None, success_condition=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1TrialSpec - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
@@ -75,6 +77,7 @@ class V1beta1TrialSpec(object):
         self._primary_container_name = None
         self._primary_pod_labels = None
         self._retain_run = None
+        self._retry_policy = None
         self._run_spec = None
         self._success_condition = None
         self.discriminator = None
@@ -97,6 +100,8 @@ class V1beta1TrialSpec(object):
             self.primary_pod_labels = primary_pod_labels
         if retain_run is not None:
             self.retain_run = retain_run
+        if retry_policy is not None:
+            self.retry_policy = retry_policy
         if run_spec is not None:
             self.run_spec = run_spec
         if success_condition is not None:
@@ -305,6 +310,27 @@ class V1beta1TrialSpec(object):
 
         self._retain_run = retain_run
 
+    @property
+    def retry_policy(self):
+        """Gets the retry_policy of this V1beta1TrialSpec.  # noqa: E501
+
+
+        :return: The retry_policy of this V1beta1TrialSpec.  # noqa: E501
+        :rtype: V1beta1RetryPolicy
+        """
+        return self._retry_policy
+
+    @retry_policy.setter
+    def retry_policy(self, retry_policy):
+        """Sets the retry_policy of this V1beta1TrialSpec.
+
+
+        :param retry_policy: The retry_policy of this V1beta1TrialSpec.  # noqa: E501
+        :type: V1beta1RetryPolicy
+        """
+
+        self._retry_policy = retry_policy
+
     @property
     def run_spec(self):
         """Gets the run_spec of this V1beta1TrialSpec.  # noqa: E501
@@ -33,38 +33,48 @@ class V1beta1TrialStatus(object):
                             and the value is json key in definition.
     """
     openapi_types = {
+        'attempts': 'list[V1beta1TrialAttempt]',
         'completion_time': 'datetime',
         'conditions': 'list[V1beta1TrialCondition]',
+        'infeasible': 'bool',
         'last_reconcile_time': 'datetime',
         'observation': 'V1beta1Observation',
         'start_time': 'datetime'
     }
 
     attribute_map = {
+        'attempts': 'attempts',
         'completion_time': 'completionTime',
         'conditions': 'conditions',
+        'infeasible': 'infeasible',
         'last_reconcile_time': 'lastReconcileTime',
         'observation': 'observation',
         'start_time': 'startTime'
     }
 
-    def __init__(self, completion_time=None, conditions=None, last_reconcile_time=None, observation=None, start_time=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, attempts=None, completion_time=None, conditions=None, infeasible=None, last_reconcile_time=None, observation=None, start_time=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1TrialStatus - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
         self.local_vars_configuration = local_vars_configuration
 
+        self._attempts = None
         self._completion_time = None
         self._conditions = None
+        self._infeasible = None
         self._last_reconcile_time = None
         self._observation = None
         self._start_time = None
         self.discriminator = None
 
+        if attempts is not None:
+            self.attempts = attempts
         if completion_time is not None:
             self.completion_time = completion_time
         if conditions is not None:
             self.conditions = conditions
+        if infeasible is not None:
+            self.infeasible = infeasible
         if last_reconcile_time is not None:
             self.last_reconcile_time = last_reconcile_time
         if observation is not None:
@@ -72,6 +82,29 @@ class V1beta1TrialStatus(object):
         if start_time is not None:
             self.start_time = start_time
 
+    @property
+    def attempts(self):
+        """Gets the attempts of this V1beta1TrialStatus.  # noqa: E501
+
+        History of the failed Trial job attempts which were retried.  # noqa: E501
+
+        :return: The attempts of this V1beta1TrialStatus.  # noqa: E501
+        :rtype: list[V1beta1TrialAttempt]
+        """
+        return self._attempts
+
+    @attempts.setter
+    def attempts(self, attempts):
+        """Sets the attempts of this V1beta1TrialStatus.
+
+        History of the failed Trial job attempts which were retried.  # noqa: E501
+
+        :param attempts: The attempts of this V1beta1TrialStatus.  # noqa: E501
+        :type: list[V1beta1TrialAttempt]
+        """
+
+        self._attempts = attempts
+
     @property
     def completion_time(self):
         """Gets the completion_time of this V1beta1TrialStatus.  # noqa: E501
@@ -116,6 +149,29 @@ class V1beta1TrialStatus(object):
 
         self._conditions = conditions
 
+    @property
+    def infeasible(self):
+        """Gets the infeasible of this V1beta1TrialStatus.  # noqa: E501
+
+        Infeasible is true if the Trial observation violates any of the objective constraints.  # noqa: E501
+
+        :return: The infeasible of this V1beta1TrialStatus.  # noqa: E501
+        :rtype: bool
+        """
+        return self._infeasible
+
+    @infeasible.setter
+    def infeasible(self, infeasible):
+        """Sets the infeasible of this V1beta1TrialStatus.
+
+        Infeasible is true if the Trial observation violates any of the objective constraints.  # noqa: E501
+
+        :param infeasible: The infeasible of this V1beta1TrialStatus.  # noqa: E501
+        :type: bool
+        """
+
+        self._infeasible = infeasible
+
     @property
     def last_reconcile_time(self):
         """Gets the last_reconcile_time of this V1beta1TrialStatus.  # noqa: E501
@@ -38,6 +38,7 @@ class V1beta1TrialTemplate(object):
         'primary_container_name': 'str',
         'primary_pod_labels': 'dict(str, str)',
         'retain': 'bool',
+        'retry_policy': 'V1beta1RetryPolicy',
         'success_condition': 'str',
         'trial_parameters': 'list[V1beta1TrialParameterSpec]',
         'trial_spec': 'object'
@@ -49,12 +50,13 @@ class V1beta1TrialTemplate(object):
         'primary_container_name': 'primaryContainerName',
         'primary_pod_labels': 'primaryPodLabels',
         'retain': 'retain',
+        'retry_policy': 'retryPolicy',
         'success_condition': 'successCondition',
         'trial_parameters': 'trialParameters',
         'trial_spec': 'trialSpec'
     }
 
-    def __init__(self, config_map=None, failure_condition=None, primary_container_name=None, primary_pod_labels=None, retain=None, success_condition=None, trial_parameters=None, trial_spec=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, config_map=None, failure_condition=None, primary_container_name=None, primary_pod_labels=None, retain=None, retry_policy=None, success_condition=None, trial_parameters=None, trial_spec=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1TrialTemplate - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
@@ -65,6 +67,7 @@ class V1beta1TrialTemplate(object):
         self._primary_container_name = None
         self._primary_pod_labels = None
         self._retain = None
+        self._retry_policy = None
         self._success_condition = None
         self._trial_parameters = None
         self._trial_spec = None
@@ -80,6 +83,8 @@ class V1beta1TrialTemplate(object):
             self.primary_pod_labels = primary_pod_labels
         if retain is not None:
             self.retain = retain
+        if retry_policy is not None:
+            self.retry_policy = retry_policy
         if success_condition is not None:
             self.success_condition = success_condition
         if trial_parameters is not None:
@@ -200,6 +205,27 @@ class V1beta1TrialTemplate(object):
 
         self._retain = retain
 
+    @property
+    def retry_policy(self):
+        """Gets the retry_policy of this V1beta1TrialTemplate.  # noqa: E501
+
+
+        :return: The retry_policy of this V1beta1TrialTemplate.  # noqa: E501
+        :rtype: V1beta1RetryPolicy
+        """
+        return self._retry_policy
+
+    @retry_policy.setter
+    def retry_policy(self, retry_policy):
+        """Sets the retry_policy of this V1beta1TrialTemplate.
+
+
+        :param retry_policy: The retry_policy of this V1beta1TrialTemplate.  # noqa: E501
+        :type: V1beta1RetryPolicy
+        """
+
+        self._retry_policy = retry_policy
+
     @property
     def success_condition(self):
         """Gets the success_condition of this V1beta1TrialTemplate.  # noqa: E501
@@ -20,6 +20,7 @@ import os
 import textwrap
 from typing import Any, Callable, Dict, List, Optional, Union
 
+import grpc
 from kubeflow.katib import models
 from kubeflow.katib.constants import constants
 
@@ -35,6 +36,32 @@ def get_current_k8s_namespace():
         return f.readline()
 
 
+def get_grpc_channel(address: str) -> grpc.Channel:
+    """Returns the channel to the Katib gRPC service.
+
+    If the KATIB_GRPC_TLS_CERT_DIR env variable is set, the channel uses mutual TLS
+    with the CA certificate, certificate and key from this directory.
+    """
+    cert_dir = os.environ.get(constants.GRPC_TLS_CERT_DIR_ENV)
+    if not cert_dir:
+        return grpc.insecure_channel(address)
+
+    def read(name: str) -> bytes:
+        with open(os.path.join(cert_dir, name), "rb") as f:
+            return f.read()
+
+    credentials = grpc.ssl_channel_credentials(
+        root_certificates=read("ca.crt"),
+        private_key=read("tls.key"),
+        certificate_chain=read("tls.crt"),
+    )
+    return grpc.secure_channel(
+        address,
+        credentials,
+        options=[("grpc.ssl_target_name_override", constants.GRPC_TLS_SERVER_NAME)],
+    )
+
+
 def get_default_target_namespace():
     if not is_running_in_k8s():
         return "default"